@@ -21,6 +21,7 @@ import (
 	"time"
 
 	"github.com/kubermatic-labs/registryman/pkg/config"
+	"github.com/kubermatic-labs/registryman/pkg/globalregistry/reconciler"
 	"github.com/kubermatic-labs/registryman/pkg/operator"
 	"github.com/spf13/cobra"
 	"k8s.io/client-go/rest"
@@ -39,6 +40,7 @@ state of the system.`,
 	Args: cobra.MaximumNArgs(1),
 	RunE: func(cmd *cobra.Command, args []string) error {
 		config.SetLogger(logger)
+		reconciler.SetLogger(logger)
 
 		var aos config.ApiObjectStore
 		var err error
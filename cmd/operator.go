@@ -23,6 +23,7 @@ import (
 	"time"
 
 	"github.com/kubermatic-labs/registryman/pkg/config"
+	"github.com/kubermatic-labs/registryman/pkg/globalregistry/reconciler"
 	"github.com/kubermatic-labs/registryman/pkg/operator"
 	"github.com/spf13/cobra"
 )
@@ -34,6 +35,7 @@ var operatorCmd = &cobra.Command{
 	Long:  `Start in operator mode`,
 	Run: func(cmd *cobra.Command, args []string) {
 		config.SetLogger(logger)
+		reconciler.SetLogger(logger)
 		operator.SetLogger(logger)
 		fmt.Println("operator called")
 		aos, clientConfig, err := config.ConnectToKube(options, "")
@@ -47,11 +49,16 @@ var operatorCmd = &cobra.Command{
 			10*time.Second,
 			aos.(operator.RegistryStore),
 		)
+		replicationScheduler := operator.NewReplicationScheduler(
+			time.Minute,
+			aos.(operator.RegistryStore),
+		)
 		reconciler := operator.NewReconciler(
 			aos.(operator.AOSWithSharedInformerFactory))
 		ctx, cancel := signal.NotifyContext(context.Background(), os.Interrupt)
 		defer cancel()
 		statusUpdater.Start(ctx)
+		replicationScheduler.Start(ctx)
 		reconciler.Start(ctx)
 		<-ctx.Done()
 	},
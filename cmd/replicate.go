@@ -0,0 +1,232 @@
+/*
+   Copyright 2021 The Kubermatic Kubernetes Platform contributors.
+
+   Licensed under the Apache License, Version 2.0 (the "License");
+   you may not use this file except in compliance with the License.
+   You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+   Unless required by applicable law or agreed to in writing, software
+   distributed under the License is distributed on an "AS IS" BASIS,
+   WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+   See the License for the specific language governing permissions and
+   limitations under the License.
+*/
+
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	api "github.com/kubermatic-labs/registryman/pkg/apis/registryman/v1alpha1"
+	"github.com/kubermatic-labs/registryman/pkg/config"
+	"github.com/kubermatic-labs/registryman/pkg/config/registry"
+	"github.com/kubermatic-labs/registryman/pkg/globalregistry"
+	"github.com/kubermatic-labs/registryman/pkg/operator"
+	"github.com/spf13/cobra"
+	"k8s.io/client-go/rest"
+)
+
+var replicationTimeout time.Duration
+var replicationPollInterval time.Duration
+
+// startedReplication describes a replication execution started by the
+// replicate command.
+type startedReplication struct {
+	registryName string
+	rule         globalregistry.ReplicationRule
+	executable   globalregistry.ExecutableReplicationRule
+	executionID  int
+}
+
+func (sr *startedReplication) String() string {
+	return fmt.Sprintf("%s: %s [%s]",
+		sr.registryName,
+		sr.rule.RemoteRegistry().GetName(),
+		sr.rule.Direction(),
+	)
+}
+
+// startReplication starts the executions of the replication rules of the
+// project in all registries where the project is provisioned.
+func startReplication(ctx context.Context, aos config.ApiObjectStore, projectName string) ([]*startedReplication, error) {
+	var apiProject *api.Project
+	for _, project := range aos.GetProjects(ctx) {
+		if project.GetName() == projectName {
+			apiProject = project
+			break
+		}
+	}
+	if apiProject == nil {
+		return nil, fmt.Errorf("project %s not found", projectName)
+	}
+	registries := aos.GetRegistries(ctx)
+	startedReplications := make([]*startedReplication, 0)
+	for _, apiRegistry := range registries {
+		if !registry.IsProjectProvisioned(apiProject, apiRegistry, registries) {
+			continue
+		}
+		actualRegistry, err := registry.New(apiRegistry, aos).ToReal()
+		if err != nil {
+			return nil, err
+		}
+		project, err := actualRegistry.(globalregistry.RegistryWithProjects).GetProjectByName(ctx, projectName)
+		if err != nil {
+			return nil, err
+		}
+		if project == nil {
+			logger.V(-1).Info("project is not provisioned yet",
+				"registry", apiRegistry.GetName(),
+				"project", projectName)
+			continue
+		}
+		projectWithReplication, ok := project.(globalregistry.ProjectWithReplication)
+		if !ok {
+			continue
+		}
+		rules, err := projectWithReplication.GetReplicationRules(ctx, nil, "")
+		if err != nil {
+			return nil, err
+		}
+		for _, rule := range rules {
+			executable, ok := rule.(globalregistry.ExecutableReplicationRule)
+			if !ok {
+				continue
+			}
+			executionID, err := executable.Execute(ctx)
+			if err != nil {
+				return nil, err
+			}
+			sr := &startedReplication{
+				registryName: apiRegistry.GetName(),
+				rule:         rule,
+				executable:   executable,
+				executionID:  executionID,
+			}
+			logger.Info("replication started",
+				"replication", sr.String(),
+				"execution", executionID)
+			startedReplications = append(startedReplications, sr)
+		}
+	}
+	return startedReplications, nil
+}
+
+// performManagedReplication copies the repositories of the project along the
+// replication edges that the registries cannot implement natively. It returns
+// the number of the synchronized edges.
+func performManagedReplication(ctx context.Context, aos config.ApiObjectStore, projectName string) (int, error) {
+	count := 0
+	managedReplications := registry.ManagedReplications(aos.GetProjects(ctx), aos.GetRegistries(ctx))
+	for _, managedReplication := range managedReplications {
+		if managedReplication.Project.GetName() != projectName {
+			continue
+		}
+		// the digests recorded by the operator are used; the digests
+		// of this run are not recorded
+		status := operator.GetManagedReplicationStatus(managedReplication.Destination, managedReplication)
+		_, err := operator.SyncManagedReplication(ctx, aos, managedReplication, status.SyncedDigests)
+		if err != nil {
+			return count, err
+		}
+		fmt.Printf("%s: %s [Managed] Succeeded\n",
+			managedReplication.Destination.GetName(),
+			managedReplication.Source.GetName())
+		count++
+	}
+	return count, nil
+}
+
+// waitForReplication waits until the started replication executions finish. An
+// error is returned if any of them does not succeed.
+func waitForReplication(ctx context.Context, startedReplications []*startedReplication) error {
+	ticker := time.NewTicker(replicationPollInterval)
+	defer ticker.Stop()
+	failures := 0
+	for len(startedReplications) > 0 {
+		select {
+		case <-ctx.Done():
+			return fmt.Errorf("waiting for replication: %w", ctx.Err())
+		case <-ticker.C:
+		}
+		running := make([]*startedReplication, 0, len(startedReplications))
+		for _, sr := range startedReplications {
+			execution, err := sr.executable.GetExecution(ctx, sr.executionID)
+			if err != nil {
+				return err
+			}
+			if execution.State == api.InProgressReplicationExecutionState {
+				running = append(running, sr)
+				continue
+			}
+			fmt.Printf("%s %s, %d failed tasks\n",
+				sr.String(),
+				execution.State,
+				execution.FailedTasks)
+			if execution.State != api.SucceededReplicationExecutionState {
+				failures++
+			}
+		}
+		startedReplications = running
+	}
+	if failures > 0 {
+		return fmt.Errorf("%d replication executions did not succeed", failures)
+	}
+	return nil
+}
+
+// replicateCmd represents the replicate command
+var replicateCmd = &cobra.Command{
+	Use:   "replicate project [path]",
+	Short: "Replicate a project",
+	Long: `The replication rules of the project are executed in all
+registries where the project is provisioned. The command
+waits until the replication executions finish. The
+repositories of the registries that cannot replicate
+natively are copied by the command.`,
+	Args: cobra.RangeArgs(1, 2),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		config.SetLogger(logger)
+		var aos config.ApiObjectStore
+		var err error
+		if len(args) == 2 {
+			logger.Info("reading config files", "dir", args[1])
+			aos, err = config.ReadLocalManifests(args[1], nil)
+			if err != nil {
+				return err
+			}
+		} else {
+			var clientConfig *rest.Config
+			aos, clientConfig, err = config.ConnectToKube(nil, "")
+			if err != nil {
+				return err
+			}
+			logger.Info("connecting to Kubernetes for resources",
+				"host", clientConfig.Host)
+		}
+
+		ctx, cancel := context.WithTimeout(context.Background(), replicationTimeout)
+		defer cancel()
+		startedReplications, err := startReplication(ctx, aos, args[0])
+		if err != nil {
+			return err
+		}
+		managedReplicationCount, err := performManagedReplication(ctx, aos, args[0])
+		if err != nil {
+			return err
+		}
+		if len(startedReplications) == 0 && managedReplicationCount == 0 {
+			return fmt.Errorf("project %s has no replication rule that can be executed", args[0])
+		}
+		return waitForReplication(ctx, startedReplications)
+	},
+}
+
+func init() {
+	rootCmd.AddCommand(replicateCmd)
+	replicateCmd.PersistentFlags().DurationVar(&replicationTimeout, "timeout", 30*time.Minute, "maximum time to wait for the replication")
+	replicateCmd.PersistentFlags().DurationVar(&replicationPollInterval, "poll-interval", 5*time.Second, "time between the checks of the replication executions")
+}
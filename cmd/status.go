@@ -59,6 +59,7 @@ var statusCmd = &cobra.Command{
 	Args:  cobra.MaximumNArgs(1),
 	RunE: func(cmd *cobra.Command, args []string) error {
 		config.SetLogger(logger)
+		reconciler.SetLogger(logger)
 		var aos config.ApiObjectStore
 		var err error
 		if len(args) == 1 {
@@ -90,10 +91,17 @@ var statusCmd = &cobra.Command{
 					return err
 				}
 			}
-			registryStatuses[expectedRegistry.GetName()], err = reconciler.GetRegistryStatus(ctx, actualRegistry)
+			registryStatus, err := reconciler.GetRegistryStatus(ctx, actualRegistry)
 			if err != nil {
 				return err
 			}
+			if !showExpected {
+				err = reconciler.AddRecordedStatus(ctx, aos, actualRegistry, registryStatus)
+				if err != nil {
+					return err
+				}
+			}
+			registryStatuses[expectedRegistry.GetName()] = registryStatus
 		}
 		var enc encoder
 		switch outputEncoder {
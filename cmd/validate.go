@@ -17,6 +17,9 @@
 package cmd
 
 import (
+	"context"
+	"time"
+
 	"github.com/kubermatic-labs/registryman/pkg/config"
 	"github.com/spf13/cobra"
 	"k8s.io/client-go/rest"
@@ -48,6 +51,11 @@ var validateCmd = &cobra.Command{
 				"host", clientConfig.Host)
 		}
 		err = config.ValidateConsistency(aos)
+		if err == nil {
+			ctx, cancel := context.WithTimeout(context.Background(), time.Minute)
+			defer cancel()
+			err = config.ValidateLdapGroups(ctx, aos)
+		}
 		if err == nil {
 			logger.Info("config files are valid")
 		} else {
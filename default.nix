@@ -353,4 +353,10 @@ in {
     "${registryman-generated}/pkg/apis/registryman/v1alpha1/registryman.kubermatic.com_registries.yaml";
   scanner-crd =
     "${registryman-generated}/pkg/apis/registryman/v1alpha1/registryman.kubermatic.com_scanners.yaml";
+  robotaccount-crd =
+    "${registryman-generated}/pkg/apis/registryman/v1alpha1/registryman.kubermatic.com_robotaccounts.yaml";
+  team-crd =
+    "${registryman-generated}/pkg/apis/registryman/v1alpha1/registryman.kubermatic.com_teams.yaml";
+  projectclass-crd =
+    "${registryman-generated}/pkg/apis/registryman/v1alpha1/registryman.kubermatic.com_projectclasses.yaml";
 }
@@ -0,0 +1,329 @@
+/*
+   Copyright 2021 The Kubermatic Kubernetes Platform contributors.
+
+   Licensed under the Apache License, Version 2.0 (the "License");
+   you may not use this file except in compliance with the License.
+   You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+   Unless required by applicable law or agreed to in writing, software
+   distributed under the License is distributed on an "AS IS" BASIS,
+   WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+   See the License for the specific language governing permissions and
+   limitations under the License.
+*/
+
+package acr
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/go-logr/logr"
+	"github.com/kubermatic-labs/registryman/pkg/globalregistry"
+)
+
+const (
+	// resourceIDAnnotation contains the Azure Resource Manager ID of the
+	// registry, e.g.
+	// /subscriptions/<id>/resourceGroups/<group>/providers/Microsoft.ContainerRegistry/registries/<name>
+	resourceIDAnnotation = "registryman.kubermatic.com/azureResourceID"
+
+	// tenantIDAnnotation contains the ID of the Azure AD tenant of the
+	// service principal.
+	tenantIDAnnotation = "registryman.kubermatic.com/azureTenantID"
+
+	// managementEndpointAnnotation can override the Azure Resource Manager
+	// endpoint, e.g. for sovereign clouds.
+	managementEndpointAnnotation = "registryman.kubermatic.com/azureManagementEndpoint"
+
+	// loginEndpointAnnotation can override the Azure AD endpoint, e.g. for
+	// sovereign clouds.
+	loginEndpointAnnotation = "registryman.kubermatic.com/azureLoginEndpoint"
+
+	defaultManagementEndpoint = "https://management.azure.com"
+	defaultLoginEndpoint      = "https://login.microsoftonline.com"
+
+	armAPIVersion = "2022-12-01"
+)
+
+// armClient accesses the Azure Resource Manager API of a container registry.
+// It authenticates with the credentials of a service principal using the
+// OAuth2 client credentials flow.
+type armClient struct {
+	logger             logr.Logger
+	client             *http.Client
+	resourceID         string
+	tenantID           string
+	clientID           string
+	clientSecret       string
+	managementEndpoint string
+	loginEndpoint      string
+
+	accessToken string
+	expiresAt   time.Time
+}
+
+// newArmClient returns an armClient if the registry is annotated with the
+// Azure Resource Manager ID. Otherwise, nil is returned.
+func newArmClient(logger logr.Logger, client *http.Client, config globalregistry.Registry) (*armClient, error) {
+	annotations := config.GetAnnotations()
+	resourceID, ok := annotations[resourceIDAnnotation]
+	if !ok {
+		return nil, nil
+	}
+	tenantID, ok := annotations[tenantIDAnnotation]
+	if !ok {
+		return nil, fmt.Errorf("%s annotation is missing", tenantIDAnnotation)
+	}
+	c := &armClient{
+		logger:             logger,
+		client:             client,
+		resourceID:         strings.TrimSuffix(resourceID, "/"),
+		tenantID:           tenantID,
+		clientID:           config.GetUsername(),
+		clientSecret:       config.GetPassword(),
+		managementEndpoint: defaultManagementEndpoint,
+		loginEndpoint:      defaultLoginEndpoint,
+	}
+	if val, ok := annotations[managementEndpointAnnotation]; ok {
+		c.managementEndpoint = strings.TrimSuffix(val, "/")
+	}
+	if val, ok := annotations[loginEndpointAnnotation]; ok {
+		c.loginEndpoint = strings.TrimSuffix(val, "/")
+	}
+	return c, nil
+}
+
+type tokenResponse struct {
+	AccessToken string `json:"access_token"`
+	ExpiresIn   int    `json:"expires_in"`
+}
+
+// authenticate fetches a new Azure AD access token if the cached one is
+// missing or about to expire.
+func (c *armClient) authenticate(ctx context.Context) error {
+	if c.accessToken != "" && time.Now().Add(time.Minute).Before(c.expiresAt) {
+		return nil
+	}
+	c.logger.V(1).Info("requesting Azure AD access token",
+		"tenant", c.tenantID,
+	)
+	form := url.Values{}
+	form.Set("grant_type", "client_credentials")
+	form.Set("client_id", c.clientID)
+	form.Set("client_secret", c.clientSecret)
+	form.Set("scope", c.managementEndpoint+"/.default")
+	req, err := http.NewRequest(http.MethodPost,
+		fmt.Sprintf("%s/%s/oauth2/v2.0/token", c.loginEndpoint, c.tenantID),
+		strings.NewReader(form.Encode()))
+	if err != nil {
+		return err
+	}
+	req = req.WithContext(ctx)
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	switch {
+	case resp.StatusCode == 400 || resp.StatusCode == 401:
+		return globalregistry.ErrUnauthorized
+	case resp.StatusCode < 200 || resp.StatusCode >= 300:
+		return globalregistry.ErrInvalidStatusCode(resp.StatusCode)
+	}
+	token := &tokenResponse{}
+	err = json.NewDecoder(resp.Body).Decode(token)
+	if err != nil {
+		return err
+	}
+	c.accessToken = token.AccessToken
+	c.expiresAt = time.Now().Add(time.Duration(token.ExpiresIn) * time.Second)
+	return nil
+}
+
+// do sends a request to the Azure Resource Manager. The path is relative to
+// the resource ID of the registry. If respBody is not nil, the JSON response is
+// decoded into it.
+func (c *armClient) do(ctx context.Context, method, path string, reqBody, respBody interface{}) error {
+	err := c.authenticate(ctx)
+	if err != nil {
+		return err
+	}
+	var body io.Reader
+	if reqBody != nil {
+		reqBodyBuf := bytes.NewBuffer(nil)
+		err = json.NewEncoder(reqBodyBuf).Encode(reqBody)
+		if err != nil {
+			return err
+		}
+		body = reqBodyBuf
+	}
+	u := path
+	if !strings.HasPrefix(u, "http") {
+		u = fmt.Sprintf("%s%s%s?api-version=%s", c.managementEndpoint, c.resourceID, path, armAPIVersion)
+	}
+	c.logger.V(1).Info("sending ARM request",
+		"method", method,
+		"url", u,
+	)
+	req, err := http.NewRequest(method, u, body)
+	if err != nil {
+		return err
+	}
+	req = req.WithContext(ctx)
+	req.Header.Set("Authorization", "Bearer "+c.accessToken)
+	if body != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+
+	resp, err := c.client.Do(req)
+	if err != nil {
+		c.logger.Error(err, "http.Client cannot Do",
+			"req-url", req.URL,
+		)
+		return err
+	}
+	defer resp.Body.Close()
+
+	buf := new(bytes.Buffer)
+	_, err = buf.ReadFrom(resp.Body)
+	if err != nil {
+		return err
+	}
+	switch {
+	case resp.StatusCode == 401:
+		return globalregistry.ErrUnauthorized
+	case resp.StatusCode < 200 || resp.StatusCode >= 300:
+		c.logger.V(-1).Info("HTTP response status code is not OK",
+			"status-code", resp.StatusCode,
+			"req-url", req.URL,
+		)
+		c.logger.V(1).Info(buf.String())
+		return globalregistry.ErrInvalidStatusCode(resp.StatusCode)
+	}
+	if respBody != nil && buf.Len() > 0 {
+		err = json.NewDecoder(buf).Decode(respBody)
+		if err != nil {
+			c.logger.Error(err, "json decoding failed")
+			c.logger.Info(buf.String())
+			return err
+		}
+	}
+	return nil
+}
+
+type scopeMapProperties struct {
+	Description string   `json:"description"`
+	Actions     []string `json:"actions"`
+}
+
+type scopeMap struct {
+	ID         string             `json:"id,omitempty"`
+	Name       string             `json:"name,omitempty"`
+	Properties scopeMapProperties `json:"properties"`
+}
+
+type scopeMapList struct {
+	Value    []*scopeMap `json:"value"`
+	NextLink string      `json:"nextLink"`
+}
+
+func (c *armClient) listScopeMaps(ctx context.Context) ([]*scopeMap, error) {
+	scopeMaps := []*scopeMap{}
+	path := "/scopeMaps"
+	for path != "" {
+		page := &scopeMapList{}
+		err := c.do(ctx, http.MethodGet, path, nil, page)
+		if err != nil {
+			return nil, err
+		}
+		scopeMaps = append(scopeMaps, page.Value...)
+		path = page.NextLink
+	}
+	return scopeMaps, nil
+}
+
+func (c *armClient) createScopeMap(ctx context.Context, name string, properties scopeMapProperties) (*scopeMap, error) {
+	result := &scopeMap{}
+	err := c.do(ctx, http.MethodPut, "/scopeMaps/"+name, &scopeMap{
+		Properties: properties,
+	}, result)
+	return result, err
+}
+
+func (c *armClient) deleteScopeMap(ctx context.Context, name string) error {
+	return c.do(ctx, http.MethodDelete, "/scopeMaps/"+name, nil, nil)
+}
+
+type tokenProperties struct {
+	ScopeMapID string `json:"scopeMapId"`
+	Status     string `json:"status,omitempty"`
+}
+
+type token struct {
+	ID         string          `json:"id,omitempty"`
+	Name       string          `json:"name,omitempty"`
+	Properties tokenProperties `json:"properties"`
+}
+
+func (c *armClient) createToken(ctx context.Context, name string, scopeMapID string) (*token, error) {
+	result := &token{}
+	err := c.do(ctx, http.MethodPut, "/tokens/"+name, &token{
+		Properties: tokenProperties{
+			ScopeMapID: scopeMapID,
+			Status:     "enabled",
+		},
+	}, result)
+	return result, err
+}
+
+func (c *armClient) deleteToken(ctx context.Context, name string) error {
+	return c.do(ctx, http.MethodDelete, "/tokens/"+name, nil, nil)
+}
+
+type generateCredentialsReqBody struct {
+	TokenID string `json:"tokenId"`
+	Name    string `json:"name"`
+}
+
+type tokenPassword struct {
+	Name  string `json:"name"`
+	Value string `json:"value"`
+}
+
+type generateCredentialsRespBody struct {
+	Username  string          `json:"username"`
+	Passwords []tokenPassword `json:"passwords"`
+}
+
+// generateCredentials generates the password1 password of the token.
+func (c *armClient) generateCredentials(ctx context.Context, t *token) (*globalregistry.ProjectMemberCredentials, error) {
+	result := &generateCredentialsRespBody{}
+	err := c.do(ctx, http.MethodPost, "/generateCredentials", &generateCredentialsReqBody{
+		TokenID: t.ID,
+		Name:    "password1",
+	}, result)
+	if err != nil {
+		return nil, err
+	}
+	for _, password := range result.Passwords {
+		if password.Name == "password1" {
+			return &globalregistry.ProjectMemberCredentials{
+				Username: result.Username,
+				Password: password.Value,
+			}, nil
+		}
+	}
+	return nil, fmt.Errorf("no password generated for token %s", t.Name)
+}
@@ -0,0 +1,341 @@
+/*
+   Copyright 2021 The Kubermatic Kubernetes Platform contributors.
+
+   Licensed under the Apache License, Version 2.0 (the "License");
+   you may not use this file except in compliance with the License.
+   You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+   Unless required by applicable law or agreed to in writing, software
+   distributed under the License is distributed on an "AS IS" BASIS,
+   WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+   See the License for the specific language governing permissions and
+   limitations under the License.
+*/
+
+package acr
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/kubermatic-labs/registryman/pkg/globalregistry"
+)
+
+// ACR has no concept of projects and project members. A managedRegistry
+// emulates them with Azure Resource Manager resources. A project is marked by a
+// scope map that grants metadata read access to the repositories of the
+// project. A robot member is a token with its own scope map that grants access
+// to the repositories of the project according to the role of the member.
+//
+// The descriptions of the scope maps identify the project and the member, the
+// resource names are derived from them.
+const (
+	projectDescriptionPrefix = "registryman:project:"
+	robotDescriptionPrefix   = "registryman:robot:"
+)
+
+type managedRegistry struct {
+	*registry
+	arm *armClient
+}
+
+var _ globalregistry.Registry = &managedRegistry{}
+var _ globalregistry.RegistryWithProjects = &managedRegistry{}
+var _ globalregistry.ProjectCreator = &managedRegistry{}
+
+// armName converts s so that it can be used in Azure Resource Manager resource
+// names, which may contain alphanumeric characters and hyphens only.
+func armName(s string) string {
+	return strings.Map(func(r rune) rune {
+		switch {
+		case r >= 'a' && r <= 'z', r >= 'A' && r <= 'Z', r >= '0' && r <= '9', r == '-':
+			return r
+		default:
+			return '-'
+		}
+	}, s)
+}
+
+func projectScopeMapName(projectName string) string {
+	return fmt.Sprintf("registryman-%s", armName(projectName))
+}
+
+func memberResourceName(projectName, memberName string) string {
+	return fmt.Sprintf("registryman-%s--%s", armName(projectName), armName(memberName))
+}
+
+func (r *managedRegistry) GetProjectByName(ctx context.Context, name string) (globalregistry.Project, error) {
+	if name == "" {
+		return r.newProject(""), nil
+	}
+	projects, err := r.ListProjects(ctx)
+	if err != nil {
+		return nil, err
+	}
+	for _, project := range projects {
+		if project.GetName() == name {
+			return project, nil
+		}
+	}
+	return nil, fmt.Errorf("no project found: %w", globalregistry.ErrRecoverableError)
+}
+
+// ListProjects returns the projects derived from the repository names and the
+// projects created by registryman.
+func (r *managedRegistry) ListProjects(ctx context.Context) ([]globalregistry.Project, error) {
+	repositories, err := r.getRepositories(ctx)
+	if err != nil {
+		return nil, err
+	}
+	scopeMaps, err := r.arm.listScopeMaps(ctx)
+	if err != nil {
+		return nil, err
+	}
+	projectNames := make(map[string]struct{})
+	projects := []globalregistry.Project{}
+	addProject := func(name string) {
+		if _, found := projectNames[name]; found {
+			return
+		}
+		projectNames[name] = struct{}{}
+		projects = append(projects, r.newProject(name))
+	}
+	for _, repoName := range repositories {
+		addProject(projectNameFromRepoName(repoName))
+	}
+	for _, sm := range scopeMaps {
+		if strings.HasPrefix(sm.Properties.Description, projectDescriptionPrefix) {
+			addProject(strings.TrimPrefix(sm.Properties.Description, projectDescriptionPrefix))
+		}
+	}
+	return projects, nil
+}
+
+// CreateProject implements the globalregistry.ProjectCreator interface. It
+// creates the scope map that marks the project.
+func (r *managedRegistry) CreateProject(ctx context.Context, name string) (globalregistry.Project, error) {
+	r.logger.V(1).Info("creating ACR project scope map",
+		"projectName", name,
+	)
+	_, err := r.arm.createScopeMap(ctx, projectScopeMapName(name), scopeMapProperties{
+		Description: projectDescriptionPrefix + name,
+		Actions: []string{
+			fmt.Sprintf("repositories/%s/*/metadata/read", name),
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+	return r.newProject(name), nil
+}
+
+func (r *managedRegistry) newProject(name string) *managedProject {
+	return &managedProject{
+		project: &project{
+			name:     name,
+			registry: r.registry,
+		},
+		arm: r.arm,
+	}
+}
+
+type managedProject struct {
+	*project
+	arm *armClient
+}
+
+// interface guard
+var _ globalregistry.Project = &managedProject{}
+var _ globalregistry.DestructibleProject = &managedProject{}
+var _ globalregistry.ProjectWithRepositories = &managedProject{}
+var _ globalregistry.ProjectWithMembers = &managedProject{}
+var _ globalregistry.MemberManipulatorProject = &managedProject{}
+
+type robotMember struct {
+	name string
+	role string
+}
+
+var _ globalregistry.ProjectMember = &robotMember{}
+
+func (m *robotMember) GetName() string {
+	return m.name
+}
+
+func (m *robotMember) GetType() string {
+	return "Robot"
+}
+
+func (m *robotMember) GetRole() string {
+	return m.role
+}
+
+// acrRoles is the role table of ACR. The roles of the robots are comma
+// separated lists of the access kinds (read, write) that the scope map of the
+// token grants to the content and the metadata of the project repositories.
+var acrRoles = globalregistry.RoleTable{
+	"Robot": {
+		"PullOnly":    "read",
+		"PushOnly":    "write",
+		"PullAndPush": "read,write",
+	},
+}
+
+// roleTable returns the role table of the registry, i.e. the ACR role table
+// with the role mapping of the registry applied.
+func (r *registry) roleTable() (globalregistry.RoleTable, error) {
+	return globalregistry.GetRoleTable(r.Registry)
+}
+
+// accessKindsToActions returns the scope map actions of the access kinds.
+func accessKindsToActions(projectName, providerRole string) []string {
+	repos := fmt.Sprintf("repositories/%s/*", projectName)
+	actions := []string{}
+	for _, kind := range globalregistry.SplitProviderRole(providerRole) {
+		actions = append(actions,
+			fmt.Sprintf("%s/content/%s", repos, kind),
+			fmt.Sprintf("%s/metadata/%s", repos, kind),
+		)
+	}
+	return actions
+}
+
+// actionsToAccessKinds is the inverse of accessKindsToActions. The access kind
+// of an action is taken into account only if it grants access to the content
+// of the repositories.
+func actionsToAccessKinds(actions []string) string {
+	kinds := []string{}
+	for _, action := range actions {
+		i := strings.LastIndex(action, "/content/")
+		if i >= 0 {
+			kinds = append(kinds, action[i+len("/content/"):])
+		}
+	}
+	return strings.Join(kinds, ",")
+}
+
+// memberScopeMaps returns the scope maps of the robot members of the project
+// indexed by the member names.
+func (p *managedProject) memberScopeMaps(ctx context.Context) (map[string]*scopeMap, error) {
+	scopeMaps, err := p.arm.listScopeMaps(ctx)
+	if err != nil {
+		return nil, err
+	}
+	prefix := fmt.Sprintf("%s%s:", robotDescriptionPrefix, p.name)
+	result := make(map[string]*scopeMap)
+	for _, sm := range scopeMaps {
+		if strings.HasPrefix(sm.Properties.Description, prefix) {
+			result[strings.TrimPrefix(sm.Properties.Description, prefix)] = sm
+		}
+	}
+	return result, nil
+}
+
+// GetMembers implements the globalregistry.ProjectWithMembers interface.
+func (p *managedProject) GetMembers(ctx context.Context) ([]globalregistry.ProjectMember, error) {
+	scopeMaps, err := p.memberScopeMaps(ctx)
+	if err != nil {
+		return nil, err
+	}
+	roleTable, err := p.registry.roleTable()
+	if err != nil {
+		return nil, err
+	}
+	members := make([]globalregistry.ProjectMember, 0, len(scopeMaps))
+	for memberName, sm := range scopeMaps {
+		members = append(members, &robotMember{
+			name: memberName,
+			role: roleTable.Role("Robot", actionsToAccessKinds(sm.Properties.Actions)),
+		})
+	}
+	return members, nil
+}
+
+// AssignMember implements the globalregistry.MemberManipulatorProject
+// interface. Only robot members are supported. The credentials of the
+// generated token are returned.
+func (p *managedProject) AssignMember(ctx context.Context, member globalregistry.ProjectMember) (*globalregistry.ProjectMemberCredentials, error) {
+	if memberType := member.GetType(); memberType != "Robot" {
+		return nil, fmt.Errorf("member type %s is not supported by ACR", memberType)
+	}
+	roleTable, err := p.registry.roleTable()
+	if err != nil {
+		return nil, err
+	}
+	providerRole, err := roleTable.ProviderRoleOf("Robot", member.GetRole())
+	if err != nil {
+		return nil, err
+	}
+	actions := accessKindsToActions(p.name, providerRole)
+	name := memberResourceName(p.name, member.GetName())
+	p.registry.logger.V(1).Info("creating ACR scope map and token",
+		"projectName", p.name,
+		"memberName", member.GetName(),
+		"resourceName", name,
+	)
+	sm, err := p.arm.createScopeMap(ctx, name, scopeMapProperties{
+		Description: fmt.Sprintf("%s%s:%s", robotDescriptionPrefix, p.name, member.GetName()),
+		Actions:     actions,
+	})
+	if err != nil {
+		return nil, err
+	}
+	t, err := p.arm.createToken(ctx, name, sm.ID)
+	if err != nil {
+		return nil, err
+	}
+	return p.arm.generateCredentials(ctx, t)
+}
+
+// UnassignMember implements the globalregistry.MemberManipulatorProject
+// interface.
+func (p *managedProject) UnassignMember(ctx context.Context, member globalregistry.ProjectMember) error {
+	if memberType := member.GetType(); memberType != "Robot" {
+		return fmt.Errorf("member type %s is not supported by ACR", memberType)
+	}
+	return p.removeMember(ctx, member.GetName())
+}
+
+func (p *managedProject) removeMember(ctx context.Context, memberName string) error {
+	name := memberResourceName(p.name, memberName)
+	p.registry.logger.V(1).Info("deleting ACR token and scope map",
+		"projectName", p.name,
+		"memberName", memberName,
+		"resourceName", name,
+	)
+	err := p.arm.deleteToken(ctx, name)
+	if err != nil {
+		return err
+	}
+	return p.arm.deleteScopeMap(ctx, name)
+}
+
+// Delete implements the globalregistry.DestructibleProject interface. Besides
+// the repositories, the tokens and scope maps of the project are removed too.
+func (p *managedProject) Delete(ctx context.Context) error {
+	err := p.project.Delete(ctx)
+	if err != nil {
+		return err
+	}
+	scopeMaps, err := p.memberScopeMaps(ctx)
+	if err != nil {
+		return err
+	}
+	for memberName := range scopeMaps {
+		err = p.removeMember(ctx, memberName)
+		if err != nil {
+			return err
+		}
+	}
+	err = p.arm.deleteScopeMap(ctx, projectScopeMapName(p.name))
+	switch err {
+	case nil, globalregistry.ErrInvalidStatusCode(404):
+		// project created by pushing a repository has no scope map
+		return nil
+	default:
+		return err
+	}
+}
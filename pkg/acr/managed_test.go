@@ -0,0 +1,299 @@
+/*
+   Copyright 2021 The Kubermatic Kubernetes Platform contributors.
+
+   Licensed under the Apache License, Version 2.0 (the "License");
+   you may not use this file except in compliance with the License.
+   You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+   Unless required by applicable law or agreed to in writing, software
+   distributed under the License is distributed on an "AS IS" BASIS,
+   WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+   See the License for the specific language governing permissions and
+   limitations under the License.
+*/
+
+package acr
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"sort"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/go-logr/logr"
+	"github.com/kubermatic-labs/registryman/pkg/globalregistry"
+	"github.com/kubermatic-labs/registryman/pkg/globalregistry/globalregistrytest"
+)
+
+const testResourceID = "/subscriptions/sub/resourceGroups/rg/providers/Microsoft.ContainerRegistry/registries/testacr"
+
+// armStub is a stubbed Azure AD, Azure Resource Manager and ACR data plane
+// HTTP server.
+type armStub struct {
+	*httptest.Server
+	mu           sync.Mutex
+	repositories []string
+	scopeMaps    map[string]*scopeMap
+	tokens       map[string]*token
+}
+
+func newArmStub() *armStub {
+	s := &armStub{
+		scopeMaps: make(map[string]*scopeMap),
+		tokens:    make(map[string]*token),
+	}
+	s.Server = httptest.NewServer(http.HandlerFunc(s.serveHTTP))
+	return s
+}
+
+func (s *armStub) serveHTTP(w http.ResponseWriter, req *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	switch {
+	case req.URL.Path == "/tenant/oauth2/v2.0/token":
+		if err := req.ParseForm(); err != nil ||
+			req.PostForm.Get("client_id") != "sp-id" ||
+			req.PostForm.Get("client_secret") != "sp-secret" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		_ = json.NewEncoder(w).Encode(&tokenResponse{
+			AccessToken: "aad-token",
+			ExpiresIn:   3600,
+		})
+		return
+	case req.URL.Path == "/v2/_catalog":
+		_ = json.NewEncoder(w).Encode(&repositories{
+			Repositories: s.repositories,
+		})
+		return
+	case !strings.HasPrefix(req.URL.Path, testResourceID):
+		w.WriteHeader(http.StatusNotFound)
+		return
+	}
+	if req.Header.Get("Authorization") != "Bearer aad-token" {
+		w.WriteHeader(http.StatusUnauthorized)
+		return
+	}
+	if req.URL.Query().Get("api-version") != armAPIVersion {
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+	parts := strings.Split(strings.TrimPrefix(req.URL.Path, testResourceID+"/"), "/")
+	switch {
+	case len(parts) == 1 && parts[0] == "scopeMaps" && req.Method == http.MethodGet:
+		list := &scopeMapList{}
+		for _, sm := range s.scopeMaps {
+			list.Value = append(list.Value, sm)
+		}
+		_ = json.NewEncoder(w).Encode(list)
+	case len(parts) == 2 && parts[0] == "scopeMaps" && req.Method == http.MethodPut:
+		sm := &scopeMap{}
+		_ = json.NewDecoder(req.Body).Decode(sm)
+		sm.Name = parts[1]
+		sm.ID = testResourceID + "/scopeMaps/" + parts[1]
+		s.scopeMaps[parts[1]] = sm
+		_ = json.NewEncoder(w).Encode(sm)
+	case len(parts) == 2 && parts[0] == "tokens" && req.Method == http.MethodPut:
+		t := &token{}
+		_ = json.NewDecoder(req.Body).Decode(t)
+		t.Name = parts[1]
+		t.ID = testResourceID + "/tokens/" + parts[1]
+		s.tokens[parts[1]] = t
+		_ = json.NewEncoder(w).Encode(t)
+	case len(parts) == 2 && parts[0] == "scopeMaps" && req.Method == http.MethodDelete:
+		if _, found := s.scopeMaps[parts[1]]; !found {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		delete(s.scopeMaps, parts[1])
+	case len(parts) == 2 && parts[0] == "tokens" && req.Method == http.MethodDelete:
+		if _, found := s.tokens[parts[1]]; !found {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		delete(s.tokens, parts[1])
+	case len(parts) == 1 && parts[0] == "generateCredentials" && req.Method == http.MethodPost:
+		body := &generateCredentialsReqBody{}
+		_ = json.NewDecoder(req.Body).Decode(body)
+		name := strings.TrimPrefix(body.TokenID, testResourceID+"/tokens/")
+		if _, found := s.tokens[name]; !found {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		_ = json.NewEncoder(w).Encode(&generateCredentialsRespBody{
+			Username: name,
+			Passwords: []tokenPassword{
+				{
+					Name:  body.Name,
+					Value: "password-of-" + name,
+				},
+			},
+		})
+	default:
+		w.WriteHeader(http.StatusNotFound)
+	}
+}
+
+func newTestRegistry(t *testing.T, stub *armStub) globalregistry.Registry {
+	reg, err := globalregistry.New(logr.Discard(), &globalregistrytest.Registry{
+		Provider:    "acr",
+		Name:        "acr-test",
+		Username:    "sp-id",
+		Password:    "sp-secret",
+		APIEndpoint: stub.URL,
+		Annotations: map[string]string{
+			resourceIDAnnotation:         testResourceID,
+			tenantIDAnnotation:           "tenant",
+			managementEndpointAnnotation: stub.URL,
+			loginEndpointAnnotation:      stub.URL,
+		},
+	})
+	if err != nil {
+		t.Fatalf("cannot create registry: %v", err)
+	}
+	return reg
+}
+
+func projectNames(projects []globalregistry.Project) []string {
+	names := make([]string, len(projects))
+	for i, project := range projects {
+		names[i] = project.GetName()
+	}
+	sort.Strings(names)
+	return names
+}
+
+func TestUnmanagedRegistry(t *testing.T) {
+	reg, err := globalregistry.New(logr.Discard(), &globalregistrytest.Registry{
+		Provider:    "acr",
+		APIEndpoint: "https://testacr.azurecr.io",
+	})
+	if err != nil {
+		t.Fatalf("cannot create registry: %v", err)
+	}
+	if _, ok := reg.(globalregistry.ProjectCreator); ok {
+		t.Errorf("registry without ARM annotations shall not create projects")
+	}
+
+	_, err = globalregistry.New(logr.Discard(), &globalregistrytest.Registry{
+		Provider:    "acr",
+		APIEndpoint: "https://testacr.azurecr.io",
+		Annotations: map[string]string{
+			resourceIDAnnotation: testResourceID,
+		},
+	})
+	if err == nil {
+		t.Errorf("missing tenant annotation shall be reported")
+	}
+}
+
+func TestManagedProjects(t *testing.T) {
+	stub := newArmStub()
+	defer stub.Close()
+	stub.repositories = []string{"os-images/alpine"}
+	ctx := context.Background()
+	reg := newTestRegistry(t, stub)
+
+	creator, ok := reg.(globalregistry.ProjectCreator)
+	if !ok {
+		t.Fatalf("registry with ARM annotations shall create projects")
+	}
+	_, err := creator.CreateProject(ctx, "apps")
+	if err != nil {
+		t.Fatalf("cannot create project: %v", err)
+	}
+	if _, found := stub.scopeMaps["registryman-apps"]; !found {
+		t.Errorf("project scope map not created")
+	}
+
+	projects, err := reg.(globalregistry.RegistryWithProjects).ListProjects(ctx)
+	if err != nil {
+		t.Fatalf("cannot list projects: %v", err)
+	}
+	if names := projectNames(projects); len(names) != 2 || names[0] != "apps" || names[1] != "os-images" {
+		t.Errorf("invalid projects: %v", names)
+	}
+
+	project, err := reg.(globalregistry.RegistryWithProjects).GetProjectByName(ctx, "apps")
+	if err != nil {
+		t.Fatalf("cannot get project: %v", err)
+	}
+	err = project.(globalregistry.DestructibleProject).Delete(ctx)
+	if err != nil {
+		t.Fatalf("cannot delete project: %v", err)
+	}
+	if len(stub.scopeMaps) != 0 {
+		t.Errorf("scope maps are not removed: %v", stub.scopeMaps)
+	}
+}
+
+func TestManagedMembers(t *testing.T) {
+	stub := newArmStub()
+	defer stub.Close()
+	ctx := context.Background()
+	reg := newTestRegistry(t, stub)
+
+	project, err := reg.(globalregistry.ProjectCreator).CreateProject(ctx, "apps")
+	if err != nil {
+		t.Fatalf("cannot create project: %v", err)
+	}
+	memberProject := project.(globalregistry.MemberManipulatorProject)
+
+	creds, err := memberProject.AssignMember(ctx, &globalregistrytest.Member{Name: "ci", Type: "Robot", Role: "PullAndPush"})
+	if err != nil {
+		t.Fatalf("cannot assign member: %v", err)
+	}
+	if creds == nil ||
+		creds.Username != "registryman-apps--ci" ||
+		creds.Password != "password-of-registryman-apps--ci" {
+		t.Errorf("invalid credentials: %+v", creds)
+	}
+	tok, found := stub.tokens["registryman-apps--ci"]
+	if !found {
+		t.Fatalf("token not created")
+	}
+	if tok.Properties.ScopeMapID != testResourceID+"/scopeMaps/registryman-apps--ci" {
+		t.Errorf("invalid scope map of token: %s", tok.Properties.ScopeMapID)
+	}
+
+	_, err = memberProject.AssignMember(ctx, &globalregistrytest.Member{Name: "reader", Type: "Robot", Role: "PullOnly"})
+	if err != nil {
+		t.Fatalf("cannot assign member: %v", err)
+	}
+
+	members, err := project.(globalregistry.ProjectWithMembers).GetMembers(ctx)
+	if err != nil {
+		t.Fatalf("cannot get members: %v", err)
+	}
+	roles := map[string]string{}
+	for _, member := range members {
+		roles[member.GetName()] = member.GetType() + "/" + member.GetRole()
+	}
+	if len(roles) != 2 || roles["ci"] != "Robot/PullAndPush" || roles["reader"] != "Robot/PullOnly" {
+		t.Errorf("invalid members: %v", roles)
+	}
+
+	err = memberProject.UnassignMember(ctx, &globalregistrytest.Member{Name: "ci", Type: "Robot", Role: "PullAndPush"})
+	if err != nil {
+		t.Fatalf("cannot unassign member: %v", err)
+	}
+	if _, found := stub.tokens["registryman-apps--ci"]; found {
+		t.Errorf("token is not removed")
+	}
+	if _, found := stub.scopeMaps["registryman-apps--ci"]; found {
+		t.Errorf("scope map is not removed")
+	}
+
+	_, err = memberProject.AssignMember(ctx, &globalregistrytest.Member{Name: "alice", Type: "User", Role: "Developer"})
+	if err == nil {
+		t.Errorf("user members shall be rejected")
+	}
+}
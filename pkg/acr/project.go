@@ -51,9 +51,9 @@ func (p *project) Delete(ctx context.Context) error {
 			if !opt.ForceDeleteProjects() {
 				return fmt.Errorf("%s: repositories are present, please delete them before deleting the project, %w", p.GetName(), globalregistry.ErrRecoverableError)
 			}
-			for _, repo := range repoNames {
+			for _, repo := range reposOfProject {
 				p.registry.logger.V(1).Info("deleting repository",
-					"repositoryName", repoNames,
+					"repositoryName", repo,
 				)
 				err = p.deleteRepository(repo)
 				if err != nil {
@@ -88,8 +88,9 @@ func (s *registry) do(req *http.Request) (*http.Response, error) {
 }
 
 func (r *registry) getRepositories(ctx context.Context) ([]string, error) {
-	r.parsedUrl.Path = path
-	req, err := http.NewRequest(http.MethodGet, r.parsedUrl.String(), nil)
+	url := *r.parsedUrl
+	url.Path = path
+	req, err := http.NewRequest(http.MethodGet, url.String(), nil)
 	if err != nil {
 		return nil, err
 	}
@@ -19,6 +19,7 @@
 package acr
 
 import (
+	"crypto/tls"
 	"net/http"
 	"net/url"
 
@@ -46,21 +47,41 @@ func init() {
 		"acr",
 		newRegistry,
 		acrRegistryCapabilities{},
+		acrRoles,
 	)
 }
 
+// newRegistry is the constructor of the registry type. It is a globalregistry
+// RegistryCreator. If the registry is annotated with its Azure Resource Manager
+// ID, a managedRegistry is returned that can manage projects and members too.
 func newRegistry(logger logr.Logger, config globalregistry.Registry) (globalregistry.Registry, error) {
 	var err error
 	r := &registry{
 		Registry: config,
-		Client:   http.DefaultClient,
-		logger:   logger,
+		Client: &http.Client{
+			Transport: &http.Transport{
+				TLSClientConfig: &tls.Config{
+					InsecureSkipVerify: config.GetInsecureSkipTLSVerify(),
+				},
+			},
+		},
+		logger: logger,
 	}
 
 	r.parsedUrl, err = url.Parse(config.GetAPIEndpoint())
 	if err != nil {
 		return nil, err
 	}
+	arm, err := newArmClient(logger, r.Client, config)
+	if err != nil {
+		return nil, err
+	}
+	if arm != nil {
+		return &managedRegistry{
+			registry: r,
+			arm:      arm,
+		}, nil
+	}
 	return r, nil
 }
 
@@ -0,0 +1,130 @@
+/*
+Copyright 2021 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by client-gen. DO NOT EDIT.
+
+package fake
+
+import (
+	"context"
+
+	v1alpha1 "github.com/kubermatic-labs/registryman/pkg/apis/registryman/v1alpha1"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	labels "k8s.io/apimachinery/pkg/labels"
+	schema "k8s.io/apimachinery/pkg/runtime/schema"
+	types "k8s.io/apimachinery/pkg/types"
+	watch "k8s.io/apimachinery/pkg/watch"
+	testing "k8s.io/client-go/testing"
+)
+
+// FakeProjectClasses implements ProjectClassInterface
+type FakeProjectClasses struct {
+	Fake *FakeRegistrymanV1alpha1
+	ns   string
+}
+
+var projectclassesResource = schema.GroupVersionResource{Group: "registryman.kubermatic.com", Version: "v1alpha1", Resource: "projectclasses"}
+
+var projectclassesKind = schema.GroupVersionKind{Group: "registryman.kubermatic.com", Version: "v1alpha1", Kind: "ProjectClass"}
+
+// Get takes name of the projectClass, and returns the corresponding projectClass object, and an error if there is any.
+func (c *FakeProjectClasses) Get(ctx context.Context, name string, options v1.GetOptions) (result *v1alpha1.ProjectClass, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewGetAction(projectclassesResource, c.ns, name), &v1alpha1.ProjectClass{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1alpha1.ProjectClass), err
+}
+
+// List takes label and field selectors, and returns the list of ProjectClasses that match those selectors.
+func (c *FakeProjectClasses) List(ctx context.Context, opts v1.ListOptions) (result *v1alpha1.ProjectClassList, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewListAction(projectclassesResource, projectclassesKind, c.ns, opts), &v1alpha1.ProjectClassList{})
+
+	if obj == nil {
+		return nil, err
+	}
+
+	label, _, _ := testing.ExtractFromListOptions(opts)
+	if label == nil {
+		label = labels.Everything()
+	}
+	list := &v1alpha1.ProjectClassList{ListMeta: obj.(*v1alpha1.ProjectClassList).ListMeta}
+	for _, item := range obj.(*v1alpha1.ProjectClassList).Items {
+		if label.Matches(labels.Set(item.Labels)) {
+			list.Items = append(list.Items, item)
+		}
+	}
+	return list, err
+}
+
+// Watch returns a watch.Interface that watches the requested projectClasses.
+func (c *FakeProjectClasses) Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error) {
+	return c.Fake.
+		InvokesWatch(testing.NewWatchAction(projectclassesResource, c.ns, opts))
+
+}
+
+// Create takes the representation of a projectClass and creates it.  Returns the server's representation of the projectClass, and an error, if there is any.
+func (c *FakeProjectClasses) Create(ctx context.Context, projectClass *v1alpha1.ProjectClass, opts v1.CreateOptions) (result *v1alpha1.ProjectClass, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewCreateAction(projectclassesResource, c.ns, projectClass), &v1alpha1.ProjectClass{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1alpha1.ProjectClass), err
+}
+
+// Update takes the representation of a projectClass and updates it. Returns the server's representation of the projectClass, and an error, if there is any.
+func (c *FakeProjectClasses) Update(ctx context.Context, projectClass *v1alpha1.ProjectClass, opts v1.UpdateOptions) (result *v1alpha1.ProjectClass, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewUpdateAction(projectclassesResource, c.ns, projectClass), &v1alpha1.ProjectClass{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1alpha1.ProjectClass), err
+}
+
+// Delete takes name of the projectClass and deletes it. Returns an error if one occurs.
+func (c *FakeProjectClasses) Delete(ctx context.Context, name string, opts v1.DeleteOptions) error {
+	_, err := c.Fake.
+		Invokes(testing.NewDeleteActionWithOptions(projectclassesResource, c.ns, name, opts), &v1alpha1.ProjectClass{})
+
+	return err
+}
+
+// DeleteCollection deletes a collection of objects.
+func (c *FakeProjectClasses) DeleteCollection(ctx context.Context, opts v1.DeleteOptions, listOpts v1.ListOptions) error {
+	action := testing.NewDeleteCollectionAction(projectclassesResource, c.ns, listOpts)
+
+	_, err := c.Fake.Invokes(action, &v1alpha1.ProjectClassList{})
+	return err
+}
+
+// Patch applies the patch and returns the patched projectClass.
+func (c *FakeProjectClasses) Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *v1alpha1.ProjectClass, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewPatchSubresourceAction(projectclassesResource, c.ns, name, pt, data, subresources...), &v1alpha1.ProjectClass{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1alpha1.ProjectClass), err
+}
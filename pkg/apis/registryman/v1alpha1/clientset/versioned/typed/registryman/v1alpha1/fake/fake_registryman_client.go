@@ -32,14 +32,26 @@ func (c *FakeRegistrymanV1alpha1) Projects(namespace string) v1alpha1.ProjectInt
 	return &FakeProjects{c, namespace}
 }
 
+func (c *FakeRegistrymanV1alpha1) ProjectClasses(namespace string) v1alpha1.ProjectClassInterface {
+	return &FakeProjectClasses{c, namespace}
+}
+
 func (c *FakeRegistrymanV1alpha1) Registries(namespace string) v1alpha1.RegistryInterface {
 	return &FakeRegistries{c, namespace}
 }
 
+func (c *FakeRegistrymanV1alpha1) RobotAccounts(namespace string) v1alpha1.RobotAccountInterface {
+	return &FakeRobotAccounts{c, namespace}
+}
+
 func (c *FakeRegistrymanV1alpha1) Scanners(namespace string) v1alpha1.ScannerInterface {
 	return &FakeScanners{c, namespace}
 }
 
+func (c *FakeRegistrymanV1alpha1) Teams(namespace string) v1alpha1.TeamInterface {
+	return &FakeTeams{c, namespace}
+}
+
 // RESTClient returns a RESTClient that is used to communicate
 // with API server by this client implementation.
 func (c *FakeRegistrymanV1alpha1) RESTClient() rest.Interface {
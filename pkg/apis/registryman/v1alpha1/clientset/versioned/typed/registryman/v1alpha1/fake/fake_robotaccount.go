@@ -0,0 +1,130 @@
+/*
+Copyright 2021 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by client-gen. DO NOT EDIT.
+
+package fake
+
+import (
+	"context"
+
+	v1alpha1 "github.com/kubermatic-labs/registryman/pkg/apis/registryman/v1alpha1"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	labels "k8s.io/apimachinery/pkg/labels"
+	schema "k8s.io/apimachinery/pkg/runtime/schema"
+	types "k8s.io/apimachinery/pkg/types"
+	watch "k8s.io/apimachinery/pkg/watch"
+	testing "k8s.io/client-go/testing"
+)
+
+// FakeRobotAccounts implements RobotAccountInterface
+type FakeRobotAccounts struct {
+	Fake *FakeRegistrymanV1alpha1
+	ns   string
+}
+
+var robotaccountsResource = schema.GroupVersionResource{Group: "registryman.kubermatic.com", Version: "v1alpha1", Resource: "robotaccounts"}
+
+var robotaccountsKind = schema.GroupVersionKind{Group: "registryman.kubermatic.com", Version: "v1alpha1", Kind: "RobotAccount"}
+
+// Get takes name of the robotAccount, and returns the corresponding robotAccount object, and an error if there is any.
+func (c *FakeRobotAccounts) Get(ctx context.Context, name string, options v1.GetOptions) (result *v1alpha1.RobotAccount, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewGetAction(robotaccountsResource, c.ns, name), &v1alpha1.RobotAccount{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1alpha1.RobotAccount), err
+}
+
+// List takes label and field selectors, and returns the list of RobotAccounts that match those selectors.
+func (c *FakeRobotAccounts) List(ctx context.Context, opts v1.ListOptions) (result *v1alpha1.RobotAccountList, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewListAction(robotaccountsResource, robotaccountsKind, c.ns, opts), &v1alpha1.RobotAccountList{})
+
+	if obj == nil {
+		return nil, err
+	}
+
+	label, _, _ := testing.ExtractFromListOptions(opts)
+	if label == nil {
+		label = labels.Everything()
+	}
+	list := &v1alpha1.RobotAccountList{ListMeta: obj.(*v1alpha1.RobotAccountList).ListMeta}
+	for _, item := range obj.(*v1alpha1.RobotAccountList).Items {
+		if label.Matches(labels.Set(item.Labels)) {
+			list.Items = append(list.Items, item)
+		}
+	}
+	return list, err
+}
+
+// Watch returns a watch.Interface that watches the requested robotAccounts.
+func (c *FakeRobotAccounts) Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error) {
+	return c.Fake.
+		InvokesWatch(testing.NewWatchAction(robotaccountsResource, c.ns, opts))
+
+}
+
+// Create takes the representation of a robotAccount and creates it.  Returns the server's representation of the robotAccount, and an error, if there is any.
+func (c *FakeRobotAccounts) Create(ctx context.Context, robotAccount *v1alpha1.RobotAccount, opts v1.CreateOptions) (result *v1alpha1.RobotAccount, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewCreateAction(robotaccountsResource, c.ns, robotAccount), &v1alpha1.RobotAccount{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1alpha1.RobotAccount), err
+}
+
+// Update takes the representation of a robotAccount and updates it. Returns the server's representation of the robotAccount, and an error, if there is any.
+func (c *FakeRobotAccounts) Update(ctx context.Context, robotAccount *v1alpha1.RobotAccount, opts v1.UpdateOptions) (result *v1alpha1.RobotAccount, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewUpdateAction(robotaccountsResource, c.ns, robotAccount), &v1alpha1.RobotAccount{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1alpha1.RobotAccount), err
+}
+
+// Delete takes name of the robotAccount and deletes it. Returns an error if one occurs.
+func (c *FakeRobotAccounts) Delete(ctx context.Context, name string, opts v1.DeleteOptions) error {
+	_, err := c.Fake.
+		Invokes(testing.NewDeleteActionWithOptions(robotaccountsResource, c.ns, name, opts), &v1alpha1.RobotAccount{})
+
+	return err
+}
+
+// DeleteCollection deletes a collection of objects.
+func (c *FakeRobotAccounts) DeleteCollection(ctx context.Context, opts v1.DeleteOptions, listOpts v1.ListOptions) error {
+	action := testing.NewDeleteCollectionAction(robotaccountsResource, c.ns, listOpts)
+
+	_, err := c.Fake.Invokes(action, &v1alpha1.RobotAccountList{})
+	return err
+}
+
+// Patch applies the patch and returns the patched robotAccount.
+func (c *FakeRobotAccounts) Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *v1alpha1.RobotAccount, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewPatchSubresourceAction(robotaccountsResource, c.ns, name, pt, data, subresources...), &v1alpha1.RobotAccount{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1alpha1.RobotAccount), err
+}
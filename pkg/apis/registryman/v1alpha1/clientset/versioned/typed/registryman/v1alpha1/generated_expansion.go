@@ -20,6 +20,12 @@ package v1alpha1
 
 type ProjectExpansion interface{}
 
+type ProjectClassExpansion interface{}
+
 type RegistryExpansion interface{}
 
+type RobotAccountExpansion interface{}
+
 type ScannerExpansion interface{}
+
+type TeamExpansion interface{}
@@ -0,0 +1,178 @@
+/*
+Copyright 2021 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by client-gen. DO NOT EDIT.
+
+package v1alpha1
+
+import (
+	"context"
+	"time"
+
+	v1alpha1 "github.com/kubermatic-labs/registryman/pkg/apis/registryman/v1alpha1"
+	scheme "github.com/kubermatic-labs/registryman/pkg/apis/registryman/v1alpha1/clientset/versioned/scheme"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	types "k8s.io/apimachinery/pkg/types"
+	watch "k8s.io/apimachinery/pkg/watch"
+	rest "k8s.io/client-go/rest"
+)
+
+// ProjectClassesGetter has a method to return a ProjectClassInterface.
+// A group's client should implement this interface.
+type ProjectClassesGetter interface {
+	ProjectClasses(namespace string) ProjectClassInterface
+}
+
+// ProjectClassInterface has methods to work with ProjectClass resources.
+type ProjectClassInterface interface {
+	Create(ctx context.Context, projectClass *v1alpha1.ProjectClass, opts v1.CreateOptions) (*v1alpha1.ProjectClass, error)
+	Update(ctx context.Context, projectClass *v1alpha1.ProjectClass, opts v1.UpdateOptions) (*v1alpha1.ProjectClass, error)
+	Delete(ctx context.Context, name string, opts v1.DeleteOptions) error
+	DeleteCollection(ctx context.Context, opts v1.DeleteOptions, listOpts v1.ListOptions) error
+	Get(ctx context.Context, name string, opts v1.GetOptions) (*v1alpha1.ProjectClass, error)
+	List(ctx context.Context, opts v1.ListOptions) (*v1alpha1.ProjectClassList, error)
+	Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error)
+	Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *v1alpha1.ProjectClass, err error)
+	ProjectClassExpansion
+}
+
+// projectClasses implements ProjectClassInterface
+type projectClasses struct {
+	client rest.Interface
+	ns     string
+}
+
+// newProjectClasses returns a ProjectClasses
+func newProjectClasses(c *RegistrymanV1alpha1Client, namespace string) *projectClasses {
+	return &projectClasses{
+		client: c.RESTClient(),
+		ns:     namespace,
+	}
+}
+
+// Get takes name of the projectClass, and returns the corresponding projectClass object, and an error if there is any.
+func (c *projectClasses) Get(ctx context.Context, name string, options v1.GetOptions) (result *v1alpha1.ProjectClass, err error) {
+	result = &v1alpha1.ProjectClass{}
+	err = c.client.Get().
+		Namespace(c.ns).
+		Resource("projectclasses").
+		Name(name).
+		VersionedParams(&options, scheme.ParameterCodec).
+		Do(ctx).
+		Into(result)
+	return
+}
+
+// List takes label and field selectors, and returns the list of ProjectClasses that match those selectors.
+func (c *projectClasses) List(ctx context.Context, opts v1.ListOptions) (result *v1alpha1.ProjectClassList, err error) {
+	var timeout time.Duration
+	if opts.TimeoutSeconds != nil {
+		timeout = time.Duration(*opts.TimeoutSeconds) * time.Second
+	}
+	result = &v1alpha1.ProjectClassList{}
+	err = c.client.Get().
+		Namespace(c.ns).
+		Resource("projectclasses").
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Timeout(timeout).
+		Do(ctx).
+		Into(result)
+	return
+}
+
+// Watch returns a watch.Interface that watches the requested projectClasses.
+func (c *projectClasses) Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error) {
+	var timeout time.Duration
+	if opts.TimeoutSeconds != nil {
+		timeout = time.Duration(*opts.TimeoutSeconds) * time.Second
+	}
+	opts.Watch = true
+	return c.client.Get().
+		Namespace(c.ns).
+		Resource("projectclasses").
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Timeout(timeout).
+		Watch(ctx)
+}
+
+// Create takes the representation of a projectClass and creates it.  Returns the server's representation of the projectClass, and an error, if there is any.
+func (c *projectClasses) Create(ctx context.Context, projectClass *v1alpha1.ProjectClass, opts v1.CreateOptions) (result *v1alpha1.ProjectClass, err error) {
+	result = &v1alpha1.ProjectClass{}
+	err = c.client.Post().
+		Namespace(c.ns).
+		Resource("projectclasses").
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Body(projectClass).
+		Do(ctx).
+		Into(result)
+	return
+}
+
+// Update takes the representation of a projectClass and updates it. Returns the server's representation of the projectClass, and an error, if there is any.
+func (c *projectClasses) Update(ctx context.Context, projectClass *v1alpha1.ProjectClass, opts v1.UpdateOptions) (result *v1alpha1.ProjectClass, err error) {
+	result = &v1alpha1.ProjectClass{}
+	err = c.client.Put().
+		Namespace(c.ns).
+		Resource("projectclasses").
+		Name(projectClass.Name).
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Body(projectClass).
+		Do(ctx).
+		Into(result)
+	return
+}
+
+// Delete takes name of the projectClass and deletes it. Returns an error if one occurs.
+func (c *projectClasses) Delete(ctx context.Context, name string, opts v1.DeleteOptions) error {
+	return c.client.Delete().
+		Namespace(c.ns).
+		Resource("projectclasses").
+		Name(name).
+		Body(&opts).
+		Do(ctx).
+		Error()
+}
+
+// DeleteCollection deletes a collection of objects.
+func (c *projectClasses) DeleteCollection(ctx context.Context, opts v1.DeleteOptions, listOpts v1.ListOptions) error {
+	var timeout time.Duration
+	if listOpts.TimeoutSeconds != nil {
+		timeout = time.Duration(*listOpts.TimeoutSeconds) * time.Second
+	}
+	return c.client.Delete().
+		Namespace(c.ns).
+		Resource("projectclasses").
+		VersionedParams(&listOpts, scheme.ParameterCodec).
+		Timeout(timeout).
+		Body(&opts).
+		Do(ctx).
+		Error()
+}
+
+// Patch applies the patch and returns the patched projectClass.
+func (c *projectClasses) Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *v1alpha1.ProjectClass, err error) {
+	result = &v1alpha1.ProjectClass{}
+	err = c.client.Patch(pt).
+		Namespace(c.ns).
+		Resource("projectclasses").
+		Name(name).
+		SubResource(subresources...).
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Body(data).
+		Do(ctx).
+		Into(result)
+	return
+}
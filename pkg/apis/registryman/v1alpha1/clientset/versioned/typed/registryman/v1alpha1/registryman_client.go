@@ -29,8 +29,11 @@ import (
 type RegistrymanV1alpha1Interface interface {
 	RESTClient() rest.Interface
 	ProjectsGetter
+	ProjectClassesGetter
 	RegistriesGetter
+	RobotAccountsGetter
 	ScannersGetter
+	TeamsGetter
 }
 
 // RegistrymanV1alpha1Client is used to interact with features provided by the registryman.kubermatic.com group.
@@ -42,14 +45,26 @@ func (c *RegistrymanV1alpha1Client) Projects(namespace string) ProjectInterface
 	return newProjects(c, namespace)
 }
 
+func (c *RegistrymanV1alpha1Client) ProjectClasses(namespace string) ProjectClassInterface {
+	return newProjectClasses(c, namespace)
+}
+
 func (c *RegistrymanV1alpha1Client) Registries(namespace string) RegistryInterface {
 	return newRegistries(c, namespace)
 }
 
+func (c *RegistrymanV1alpha1Client) RobotAccounts(namespace string) RobotAccountInterface {
+	return newRobotAccounts(c, namespace)
+}
+
 func (c *RegistrymanV1alpha1Client) Scanners(namespace string) ScannerInterface {
 	return newScanners(c, namespace)
 }
 
+func (c *RegistrymanV1alpha1Client) Teams(namespace string) TeamInterface {
+	return newTeams(c, namespace)
+}
+
 // NewForConfig creates a new RegistrymanV1alpha1Client for the given config.
 // NewForConfig is equivalent to NewForConfigAndClient(c, httpClient),
 // where httpClient was generated with rest.HTTPClientFor(c).
@@ -55,10 +55,16 @@ func (f *sharedInformerFactory) ForResource(resource schema.GroupVersionResource
 	// Group=registryman.kubermatic.com, Version=v1alpha1
 	case v1alpha1.SchemeGroupVersion.WithResource("projects"):
 		return &genericInformer{resource: resource.GroupResource(), informer: f.Registryman().V1alpha1().Projects().Informer()}, nil
+	case v1alpha1.SchemeGroupVersion.WithResource("projectclasses"):
+		return &genericInformer{resource: resource.GroupResource(), informer: f.Registryman().V1alpha1().ProjectClasses().Informer()}, nil
 	case v1alpha1.SchemeGroupVersion.WithResource("registries"):
 		return &genericInformer{resource: resource.GroupResource(), informer: f.Registryman().V1alpha1().Registries().Informer()}, nil
+	case v1alpha1.SchemeGroupVersion.WithResource("robotaccounts"):
+		return &genericInformer{resource: resource.GroupResource(), informer: f.Registryman().V1alpha1().RobotAccounts().Informer()}, nil
 	case v1alpha1.SchemeGroupVersion.WithResource("scanners"):
 		return &genericInformer{resource: resource.GroupResource(), informer: f.Registryman().V1alpha1().Scanners().Informer()}, nil
+	case v1alpha1.SchemeGroupVersion.WithResource("teams"):
+		return &genericInformer{resource: resource.GroupResource(), informer: f.Registryman().V1alpha1().Teams().Informer()}, nil
 
 	}
 
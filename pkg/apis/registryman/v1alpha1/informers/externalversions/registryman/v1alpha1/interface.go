@@ -26,10 +26,16 @@ import (
 type Interface interface {
 	// Projects returns a ProjectInformer.
 	Projects() ProjectInformer
+	// ProjectClasses returns a ProjectClassInformer.
+	ProjectClasses() ProjectClassInformer
 	// Registries returns a RegistryInformer.
 	Registries() RegistryInformer
+	// RobotAccounts returns a RobotAccountInformer.
+	RobotAccounts() RobotAccountInformer
 	// Scanners returns a ScannerInformer.
 	Scanners() ScannerInformer
+	// Teams returns a TeamInformer.
+	Teams() TeamInformer
 }
 
 type version struct {
@@ -48,12 +54,27 @@ func (v *version) Projects() ProjectInformer {
 	return &projectInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
 }
 
+// ProjectClasses returns a ProjectClassInformer.
+func (v *version) ProjectClasses() ProjectClassInformer {
+	return &projectClassInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
+}
+
 // Registries returns a RegistryInformer.
 func (v *version) Registries() RegistryInformer {
 	return &registryInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
 }
 
+// RobotAccounts returns a RobotAccountInformer.
+func (v *version) RobotAccounts() RobotAccountInformer {
+	return &robotAccountInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
+}
+
 // Scanners returns a ScannerInformer.
 func (v *version) Scanners() ScannerInformer {
 	return &scannerInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
 }
+
+// Teams returns a TeamInformer.
+func (v *version) Teams() TeamInformer {
+	return &teamInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
+}
@@ -26,6 +26,14 @@ type ProjectListerExpansion interface{}
 // ProjectNamespaceLister.
 type ProjectNamespaceListerExpansion interface{}
 
+// ProjectClassListerExpansion allows custom methods to be added to
+// ProjectClassLister.
+type ProjectClassListerExpansion interface{}
+
+// ProjectClassNamespaceListerExpansion allows custom methods to be added to
+// ProjectClassNamespaceLister.
+type ProjectClassNamespaceListerExpansion interface{}
+
 // RegistryListerExpansion allows custom methods to be added to
 // RegistryLister.
 type RegistryListerExpansion interface{}
@@ -34,6 +42,14 @@ type RegistryListerExpansion interface{}
 // RegistryNamespaceLister.
 type RegistryNamespaceListerExpansion interface{}
 
+// RobotAccountListerExpansion allows custom methods to be added to
+// RobotAccountLister.
+type RobotAccountListerExpansion interface{}
+
+// RobotAccountNamespaceListerExpansion allows custom methods to be added to
+// RobotAccountNamespaceLister.
+type RobotAccountNamespaceListerExpansion interface{}
+
 // ScannerListerExpansion allows custom methods to be added to
 // ScannerLister.
 type ScannerListerExpansion interface{}
@@ -41,3 +57,11 @@ type ScannerListerExpansion interface{}
 // ScannerNamespaceListerExpansion allows custom methods to be added to
 // ScannerNamespaceLister.
 type ScannerNamespaceListerExpansion interface{}
+
+// TeamListerExpansion allows custom methods to be added to
+// TeamLister.
+type TeamListerExpansion interface{}
+
+// TeamNamespaceListerExpansion allows custom methods to be added to
+// TeamNamespaceLister.
+type TeamNamespaceListerExpansion interface{}
@@ -0,0 +1,99 @@
+/*
+Copyright 2021 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by lister-gen. DO NOT EDIT.
+
+package v1alpha1
+
+import (
+	v1alpha1 "github.com/kubermatic-labs/registryman/pkg/apis/registryman/v1alpha1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/client-go/tools/cache"
+)
+
+// ProjectClassLister helps list ProjectClasses.
+// All objects returned here must be treated as read-only.
+type ProjectClassLister interface {
+	// List lists all ProjectClasses in the indexer.
+	// Objects returned here must be treated as read-only.
+	List(selector labels.Selector) (ret []*v1alpha1.ProjectClass, err error)
+	// ProjectClasses returns an object that can list and get ProjectClasses.
+	ProjectClasses(namespace string) ProjectClassNamespaceLister
+	ProjectClassListerExpansion
+}
+
+// projectClassLister implements the ProjectClassLister interface.
+type projectClassLister struct {
+	indexer cache.Indexer
+}
+
+// NewProjectClassLister returns a new ProjectClassLister.
+func NewProjectClassLister(indexer cache.Indexer) ProjectClassLister {
+	return &projectClassLister{indexer: indexer}
+}
+
+// List lists all ProjectClasses in the indexer.
+func (s *projectClassLister) List(selector labels.Selector) (ret []*v1alpha1.ProjectClass, err error) {
+	err = cache.ListAll(s.indexer, selector, func(m interface{}) {
+		ret = append(ret, m.(*v1alpha1.ProjectClass))
+	})
+	return ret, err
+}
+
+// ProjectClasses returns an object that can list and get ProjectClasses.
+func (s *projectClassLister) ProjectClasses(namespace string) ProjectClassNamespaceLister {
+	return projectClassNamespaceLister{indexer: s.indexer, namespace: namespace}
+}
+
+// ProjectClassNamespaceLister helps list and get ProjectClasses.
+// All objects returned here must be treated as read-only.
+type ProjectClassNamespaceLister interface {
+	// List lists all ProjectClasses in the indexer for a given namespace.
+	// Objects returned here must be treated as read-only.
+	List(selector labels.Selector) (ret []*v1alpha1.ProjectClass, err error)
+	// Get retrieves the ProjectClass from the indexer for a given namespace and name.
+	// Objects returned here must be treated as read-only.
+	Get(name string) (*v1alpha1.ProjectClass, error)
+	ProjectClassNamespaceListerExpansion
+}
+
+// projectClassNamespaceLister implements the ProjectClassNamespaceLister
+// interface.
+type projectClassNamespaceLister struct {
+	indexer   cache.Indexer
+	namespace string
+}
+
+// List lists all ProjectClasses in the indexer for a given namespace.
+func (s projectClassNamespaceLister) List(selector labels.Selector) (ret []*v1alpha1.ProjectClass, err error) {
+	err = cache.ListAllByNamespace(s.indexer, s.namespace, selector, func(m interface{}) {
+		ret = append(ret, m.(*v1alpha1.ProjectClass))
+	})
+	return ret, err
+}
+
+// Get retrieves the ProjectClass from the indexer for a given namespace and name.
+func (s projectClassNamespaceLister) Get(name string) (*v1alpha1.ProjectClass, error) {
+	obj, exists, err := s.indexer.GetByKey(s.namespace + "/" + name)
+	if err != nil {
+		return nil, err
+	}
+	if !exists {
+		return nil, errors.NewNotFound(v1alpha1.Resource("projectclass"), name)
+	}
+	return obj.(*v1alpha1.ProjectClass), nil
+}
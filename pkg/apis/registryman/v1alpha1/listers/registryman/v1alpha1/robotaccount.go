@@ -0,0 +1,99 @@
+/*
+Copyright 2021 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by lister-gen. DO NOT EDIT.
+
+package v1alpha1
+
+import (
+	v1alpha1 "github.com/kubermatic-labs/registryman/pkg/apis/registryman/v1alpha1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/client-go/tools/cache"
+)
+
+// RobotAccountLister helps list RobotAccounts.
+// All objects returned here must be treated as read-only.
+type RobotAccountLister interface {
+	// List lists all RobotAccounts in the indexer.
+	// Objects returned here must be treated as read-only.
+	List(selector labels.Selector) (ret []*v1alpha1.RobotAccount, err error)
+	// RobotAccounts returns an object that can list and get RobotAccounts.
+	RobotAccounts(namespace string) RobotAccountNamespaceLister
+	RobotAccountListerExpansion
+}
+
+// robotAccountLister implements the RobotAccountLister interface.
+type robotAccountLister struct {
+	indexer cache.Indexer
+}
+
+// NewRobotAccountLister returns a new RobotAccountLister.
+func NewRobotAccountLister(indexer cache.Indexer) RobotAccountLister {
+	return &robotAccountLister{indexer: indexer}
+}
+
+// List lists all RobotAccounts in the indexer.
+func (s *robotAccountLister) List(selector labels.Selector) (ret []*v1alpha1.RobotAccount, err error) {
+	err = cache.ListAll(s.indexer, selector, func(m interface{}) {
+		ret = append(ret, m.(*v1alpha1.RobotAccount))
+	})
+	return ret, err
+}
+
+// RobotAccounts returns an object that can list and get RobotAccounts.
+func (s *robotAccountLister) RobotAccounts(namespace string) RobotAccountNamespaceLister {
+	return robotAccountNamespaceLister{indexer: s.indexer, namespace: namespace}
+}
+
+// RobotAccountNamespaceLister helps list and get RobotAccounts.
+// All objects returned here must be treated as read-only.
+type RobotAccountNamespaceLister interface {
+	// List lists all RobotAccounts in the indexer for a given namespace.
+	// Objects returned here must be treated as read-only.
+	List(selector labels.Selector) (ret []*v1alpha1.RobotAccount, err error)
+	// Get retrieves the RobotAccount from the indexer for a given namespace and name.
+	// Objects returned here must be treated as read-only.
+	Get(name string) (*v1alpha1.RobotAccount, error)
+	RobotAccountNamespaceListerExpansion
+}
+
+// robotAccountNamespaceLister implements the RobotAccountNamespaceLister
+// interface.
+type robotAccountNamespaceLister struct {
+	indexer   cache.Indexer
+	namespace string
+}
+
+// List lists all RobotAccounts in the indexer for a given namespace.
+func (s robotAccountNamespaceLister) List(selector labels.Selector) (ret []*v1alpha1.RobotAccount, err error) {
+	err = cache.ListAllByNamespace(s.indexer, s.namespace, selector, func(m interface{}) {
+		ret = append(ret, m.(*v1alpha1.RobotAccount))
+	})
+	return ret, err
+}
+
+// Get retrieves the RobotAccount from the indexer for a given namespace and name.
+func (s robotAccountNamespaceLister) Get(name string) (*v1alpha1.RobotAccount, error) {
+	obj, exists, err := s.indexer.GetByKey(s.namespace + "/" + name)
+	if err != nil {
+		return nil, err
+	}
+	if !exists {
+		return nil, errors.NewNotFound(v1alpha1.Resource("robotaccount"), name)
+	}
+	return obj.(*v1alpha1.RobotAccount), nil
+}
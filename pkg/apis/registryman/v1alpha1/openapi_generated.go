@@ -30,24 +30,211 @@ import (
 
 func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenAPIDefinition {
 	return map[string]common.OpenAPIDefinition{
-		"github.com/kubermatic-labs/registryman/pkg/apis/registryman/v1alpha1.MemberStatus":          schema_pkg_apis_registryman_v1alpha1_MemberStatus(ref),
-		"github.com/kubermatic-labs/registryman/pkg/apis/registryman/v1alpha1.Project":               schema_pkg_apis_registryman_v1alpha1_Project(ref),
-		"github.com/kubermatic-labs/registryman/pkg/apis/registryman/v1alpha1.ProjectList":           schema_pkg_apis_registryman_v1alpha1_ProjectList(ref),
-		"github.com/kubermatic-labs/registryman/pkg/apis/registryman/v1alpha1.ProjectMember":         schema_pkg_apis_registryman_v1alpha1_ProjectMember(ref),
-		"github.com/kubermatic-labs/registryman/pkg/apis/registryman/v1alpha1.ProjectSpec":           schema_pkg_apis_registryman_v1alpha1_ProjectSpec(ref),
-		"github.com/kubermatic-labs/registryman/pkg/apis/registryman/v1alpha1.ProjectStatus":         schema_pkg_apis_registryman_v1alpha1_ProjectStatus(ref),
-		"github.com/kubermatic-labs/registryman/pkg/apis/registryman/v1alpha1.Registry":              schema_pkg_apis_registryman_v1alpha1_Registry(ref),
-		"github.com/kubermatic-labs/registryman/pkg/apis/registryman/v1alpha1.RegistryCapabilities":  schema_pkg_apis_registryman_v1alpha1_RegistryCapabilities(ref),
-		"github.com/kubermatic-labs/registryman/pkg/apis/registryman/v1alpha1.RegistryList":          schema_pkg_apis_registryman_v1alpha1_RegistryList(ref),
-		"github.com/kubermatic-labs/registryman/pkg/apis/registryman/v1alpha1.RegistrySpec":          schema_pkg_apis_registryman_v1alpha1_RegistrySpec(ref),
-		"github.com/kubermatic-labs/registryman/pkg/apis/registryman/v1alpha1.RegistryStatus":        schema_pkg_apis_registryman_v1alpha1_RegistryStatus(ref),
-		"github.com/kubermatic-labs/registryman/pkg/apis/registryman/v1alpha1.RemoteRegistryStatus":  schema_pkg_apis_registryman_v1alpha1_RemoteRegistryStatus(ref),
-		"github.com/kubermatic-labs/registryman/pkg/apis/registryman/v1alpha1.ReplicationRuleStatus": schema_pkg_apis_registryman_v1alpha1_ReplicationRuleStatus(ref),
-		"github.com/kubermatic-labs/registryman/pkg/apis/registryman/v1alpha1.ReplicationTrigger":    schema_pkg_apis_registryman_v1alpha1_ReplicationTrigger(ref),
-		"github.com/kubermatic-labs/registryman/pkg/apis/registryman/v1alpha1.Scanner":               schema_pkg_apis_registryman_v1alpha1_Scanner(ref),
-		"github.com/kubermatic-labs/registryman/pkg/apis/registryman/v1alpha1.ScannerList":           schema_pkg_apis_registryman_v1alpha1_ScannerList(ref),
-		"github.com/kubermatic-labs/registryman/pkg/apis/registryman/v1alpha1.ScannerSpec":           schema_pkg_apis_registryman_v1alpha1_ScannerSpec(ref),
-		"github.com/kubermatic-labs/registryman/pkg/apis/registryman/v1alpha1.ScannerStatus":         schema_pkg_apis_registryman_v1alpha1_ScannerStatus(ref),
+		"github.com/kubermatic-labs/registryman/pkg/apis/registryman/v1alpha1.CredentialRotation":         schema_pkg_apis_registryman_v1alpha1_CredentialRotation(ref),
+		"github.com/kubermatic-labs/registryman/pkg/apis/registryman/v1alpha1.ImmutableTagPolicy":         schema_pkg_apis_registryman_v1alpha1_ImmutableTagPolicy(ref),
+		"github.com/kubermatic-labs/registryman/pkg/apis/registryman/v1alpha1.ImmutableTagRule":           schema_pkg_apis_registryman_v1alpha1_ImmutableTagRule(ref),
+		"github.com/kubermatic-labs/registryman/pkg/apis/registryman/v1alpha1.ManagedReplicationStatus":   schema_pkg_apis_registryman_v1alpha1_ManagedReplicationStatus(ref),
+		"github.com/kubermatic-labs/registryman/pkg/apis/registryman/v1alpha1.MemberStatus":               schema_pkg_apis_registryman_v1alpha1_MemberStatus(ref),
+		"github.com/kubermatic-labs/registryman/pkg/apis/registryman/v1alpha1.Project":                    schema_pkg_apis_registryman_v1alpha1_Project(ref),
+		"github.com/kubermatic-labs/registryman/pkg/apis/registryman/v1alpha1.ProjectClass":               schema_pkg_apis_registryman_v1alpha1_ProjectClass(ref),
+		"github.com/kubermatic-labs/registryman/pkg/apis/registryman/v1alpha1.ProjectClassList":           schema_pkg_apis_registryman_v1alpha1_ProjectClassList(ref),
+		"github.com/kubermatic-labs/registryman/pkg/apis/registryman/v1alpha1.ProjectClassSpec":           schema_pkg_apis_registryman_v1alpha1_ProjectClassSpec(ref),
+		"github.com/kubermatic-labs/registryman/pkg/apis/registryman/v1alpha1.ProjectList":                schema_pkg_apis_registryman_v1alpha1_ProjectList(ref),
+		"github.com/kubermatic-labs/registryman/pkg/apis/registryman/v1alpha1.ProjectMember":              schema_pkg_apis_registryman_v1alpha1_ProjectMember(ref),
+		"github.com/kubermatic-labs/registryman/pkg/apis/registryman/v1alpha1.ProjectReplication":         schema_pkg_apis_registryman_v1alpha1_ProjectReplication(ref),
+		"github.com/kubermatic-labs/registryman/pkg/apis/registryman/v1alpha1.ProjectSettings":            schema_pkg_apis_registryman_v1alpha1_ProjectSettings(ref),
+		"github.com/kubermatic-labs/registryman/pkg/apis/registryman/v1alpha1.ProjectSpec":                schema_pkg_apis_registryman_v1alpha1_ProjectSpec(ref),
+		"github.com/kubermatic-labs/registryman/pkg/apis/registryman/v1alpha1.ProjectStatus":              schema_pkg_apis_registryman_v1alpha1_ProjectStatus(ref),
+		"github.com/kubermatic-labs/registryman/pkg/apis/registryman/v1alpha1.Registry":                   schema_pkg_apis_registryman_v1alpha1_Registry(ref),
+		"github.com/kubermatic-labs/registryman/pkg/apis/registryman/v1alpha1.RegistryCapabilities":       schema_pkg_apis_registryman_v1alpha1_RegistryCapabilities(ref),
+		"github.com/kubermatic-labs/registryman/pkg/apis/registryman/v1alpha1.RegistryList":               schema_pkg_apis_registryman_v1alpha1_RegistryList(ref),
+		"github.com/kubermatic-labs/registryman/pkg/apis/registryman/v1alpha1.RegistrySpec":               schema_pkg_apis_registryman_v1alpha1_RegistrySpec(ref),
+		"github.com/kubermatic-labs/registryman/pkg/apis/registryman/v1alpha1.RegistryStatus":             schema_pkg_apis_registryman_v1alpha1_RegistryStatus(ref),
+		"github.com/kubermatic-labs/registryman/pkg/apis/registryman/v1alpha1.RemoteRegistryStatus":       schema_pkg_apis_registryman_v1alpha1_RemoteRegistryStatus(ref),
+		"github.com/kubermatic-labs/registryman/pkg/apis/registryman/v1alpha1.ReplicationExecutionStatus": schema_pkg_apis_registryman_v1alpha1_ReplicationExecutionStatus(ref),
+		"github.com/kubermatic-labs/registryman/pkg/apis/registryman/v1alpha1.ReplicationFilters":         schema_pkg_apis_registryman_v1alpha1_ReplicationFilters(ref),
+		"github.com/kubermatic-labs/registryman/pkg/apis/registryman/v1alpha1.ReplicationOptions":         schema_pkg_apis_registryman_v1alpha1_ReplicationOptions(ref),
+		"github.com/kubermatic-labs/registryman/pkg/apis/registryman/v1alpha1.ReplicationRoute":           schema_pkg_apis_registryman_v1alpha1_ReplicationRoute(ref),
+		"github.com/kubermatic-labs/registryman/pkg/apis/registryman/v1alpha1.ReplicationRuleStatus":      schema_pkg_apis_registryman_v1alpha1_ReplicationRuleStatus(ref),
+		"github.com/kubermatic-labs/registryman/pkg/apis/registryman/v1alpha1.ReplicationTrigger":         schema_pkg_apis_registryman_v1alpha1_ReplicationTrigger(ref),
+		"github.com/kubermatic-labs/registryman/pkg/apis/registryman/v1alpha1.RetentionPolicy":            schema_pkg_apis_registryman_v1alpha1_RetentionPolicy(ref),
+		"github.com/kubermatic-labs/registryman/pkg/apis/registryman/v1alpha1.RetentionRule":              schema_pkg_apis_registryman_v1alpha1_RetentionRule(ref),
+		"github.com/kubermatic-labs/registryman/pkg/apis/registryman/v1alpha1.RobotAccount":               schema_pkg_apis_registryman_v1alpha1_RobotAccount(ref),
+		"github.com/kubermatic-labs/registryman/pkg/apis/registryman/v1alpha1.RobotAccountList":           schema_pkg_apis_registryman_v1alpha1_RobotAccountList(ref),
+		"github.com/kubermatic-labs/registryman/pkg/apis/registryman/v1alpha1.RobotAccountProject":        schema_pkg_apis_registryman_v1alpha1_RobotAccountProject(ref),
+		"github.com/kubermatic-labs/registryman/pkg/apis/registryman/v1alpha1.RobotAccountSpec":           schema_pkg_apis_registryman_v1alpha1_RobotAccountSpec(ref),
+		"github.com/kubermatic-labs/registryman/pkg/apis/registryman/v1alpha1.RobotAccountStatus":         schema_pkg_apis_registryman_v1alpha1_RobotAccountStatus(ref),
+		"github.com/kubermatic-labs/registryman/pkg/apis/registryman/v1alpha1.RoleMapping":                schema_pkg_apis_registryman_v1alpha1_RoleMapping(ref),
+		"github.com/kubermatic-labs/registryman/pkg/apis/registryman/v1alpha1.Scanner":                    schema_pkg_apis_registryman_v1alpha1_Scanner(ref),
+		"github.com/kubermatic-labs/registryman/pkg/apis/registryman/v1alpha1.ScannerList":                schema_pkg_apis_registryman_v1alpha1_ScannerList(ref),
+		"github.com/kubermatic-labs/registryman/pkg/apis/registryman/v1alpha1.ScannerSpec":                schema_pkg_apis_registryman_v1alpha1_ScannerSpec(ref),
+		"github.com/kubermatic-labs/registryman/pkg/apis/registryman/v1alpha1.ScannerStatus":              schema_pkg_apis_registryman_v1alpha1_ScannerStatus(ref),
+		"github.com/kubermatic-labs/registryman/pkg/apis/registryman/v1alpha1.ScheduledReplicationStatus": schema_pkg_apis_registryman_v1alpha1_ScheduledReplicationStatus(ref),
+		"github.com/kubermatic-labs/registryman/pkg/apis/registryman/v1alpha1.SecretKeyRef":               schema_pkg_apis_registryman_v1alpha1_SecretKeyRef(ref),
+		"github.com/kubermatic-labs/registryman/pkg/apis/registryman/v1alpha1.Team":                       schema_pkg_apis_registryman_v1alpha1_Team(ref),
+		"github.com/kubermatic-labs/registryman/pkg/apis/registryman/v1alpha1.TeamList":                   schema_pkg_apis_registryman_v1alpha1_TeamList(ref),
+		"github.com/kubermatic-labs/registryman/pkg/apis/registryman/v1alpha1.TeamSpec":                   schema_pkg_apis_registryman_v1alpha1_TeamSpec(ref),
+		"github.com/kubermatic-labs/registryman/pkg/apis/registryman/v1alpha1.UserGroupStatus":            schema_pkg_apis_registryman_v1alpha1_UserGroupStatus(ref),
+		"github.com/kubermatic-labs/registryman/pkg/apis/registryman/v1alpha1.WebhookStatus":              schema_pkg_apis_registryman_v1alpha1_WebhookStatus(ref),
+		"github.com/kubermatic-labs/registryman/pkg/apis/registryman/v1alpha1.WebhookTarget":              schema_pkg_apis_registryman_v1alpha1_WebhookTarget(ref),
+	}
+}
+
+func schema_pkg_apis_registryman_v1alpha1_CredentialRotation(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "CredentialRotation describes the lifetime of the robot credentials and when they are rotated.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"expiresIn": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ExpiresIn is the lifetime of the robot in days. The robot never expires, if omitted.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"rotateEvery": {
+						SchemaProps: spec.SchemaProps{
+							Description: "RotateEvery is the number of days after which the secret of the robot is rotated. The secret is not rotated periodically, if omitted.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"rotateBefore": {
+						SchemaProps: spec.SchemaProps{
+							Description: "RotateBefore is the number of days before the expiration when the robot is renewed. Defaults to 7 days, but at most the half of the lifetime.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func schema_pkg_apis_registryman_v1alpha1_ImmutableTagPolicy(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "ImmutableTagPolicy describes the immutable tag rules of a project. A tag is immutable if any of the rules matches it.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"rules": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-type": "atomic",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "Rules of the immutable tag policy.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/kubermatic-labs/registryman/pkg/apis/registryman/v1alpha1.ImmutableTagRule"),
+									},
+								},
+							},
+						},
+					},
+				},
+				Required: []string{"rules"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/kubermatic-labs/registryman/pkg/apis/registryman/v1alpha1.ImmutableTagRule"},
+	}
+}
+
+func schema_pkg_apis_registryman_v1alpha1_ImmutableTagRule(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "ImmutableTagRule selects the tags that are immutable.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"repositories": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Repositories is a doublestar pattern that selects the repositories of the project, e.g. \"backend/**\". All repositories are selected if it is not set.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"tags": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Tags is a doublestar pattern that selects the immutable tags, e.g. \"v*\".",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"tags"},
+			},
+		},
+	}
+}
+
+func schema_pkg_apis_registryman_v1alpha1_ManagedReplicationStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "ManagedReplicationStatus describes the state of a replication that is performed by registryman.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"project": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Project is the name of the replicated project.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"source": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Source is the name of the registry where the project is replicated from.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"lastRunTime": {
+						SchemaProps: spec.SchemaProps{
+							Description: "LastRunTime shows when the last synchronization was started.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+					"lastSyncTime": {
+						SchemaProps: spec.SchemaProps{
+							Description: "LastSyncTime shows when the project was last synchronized successfully.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+					"syncedDigests": {
+						SchemaProps: spec.SchemaProps{
+							Description: "SyncedDigests maps the synchronized images, in repository:tag format, to the digest of their manifest at the last synchronization. The images whose digest has not changed are not copied again.",
+							Type:        []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+				},
+				Required: []string{"project", "source"},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
 	}
 }
 
@@ -89,10 +276,52 @@ func schema_pkg_apis_registryman_v1alpha1_MemberStatus(ref common.ReferenceCallb
 							Format:      "",
 						},
 					},
+					"groupType": {
+						SchemaProps: spec.SchemaProps{
+							Description: "GroupType is the type of a group member, e.g. LDAP or OIDC. Empty for the other member types.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"rotation": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Rotation is the expected credential rotation policy of a robot member. The actual status shows only the lifetime of the credentials.",
+							Ref:         ref("github.com/kubermatic-labs/registryman/pkg/apis/registryman/v1alpha1.CredentialRotation"),
+						},
+					},
+					"expiresAt": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ExpiresAt shows when the credentials of a robot member expire. It is not set when the credentials never expire.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+					"lastRotationTime": {
+						SchemaProps: spec.SchemaProps{
+							Description: "LastRotationTime shows when the credentials of a robot member were created or last rotated. It is read from the Secret containing the credentials.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+					"targetNamespaces": {
+						SchemaProps: spec.SchemaProps{
+							Description: "TargetNamespaces lists the namespaces where the pull secret of a robot member is expected to be copied to. The actual status is read from the Secret containing the credentials.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
 				},
 				Required: []string{"name", "type", "role"},
 			},
 		},
+		Dependencies: []string{
+			"github.com/kubermatic-labs/registryman/pkg/apis/registryman/v1alpha1.CredentialRotation", "k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
 	}
 }
 
@@ -137,11 +366,11 @@ func schema_pkg_apis_registryman_v1alpha1_Project(ref common.ReferenceCallback)
 	}
 }
 
-func schema_pkg_apis_registryman_v1alpha1_ProjectList(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_pkg_apis_registryman_v1alpha1_ProjectClass(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "ProjectList collects Registry resources.",
+				Description: "ProjectClass resource describes the defaults of the Projects referring to it.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
 					"kind": {
@@ -161,126 +390,110 @@ func schema_pkg_apis_registryman_v1alpha1_ProjectList(ref common.ReferenceCallba
 					"metadata": {
 						SchemaProps: spec.SchemaProps{
 							Default: map[string]interface{}{},
-							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"),
+							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"),
 						},
 					},
-					"items": {
+					"spec": {
 						SchemaProps: spec.SchemaProps{
-							Type: []string{"array"},
-							Items: &spec.SchemaOrArray{
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Default: map[string]interface{}{},
-										Ref:     ref("github.com/kubermatic-labs/registryman/pkg/apis/registryman/v1alpha1.Project"),
-									},
-								},
-							},
+							Description: "Spec describes the ProjectClass Specification.",
+							Ref:         ref("github.com/kubermatic-labs/registryman/pkg/apis/registryman/v1alpha1.ProjectClassSpec"),
 						},
 					},
 				},
-				Required: []string{"items"},
+				Required: []string{"spec"},
 			},
 		},
 		Dependencies: []string{
-			"github.com/kubermatic-labs/registryman/pkg/apis/registryman/v1alpha1.Project", "k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"},
+			"github.com/kubermatic-labs/registryman/pkg/apis/registryman/v1alpha1.ProjectClassSpec", "k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"},
 	}
 }
 
-func schema_pkg_apis_registryman_v1alpha1_ProjectMember(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_pkg_apis_registryman_v1alpha1_ProjectClassList(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "ProjectMember reprensents a User, Group or Robot user of a Project.",
+				Description: "ProjectClassList collects ProjectClass resources.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"type": {
+					"kind": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Type of the project member, e.g. User, Group, Robot. If not set, the default value (User) is applied.",
-							Type:        []string{"integer"},
-							Format:      "int32",
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"name": {
+					"apiVersion": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Name of the project member",
-							Default:     "",
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"role": {
+					"metadata": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Role of the project member, e.g. Developer, Maintainer, etc.\n\nThe possible values depend on the value of the Type field.",
-							Default:     0,
-							Type:        []string{"integer"},
-							Format:      "int32",
+							Default: map[string]interface{}{},
+							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"),
 						},
 					},
-					"dn": {
+					"items": {
 						SchemaProps: spec.SchemaProps{
-							Description: "DN is optional distinguished name of the user. Used with LDAP integration.",
-							Type:        []string{"string"},
-							Format:      "",
+							Type: []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/kubermatic-labs/registryman/pkg/apis/registryman/v1alpha1.ProjectClass"),
+									},
+								},
+							},
 						},
 					},
 				},
-				Required: []string{"name", "role"},
+				Required: []string{"items"},
 			},
 		},
+		Dependencies: []string{
+			"github.com/kubermatic-labs/registryman/pkg/apis/registryman/v1alpha1.ProjectClass", "k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"},
 	}
 }
 
-func schema_pkg_apis_registryman_v1alpha1_ProjectSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_pkg_apis_registryman_v1alpha1_ProjectClassSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "ProjectSpec describes the spec field of the Project resource",
+				Description: "ProjectClassSpec describes the defaults of the projects. The meaning of the fields is the same as in ProjectSpec.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
 					"type": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Type selects whether the project is global or local.",
-							Default:     0,
+							Description: "Type specifies the default type of the projects.",
 							Type:        []string{"integer"},
 							Format:      "int32",
 						},
 					},
-					"localRegistries": {
-						VendorExtensible: spec.VendorExtensible{
-							Extensions: spec.Extensions{
-								"x-kubernetes-list-type": "set",
-							},
-						},
+					"members": {
 						SchemaProps: spec.SchemaProps{
-							Description: "LocalRegistries lists the registry names at which the local project shall be provisioned at.",
+							Description: "Members enumerates the default members of the projects. They are added to the members of the project unless a member of the same type and name is listed in the project.",
 							Type:        []string{"array"},
 							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
-										Default: "",
-										Type:    []string{"string"},
-										Format:  "",
+										Ref: ref("github.com/kubermatic-labs/registryman/pkg/apis/registryman/v1alpha1.ProjectMember"),
 									},
 								},
 							},
 						},
 					},
-					"members": {
-						VendorExtensible: spec.VendorExtensible{
-							Extensions: spec.Extensions{
-								"x-kubernetes-list-map-keys": []interface{}{
-									"name",
-								},
-								"x-kubernetes-list-type": "map",
-							},
-						},
+					"teams": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Members enumerates the project members and their capabilities provisioned for the specific registry.",
+							Description: "Teams lists the names of the Team resources which are added to the teams of the projects.",
 							Type:        []string{"array"},
 							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
-										Ref: ref("github.com/kubermatic-labs/registryman/pkg/apis/registryman/v1alpha1.ProjectMember"),
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
 									},
 								},
 							},
@@ -288,45 +501,51 @@ func schema_pkg_apis_registryman_v1alpha1_ProjectSpec(ref common.ReferenceCallba
 					},
 					"scanner": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Scanner specifies the name of the assigned scanner.",
+							Description: "Scanner specifies the name of the default scanner.",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
 					"trigger": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Trigger specifies the preferred replication trigger. If it is not possible to implement the selected replication trigger, the trigger may be overridden.",
+							Description: "Trigger specifies the default replication trigger.",
 							Default:     map[string]interface{}{},
 							Ref:         ref("github.com/kubermatic-labs/registryman/pkg/apis/registryman/v1alpha1.ReplicationTrigger"),
 						},
 					},
-				},
-				Required: []string{"type"},
-			},
-		},
-		Dependencies: []string{
-			"github.com/kubermatic-labs/registryman/pkg/apis/registryman/v1alpha1.ProjectMember", "github.com/kubermatic-labs/registryman/pkg/apis/registryman/v1alpha1.ReplicationTrigger"},
-	}
-}
-
-func schema_pkg_apis_registryman_v1alpha1_ProjectStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
-	return common.OpenAPIDefinition{
-		Schema: spec.Schema{
-			SchemaProps: spec.SchemaProps{
-				Description: "ProjectStatus specifies the status of a registry project.",
-				Type:        []string{"object"},
-				Properties: map[string]spec.Schema{
-					"name": {
+					"replication": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Name of the project.",
-							Default:     "",
-							Type:        []string{"string"},
-							Format:      "",
+							Description: "Replication specifies the default replication of the projects.",
+							Ref:         ref("github.com/kubermatic-labs/registryman/pkg/apis/registryman/v1alpha1.ProjectReplication"),
 						},
 					},
-					"members": {
-						VendorExtensible: spec.VendorExtensible{
-							Extensions: spec.Extensions{
+					"storageQuota": {
+						SchemaProps: spec.SchemaProps{
+							Description: "StorageQuota specifies the default storage quota of the projects.",
+							Ref:         ref("k8s.io/apimachinery/pkg/api/resource.Quantity"),
+						},
+					},
+					"settings": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Settings specifies the default project level settings.",
+							Ref:         ref("github.com/kubermatic-labs/registryman/pkg/apis/registryman/v1alpha1.ProjectSettings"),
+						},
+					},
+					"retention": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Retention specifies the default tag retention policy.",
+							Ref:         ref("github.com/kubermatic-labs/registryman/pkg/apis/registryman/v1alpha1.RetentionPolicy"),
+						},
+					},
+					"immutableTags": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ImmutableTags specifies the default immutable tag policy.",
+							Ref:         ref("github.com/kubermatic-labs/registryman/pkg/apis/registryman/v1alpha1.ImmutableTagPolicy"),
+						},
+					},
+					"webhooks": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
 								"x-kubernetes-list-map-keys": []interface{}{
 									"name",
 								},
@@ -334,66 +553,1503 @@ func schema_pkg_apis_registryman_v1alpha1_ProjectStatus(ref common.ReferenceCall
 							},
 						},
 						SchemaProps: spec.SchemaProps{
-							Description: "Members of the project.",
+							Description: "Webhooks specifies the default notification targets of the projects.",
 							Type:        []string{"array"},
 							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
 										Default: map[string]interface{}{},
-										Ref:     ref("github.com/kubermatic-labs/registryman/pkg/apis/registryman/v1alpha1.MemberStatus"),
+										Ref:     ref("github.com/kubermatic-labs/registryman/pkg/apis/registryman/v1alpha1.WebhookTarget"),
 									},
 								},
 							},
 						},
 					},
-					"replicationRules": {
+				},
+			},
+		},
+		Dependencies: []string{
+			"github.com/kubermatic-labs/registryman/pkg/apis/registryman/v1alpha1.ImmutableTagPolicy", "github.com/kubermatic-labs/registryman/pkg/apis/registryman/v1alpha1.ProjectMember", "github.com/kubermatic-labs/registryman/pkg/apis/registryman/v1alpha1.ProjectReplication", "github.com/kubermatic-labs/registryman/pkg/apis/registryman/v1alpha1.ProjectSettings", "github.com/kubermatic-labs/registryman/pkg/apis/registryman/v1alpha1.ReplicationTrigger", "github.com/kubermatic-labs/registryman/pkg/apis/registryman/v1alpha1.RetentionPolicy", "github.com/kubermatic-labs/registryman/pkg/apis/registryman/v1alpha1.WebhookTarget", "k8s.io/apimachinery/pkg/api/resource.Quantity"},
+	}
+}
+
+func schema_pkg_apis_registryman_v1alpha1_ProjectList(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "ProjectList collects Registry resources.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"apiVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"metadata": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"),
+						},
+					},
+					"items": {
+						SchemaProps: spec.SchemaProps{
+							Type: []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/kubermatic-labs/registryman/pkg/apis/registryman/v1alpha1.Project"),
+									},
+								},
+							},
+						},
+					},
+				},
+				Required: []string{"items"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/kubermatic-labs/registryman/pkg/apis/registryman/v1alpha1.Project", "k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"},
+	}
+}
+
+func schema_pkg_apis_registryman_v1alpha1_ProjectMember(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "ProjectMember reprensents a User, Group or Robot user of a Project.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"type": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Type of the project member, e.g. User, Group, Robot. If not set, the default value (User) is applied.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"name": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Name of the project member",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"role": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Role of the project member, e.g. Developer, Maintainer, etc.\n\nThe possible values depend on the value of the Type field.",
+							Default:     0,
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"dn": {
+						SchemaProps: spec.SchemaProps{
+							Description: "DN is optional distinguished name of the user. Used with LDAP integration.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"groupType": {
+						SchemaProps: spec.SchemaProps{
+							Description: "GroupType selects the identity provider of a Group member. If omitted, LDAP is assumed. LDAP groups are identified by their DN, OIDC groups by their name. It is ignored for the other member types.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"rotation": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Rotation describes the expiration and the credential rotation policy of a Robot member. It is ignored for the other member types.",
+							Ref:         ref("github.com/kubermatic-labs/registryman/pkg/apis/registryman/v1alpha1.CredentialRotation"),
+						},
+					},
+					"targetNamespaces": {
+						SchemaProps: spec.SchemaProps{
+							Description: "TargetNamespaces lists the namespaces where the pull secret of a Robot member is copied to. It is ignored for the other member types.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+				},
+				Required: []string{"name", "role"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/kubermatic-labs/registryman/pkg/apis/registryman/v1alpha1.CredentialRotation"},
+	}
+}
+
+func schema_pkg_apis_registryman_v1alpha1_ProjectReplication(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "ProjectReplication describes the replication topology of a project.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"routes": {
 						VendorExtensible: spec.VendorExtensible{
 							Extensions: spec.Extensions{
 								"x-kubernetes-list-type": "atomic",
 							},
 						},
 						SchemaProps: spec.SchemaProps{
-							Description: "Replication rules of the project.",
+							Description: "Routes lists the registry pairs between which the repositories of the project are replicated. Routes can be chained, e.g. from the hub to a regional registry and from the regional registry to an edge registry. If it is empty, the default topology of the project type is used.",
 							Type:        []string{"array"},
 							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
 										Default: map[string]interface{}{},
-										Ref:     ref("github.com/kubermatic-labs/registryman/pkg/apis/registryman/v1alpha1.ReplicationRuleStatus"),
+										Ref:     ref("github.com/kubermatic-labs/registryman/pkg/apis/registryman/v1alpha1.ReplicationRoute"),
+									},
+								},
+							},
+						},
+					},
+					"exclude": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-type": "set",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "Exclude lists the registries which do not take part in the replication of the project.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
 									},
 								},
 							},
 						},
 					},
-					"storageUsed": {
+					"filters": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Filters selects the artifacts of the project that are replicated. If it is not set, all artifacts are replicated.",
+							Ref:         ref("github.com/kubermatic-labs/registryman/pkg/apis/registryman/v1alpha1.ReplicationFilters"),
+						},
+					},
+					"deletion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Deletion shows whether the deletion of an artifact is replicated too. If it is not set, the deletions are replicated.",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+					"override": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Override shows whether the artifacts of the destination registry are overwritten if they already exist. If it is not set, the artifacts are overwritten.",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"github.com/kubermatic-labs/registryman/pkg/apis/registryman/v1alpha1.ReplicationFilters", "github.com/kubermatic-labs/registryman/pkg/apis/registryman/v1alpha1.ReplicationRoute"},
+	}
+}
+
+func schema_pkg_apis_registryman_v1alpha1_ProjectSettings(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "ProjectSettings describes the project level settings of a registry.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"public": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Public shows whether the repositories of the project can be pulled without authentication.",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+					"autoScan": {
+						SchemaProps: spec.SchemaProps{
+							Description: "AutoScan shows whether the images are scanned for vulnerabilities automatically when they are pushed.",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+					"enableContentTrust": {
+						SchemaProps: spec.SchemaProps{
+							Description: "EnableContentTrust shows whether only signed images can be pulled.",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+					"preventVulnerableImages": {
+						SchemaProps: spec.SchemaProps{
+							Description: "PreventVulnerableImages shows whether the images with vulnerabilities of at least the configured Severity are prevented from being pulled.",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+					"severity": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Severity is the vulnerability severity threshold used when PreventVulnerableImages is set.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func schema_pkg_apis_registryman_v1alpha1_ProjectSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "ProjectSpec describes the spec field of the Project resource",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"type": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Type selects whether the project is global or local. If it is not set, the type of the project class is used, or Global if the project class does not set it either.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"projectClass": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ProjectClass is the name of the ProjectClass resource whose defaults are applied to the project. The fields set in the project take precedence over the defaults. If it is not set, the project class is selected by the registryman.kubermatic.com/projectClass label.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"localRegistries": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-type": "set",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "LocalRegistries lists the registry names at which the local project shall be provisioned at.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+					"localRegistrySelector": {
+						SchemaProps: spec.SchemaProps{
+							Description: "LocalRegistrySelector selects the Local registries by their labels at which the local project shall be provisioned at, in addition to the registries listed in LocalRegistries.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.LabelSelector"),
+						},
+					},
+					"hub": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Hub is the name of the GlobalHub registry of a global project. The repositories of the project are replicated between the hub and the local registries. If it is not set, the default hub is used, i.e. the only GlobalHub registry or the one annotated with registryman.kubermatic.com/defaultHub: \"true\".",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"members": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-map-keys": []interface{}{
+									"name",
+								},
+								"x-kubernetes-list-type": "map",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "Members enumerates the project members and their capabilities provisioned for the specific registry.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Ref: ref("github.com/kubermatic-labs/registryman/pkg/apis/registryman/v1alpha1.ProjectMember"),
+									},
+								},
+							},
+						},
+					},
+					"teams": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Teams lists the names of the Team resources whose members are the members of the project, too. If a member is listed both inline and in a team, the inline member takes precedence. If a member is listed in several teams, the first team takes precedence.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+					"scanner": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Scanner specifies the name of the assigned scanner.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"trigger": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Trigger specifies the preferred replication trigger. If it is not possible to implement the selected replication trigger, the trigger may be overridden.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("github.com/kubermatic-labs/registryman/pkg/apis/registryman/v1alpha1.ReplicationTrigger"),
+						},
+					},
+					"replication": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Replication specifies between which registries the repositories of the project are replicated. If it is not set, the repositories of a global project are replicated from its hub to the local registries and the local projects are not replicated.",
+							Ref:         ref("github.com/kubermatic-labs/registryman/pkg/apis/registryman/v1alpha1.ProjectReplication"),
+						},
+					},
+					"storageQuota": {
+						SchemaProps: spec.SchemaProps{
+							Description: "StorageQuota limits the storage the project can use, e.g. 10Gi. If it is not set, the storage of the project is not limited.",
+							Ref:         ref("k8s.io/apimachinery/pkg/api/resource.Quantity"),
+						},
+					},
+					"settings": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Settings specifies the project level settings, like visibility or vulnerability scanning. If it is not set, the settings of the project are not managed.",
+							Ref:         ref("github.com/kubermatic-labs/registryman/pkg/apis/registryman/v1alpha1.ProjectSettings"),
+						},
+					},
+					"retention": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Retention specifies which tags of the project shall be kept. If it is not set, the tag retention of the project is not managed.",
+							Ref:         ref("github.com/kubermatic-labs/registryman/pkg/apis/registryman/v1alpha1.RetentionPolicy"),
+						},
+					},
+					"immutableTags": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ImmutableTags specifies the tags of the project that cannot be overwritten or deleted. If it is not set, the immutable tag rules of the project are not managed.",
+							Ref:         ref("github.com/kubermatic-labs/registryman/pkg/apis/registryman/v1alpha1.ImmutableTagPolicy"),
+						},
+					},
+					"webhooks": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-map-keys": []interface{}{
+									"name",
+								},
+								"x-kubernetes-list-type": "map",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "Webhooks specifies the notification targets of the project. If it is not set, the webhooks of the project are not managed.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/kubermatic-labs/registryman/pkg/apis/registryman/v1alpha1.WebhookTarget"),
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"github.com/kubermatic-labs/registryman/pkg/apis/registryman/v1alpha1.ImmutableTagPolicy", "github.com/kubermatic-labs/registryman/pkg/apis/registryman/v1alpha1.ProjectMember", "github.com/kubermatic-labs/registryman/pkg/apis/registryman/v1alpha1.ProjectReplication", "github.com/kubermatic-labs/registryman/pkg/apis/registryman/v1alpha1.ProjectSettings", "github.com/kubermatic-labs/registryman/pkg/apis/registryman/v1alpha1.ReplicationTrigger", "github.com/kubermatic-labs/registryman/pkg/apis/registryman/v1alpha1.RetentionPolicy", "github.com/kubermatic-labs/registryman/pkg/apis/registryman/v1alpha1.WebhookTarget", "k8s.io/apimachinery/pkg/api/resource.Quantity", "k8s.io/apimachinery/pkg/apis/meta/v1.LabelSelector"},
+	}
+}
+
+func schema_pkg_apis_registryman_v1alpha1_ProjectStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "ProjectStatus specifies the status of a registry project.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"name": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Name of the project.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"members": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-map-keys": []interface{}{
+									"name",
+								},
+								"x-kubernetes-list-type": "map",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "Members of the project.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/kubermatic-labs/registryman/pkg/apis/registryman/v1alpha1.MemberStatus"),
+									},
+								},
+							},
+						},
+					},
+					"replicationRules": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-type": "atomic",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "Replication rules of the project.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/kubermatic-labs/registryman/pkg/apis/registryman/v1alpha1.ReplicationRuleStatus"),
+									},
+								},
+							},
+						},
+					},
+					"storageUsed": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Storage used by the project in bytes.",
+							Default:     0,
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"storageQuota": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Storage quota of the project in bytes. -1 means that the storage of the project is not limited.",
+							Default:     0,
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"settings": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Settings of the project. Empty when the settings are not managed.",
+							Ref:         ref("github.com/kubermatic-labs/registryman/pkg/apis/registryman/v1alpha1.ProjectSettings"),
+						},
+					},
+					"retention": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Tag retention policy of the project. Empty when the tag retention is not managed.",
+							Ref:         ref("github.com/kubermatic-labs/registryman/pkg/apis/registryman/v1alpha1.RetentionPolicy"),
+						},
+					},
+					"immutableTags": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Immutable tag rules of the project. Empty when the immutable tag rules are not managed.",
+							Ref:         ref("github.com/kubermatic-labs/registryman/pkg/apis/registryman/v1alpha1.ImmutableTagPolicy"),
+						},
+					},
+					"webhooks": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-map-keys": []interface{}{
+									"name",
+								},
+								"x-kubernetes-list-type": "map",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "Webhook notifications of the project. Empty when the webhooks are not managed.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/kubermatic-labs/registryman/pkg/apis/registryman/v1alpha1.WebhookStatus"),
+									},
+								},
+							},
+						},
+					},
+					"scannerStatus": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Scanner of the project.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("github.com/kubermatic-labs/registryman/pkg/apis/registryman/v1alpha1.ScannerStatus"),
+						},
+					},
+				},
+				Required: []string{"name", "members", "replicationRules", "storageUsed", "storageQuota", "scannerStatus"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/kubermatic-labs/registryman/pkg/apis/registryman/v1alpha1.ImmutableTagPolicy", "github.com/kubermatic-labs/registryman/pkg/apis/registryman/v1alpha1.MemberStatus", "github.com/kubermatic-labs/registryman/pkg/apis/registryman/v1alpha1.ProjectSettings", "github.com/kubermatic-labs/registryman/pkg/apis/registryman/v1alpha1.ReplicationRuleStatus", "github.com/kubermatic-labs/registryman/pkg/apis/registryman/v1alpha1.RetentionPolicy", "github.com/kubermatic-labs/registryman/pkg/apis/registryman/v1alpha1.ScannerStatus", "github.com/kubermatic-labs/registryman/pkg/apis/registryman/v1alpha1.WebhookStatus"},
+	}
+}
+
+func schema_pkg_apis_registryman_v1alpha1_Registry(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "Registry describes the expected state of a registry Object",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"apiVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"metadata": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"),
+						},
+					},
+					"spec": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Spec describes the Registry Specification.",
+							Ref:         ref("github.com/kubermatic-labs/registryman/pkg/apis/registryman/v1alpha1.RegistrySpec"),
+						},
+					},
+					"status": {
+						SchemaProps: spec.SchemaProps{
+							Ref: ref("github.com/kubermatic-labs/registryman/pkg/apis/registryman/v1alpha1.RegistryStatus"),
+						},
+					},
+				},
+				Required: []string{"spec"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/kubermatic-labs/registryman/pkg/apis/registryman/v1alpha1.RegistrySpec", "github.com/kubermatic-labs/registryman/pkg/apis/registryman/v1alpha1.RegistryStatus", "k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"},
+	}
+}
+
+func schema_pkg_apis_registryman_v1alpha1_RegistryCapabilities(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Type: []string{"object"},
+				Properties: map[string]spec.Schema{
+					"canCreateProject": {
+						SchemaProps: spec.SchemaProps{
+							Description: "CanCreateProject shows whether the registry can create projects.",
+							Default:     false,
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+					"canDeleteProject": {
+						SchemaProps: spec.SchemaProps{
+							Description: "CanDeleteProject shows whether the registry can delete projects.",
+							Default:     false,
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+					"canPullReplicate": {
+						SchemaProps: spec.SchemaProps{
+							Description: "CanPullReplicate shows whether the registry can pull repositories from remote registries.",
+							Default:     false,
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+					"canPushReplicate": {
+						SchemaProps: spec.SchemaProps{
+							Description: "CanPushReplicate shows whether the registry can push repositories from remote registries.",
+							Default:     false,
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+					"canManipulateProjectMembers": {
+						SchemaProps: spec.SchemaProps{
+							Description: "CanManipulateProjectMembers shows whether the registry can add/remove members to the projects.",
+							Default:     false,
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+					"canManipulateScanners": {
+						SchemaProps: spec.SchemaProps{
+							Description: "CanManipulateProjectScanners shows whether the registry can add/remove scanners to the projects.",
+							Default:     false,
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+					"canManipulateReplicationRules": {
+						SchemaProps: spec.SchemaProps{
+							Description: "CanManipulateProjectReplicationRules shows whether the registry can add/remove replication rules to the projects.",
+							Default:     false,
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+					"hasProjectMembers": {
+						SchemaProps: spec.SchemaProps{
+							Description: "HasProjectMembers shows whether the registry understands the concept of project membership.",
+							Default:     false,
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+					"canRotateProjectMemberCredentials": {
+						SchemaProps: spec.SchemaProps{
+							Description: "CanRotateProjectMemberCredentials shows whether the registry can rotate the credentials of the project members.",
+							Default:     false,
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+					"hasProjectScanners": {
+						SchemaProps: spec.SchemaProps{
+							Description: "HasProjectScanners shows whether the registry understands the concept of project level vulnerability scanners.",
+							Default:     false,
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+					"hasProjectReplicationRules": {
+						SchemaProps: spec.SchemaProps{
+							Description: "HasProjectReplicationRules shows whether the registry understands the concept of project level replication rules.",
+							Default:     false,
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+					"hasProjectStorageReport": {
+						SchemaProps: spec.SchemaProps{
+							Description: "HasProjectStorageReport shows whether the registry understands the concept of project level storage reporting.",
+							Default:     false,
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+					"hasProjectStorageQuota": {
+						SchemaProps: spec.SchemaProps{
+							Description: "HasProjectStorageQuota shows whether the registry understands the concept of project level storage quotas.",
+							Default:     false,
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+					"canManipulateProjectStorageQuota": {
+						SchemaProps: spec.SchemaProps{
+							Description: "CanManipulateProjectStorageQuota shows whether the registry can change the storage quota of the projects.",
+							Default:     false,
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+					"hasProjectSettings": {
+						SchemaProps: spec.SchemaProps{
+							Description: "HasProjectSettings shows whether the registry understands the concept of project level settings.",
+							Default:     false,
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+					"canManipulateProjectSettings": {
+						SchemaProps: spec.SchemaProps{
+							Description: "CanManipulateProjectSettings shows whether the registry can change the settings of the projects.",
+							Default:     false,
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+					"hasProjectRetention": {
+						SchemaProps: spec.SchemaProps{
+							Description: "HasProjectRetention shows whether the registry understands the concept of project level tag retention.",
+							Default:     false,
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+					"canManipulateProjectRetention": {
+						SchemaProps: spec.SchemaProps{
+							Description: "CanManipulateProjectRetention shows whether the registry can change the tag retention rules of the projects.",
+							Default:     false,
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+					"hasProjectImmutableTags": {
+						SchemaProps: spec.SchemaProps{
+							Description: "HasProjectImmutableTags shows whether the registry understands the concept of project level immutable tag rules.",
+							Default:     false,
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+					"canManipulateProjectImmutableTags": {
+						SchemaProps: spec.SchemaProps{
+							Description: "CanManipulateProjectImmutableTags shows whether the registry can add/remove immutable tag rules to the projects.",
+							Default:     false,
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+					"hasProjectWebhooks": {
+						SchemaProps: spec.SchemaProps{
+							Description: "HasProjectWebhooks shows whether the registry understands the concept of project level webhook notifications.",
+							Default:     false,
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+					"canManipulateProjectWebhooks": {
+						SchemaProps: spec.SchemaProps{
+							Description: "CanManipulateProjectWebhooks shows whether the registry can add/update/remove the webhook notifications of the projects.",
+							Default:     false,
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+					"hasRobotAccounts": {
+						SchemaProps: spec.SchemaProps{
+							Description: "HasRobotAccounts shows whether the registry understands the concept of registry level robot accounts.",
+							Default:     false,
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+					"canManipulateRobotAccounts": {
+						SchemaProps: spec.SchemaProps{
+							Description: "CanManipulateRobotAccounts shows whether the registry can add/update/remove registry level robot accounts.",
+							Default:     false,
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+					"hasUserGroups": {
+						SchemaProps: spec.SchemaProps{
+							Description: "HasUserGroups shows whether the registry understands the concept of registry level user groups.",
+							Default:     false,
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+					"canManipulateUserGroups": {
+						SchemaProps: spec.SchemaProps{
+							Description: "CanManipulateUserGroups shows whether the registry can remove the user groups which are not used anymore.",
+							Default:     false,
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"canCreateProject", "canDeleteProject", "canPullReplicate", "canPushReplicate", "canManipulateProjectMembers", "canManipulateScanners", "canManipulateReplicationRules", "hasProjectMembers", "canRotateProjectMemberCredentials", "hasProjectScanners", "hasProjectReplicationRules", "hasProjectStorageReport", "hasProjectStorageQuota", "canManipulateProjectStorageQuota", "hasProjectSettings", "canManipulateProjectSettings", "hasProjectRetention", "canManipulateProjectRetention", "hasProjectImmutableTags", "canManipulateProjectImmutableTags", "hasProjectWebhooks", "canManipulateProjectWebhooks", "hasRobotAccounts", "canManipulateRobotAccounts", "hasUserGroups", "canManipulateUserGroups"},
+			},
+		},
+	}
+}
+
+func schema_pkg_apis_registryman_v1alpha1_RegistryList(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "RegistryList collects Registry resources.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"apiVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"metadata": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"),
+						},
+					},
+					"items": {
+						SchemaProps: spec.SchemaProps{
+							Type: []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/kubermatic-labs/registryman/pkg/apis/registryman/v1alpha1.Registry"),
+									},
+								},
+							},
+						},
+					},
+				},
+				Required: []string{"items"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/kubermatic-labs/registryman/pkg/apis/registryman/v1alpha1.Registry", "k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"},
+	}
+}
+
+func schema_pkg_apis_registryman_v1alpha1_RegistrySpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "RegistrySpec describes the specification of a Registry.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"provider": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Provider identifies the actual registry type, e.g. Harbor, Docker Hub, etc.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"apiEndpoint": {
+						SchemaProps: spec.SchemaProps{
+							Description: "APIEndpoint identifies the registry API endpoint in a registry implementation specific way. It can be for example an HTTP endpoint, like \"http://harbor.example.com:8080\".",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"username": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Username is the user name to be used during the authentication at the APIEndpoint interface.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"password": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Password is the password to be used during the authentication at the APIEndpoint interface. Use PasswordSecretRef instead to keep the password out of the resource.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"passwordSecretRef": {
+						SchemaProps: spec.SchemaProps{
+							Description: "PasswordSecretRef selects the Secret key which contains the password to be used during the authentication at the APIEndpoint interface. It takes precedence over Password.",
+							Ref:         ref("github.com/kubermatic-labs/registryman/pkg/apis/registryman/v1alpha1.SecretKeyRef"),
+						},
+					},
+					"tokenSecretRef": {
+						SchemaProps: spec.SchemaProps{
+							Description: "TokenSecretRef selects the Secret key which contains the API token of the registry. It is used by the providers which authenticate certain API calls with a token, e.g. the project based Artifactory.",
+							Ref:         ref("github.com/kubermatic-labs/registryman/pkg/apis/registryman/v1alpha1.SecretKeyRef"),
+						},
+					},
+					"role": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Role specifies whether the registry is a Global Hub or a Local registry.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"insecureSkipTlsVerify": {
+						SchemaProps: spec.SchemaProps{
+							Description: "InsecureSkipTlsVerify shows whether the TLS validation of the registry endpoint can be skipped or not.",
+							Default:     false,
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+					"roleMapping": {
+						SchemaProps: spec.SchemaProps{
+							Description: "RoleMapping overrides the provider specific roles of the project member roles, e.g. to map the Developer role to a custom Artifactory permission target action list or to a Quay team role.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/kubermatic-labs/registryman/pkg/apis/registryman/v1alpha1.RoleMapping"),
+									},
+								},
+							},
+						},
+					},
+				},
+				Required: []string{"provider", "apiEndpoint", "username", "role", "insecureSkipTlsVerify"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/kubermatic-labs/registryman/pkg/apis/registryman/v1alpha1.RoleMapping", "github.com/kubermatic-labs/registryman/pkg/apis/registryman/v1alpha1.SecretKeyRef"},
+	}
+}
+
+func schema_pkg_apis_registryman_v1alpha1_RegistryStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "RegistryStatus specifies the status of a registry.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"projects": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-map-keys": []interface{}{
+									"name",
+								},
+								"x-kubernetes-list-type": "map",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Type: []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/kubermatic-labs/registryman/pkg/apis/registryman/v1alpha1.ProjectStatus"),
+									},
+								},
+							},
+						},
+					},
+					"capabilities": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("github.com/kubermatic-labs/registryman/pkg/apis/registryman/v1alpha1.RegistryCapabilities"),
+						},
+					},
+					"managedReplications": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-map-keys": []interface{}{
+									"project",
+									"source",
+								},
+								"x-kubernetes-list-type": "map",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "ManagedReplications describes the replications into the registry that are performed by registryman, because neither the source nor the destination registry can replicate natively.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/kubermatic-labs/registryman/pkg/apis/registryman/v1alpha1.ManagedReplicationStatus"),
+									},
+								},
+							},
+						},
+					},
+					"scheduledReplications": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-map-keys": []interface{}{
+									"project",
+								},
+								"x-kubernetes-list-type": "map",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "ScheduledReplications describes the replication executions of the projects that are started by the scheduler of the operator.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/kubermatic-labs/registryman/pkg/apis/registryman/v1alpha1.ScheduledReplicationStatus"),
+									},
+								},
+							},
+						},
+					},
+					"robotAccounts": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-map-keys": []interface{}{
+									"name",
+								},
+								"x-kubernetes-list-type": "map",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "RobotAccounts describes the registry level (system) robot accounts. Nil when the robot accounts of the registry are not managed.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/kubermatic-labs/registryman/pkg/apis/registryman/v1alpha1.RobotAccountStatus"),
+									},
+								},
+							},
+						},
+					},
+					"userGroups": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-map-keys": []interface{}{
+									"name",
+								},
+								"x-kubernetes-list-type": "map",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "UserGroups describes the user groups (LDAP or OIDC groups) of the registry. Nil when the registry has no user groups.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/kubermatic-labs/registryman/pkg/apis/registryman/v1alpha1.UserGroupStatus"),
+									},
+								},
+							},
+						},
+					},
+				},
+				Required: []string{"projects", "capabilities"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/kubermatic-labs/registryman/pkg/apis/registryman/v1alpha1.ManagedReplicationStatus", "github.com/kubermatic-labs/registryman/pkg/apis/registryman/v1alpha1.ProjectStatus", "github.com/kubermatic-labs/registryman/pkg/apis/registryman/v1alpha1.RegistryCapabilities", "github.com/kubermatic-labs/registryman/pkg/apis/registryman/v1alpha1.RobotAccountStatus", "github.com/kubermatic-labs/registryman/pkg/apis/registryman/v1alpha1.ScheduledReplicationStatus", "github.com/kubermatic-labs/registryman/pkg/apis/registryman/v1alpha1.UserGroupStatus"},
+	}
+}
+
+func schema_pkg_apis_registryman_v1alpha1_RemoteRegistryStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "RemoteRegistrySpec specifies the remote registry of a replication rule.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"name": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Name of the remote registry.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"provider": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Provider identifies the actual registry type, e.g. Harbor, Docker Hub, etc.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"apiEndpoint": {
+						SchemaProps: spec.SchemaProps{
+							Description: "APIEndpoint identifies the registry API endpoint in a registry implementation specific way. It can be for example an HTTP endpoint, like \"http://harbor.example.com:8080\".",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"username": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Username is the user name to be used during the authentication at the APIEndpoint interface.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"insecureSkipTlsVerify": {
+						SchemaProps: spec.SchemaProps{
+							Description: "InsecureSkipTlsVerify shows whether the TLS validation of the registry endpoint can be skipped or not.",
+							Default:     false,
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"name", "provider", "apiEndpoint", "username", "insecureSkipTlsVerify"},
+			},
+		},
+	}
+}
+
+func schema_pkg_apis_registryman_v1alpha1_ReplicationExecutionStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "ReplicationExecutionStatus describes an execution of a replication rule.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"id": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ID identifies the execution in the registry.",
+							Default:     0,
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"state": {
+						SchemaProps: spec.SchemaProps{
+							Description: "State of the execution.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"startTime": {
+						SchemaProps: spec.SchemaProps{
+							Description: "StartTime shows when the execution started.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+					"endTime": {
+						SchemaProps: spec.SchemaProps{
+							Description: "EndTime shows when the execution finished. It is not set while the execution is in progress.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+					"failedTasks": {
+						SchemaProps: spec.SchemaProps{
+							Description: "FailedTasks is the number of the failed tasks, e.g. artifacts that could not be copied.",
+							Default:     0,
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+				},
+				Required: []string{"id", "state", "failedTasks"},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
+	}
+}
+
+func schema_pkg_apis_registryman_v1alpha1_ReplicationFilters(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "ReplicationFilters selects the artifacts that are replicated. An artifact is replicated if it matches all the filters that are set.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"tags": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Tags is a doublestar pattern that selects the tags of the replicated artifacts, e.g. \"v*\" or \"{release-*,latest}\".",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"excludeTags": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ExcludeTags inverts the Tags pattern, i.e. the artifacts with matching tags are not replicated. E.g. the pattern \"*.sig\" with ExcludeTags skips the signatures.",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+					"label": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Label selects the artifacts that have the given label.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"resourceType": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ResourceType selects the type of the replicated artifacts. If it is not set, all types are replicated.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func schema_pkg_apis_registryman_v1alpha1_ReplicationOptions(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "ReplicationOptions describes which artifacts are replicated by a replication rule and how.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"filters": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Filters selects the replicated artifacts.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("github.com/kubermatic-labs/registryman/pkg/apis/registryman/v1alpha1.ReplicationFilters"),
+						},
+					},
+					"deletion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Deletion shows whether the deletion of an artifact is replicated.",
+							Default:     false,
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+					"override": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Override shows whether the existing artifacts of the destination registry are overwritten.",
+							Default:     false,
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"deletion", "override"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/kubermatic-labs/registryman/pkg/apis/registryman/v1alpha1.ReplicationFilters"},
+	}
+}
+
+func schema_pkg_apis_registryman_v1alpha1_ReplicationRoute(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "ReplicationRoute describes that the repositories of a project are replicated from a source registry to a destination registry.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"from": {
+						SchemaProps: spec.SchemaProps{
+							Description: "From is the name of the source registry.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"to": {
+						SchemaProps: spec.SchemaProps{
+							Description: "To is the name of the destination registry.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"from", "to"},
+			},
+		},
+	}
+}
+
+func schema_pkg_apis_registryman_v1alpha1_ReplicationRuleStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "ReplicationRuleStatus specifies the status of project replication rule.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"remoteRegistry": {
+						SchemaProps: spec.SchemaProps{
+							Description: "RemoteRegistry indicates the remote registry which the current registry shall synchronize with.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("github.com/kubermatic-labs/registryman/pkg/apis/registryman/v1alpha1.RemoteRegistryStatus"),
+						},
+					},
+					"trigger": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Trigger describes the event that shall trigger the replication.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("github.com/kubermatic-labs/registryman/pkg/apis/registryman/v1alpha1.ReplicationTrigger"),
+						},
+					},
+					"direction": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Direction shows whether the replication is of type pull or push.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"filters": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Filters selects the replicated artifacts.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("github.com/kubermatic-labs/registryman/pkg/apis/registryman/v1alpha1.ReplicationFilters"),
+						},
+					},
+					"deletion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Deletion shows whether the deletion of an artifact is replicated.",
+							Default:     false,
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+					"override": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Override shows whether the existing artifacts of the destination registry are overwritten.",
+							Default:     false,
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+					"enabled": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Enabled shows whether the replication rule is active.",
+							Default:     false,
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+					"lastExecution": {
+						SchemaProps: spec.SchemaProps{
+							Description: "LastExecution describes the most recent execution of the replication rule. It is not set if the rule has not been executed yet or the registry does not report the executions.",
+							Ref:         ref("github.com/kubermatic-labs/registryman/pkg/apis/registryman/v1alpha1.ReplicationExecutionStatus"),
+						},
+					},
+				},
+				Required: []string{"remoteRegistry", "trigger", "direction", "deletion", "override", "enabled"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/kubermatic-labs/registryman/pkg/apis/registryman/v1alpha1.RemoteRegistryStatus", "github.com/kubermatic-labs/registryman/pkg/apis/registryman/v1alpha1.ReplicationExecutionStatus", "github.com/kubermatic-labs/registryman/pkg/apis/registryman/v1alpha1.ReplicationFilters", "github.com/kubermatic-labs/registryman/pkg/apis/registryman/v1alpha1.ReplicationTrigger"},
+	}
+}
+
+func schema_pkg_apis_registryman_v1alpha1_ReplicationTrigger(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "ReplicationTrigger indicates the replication trigger of a project.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"type": {
+						SchemaProps: spec.SchemaProps{
+							Default: 0,
+							Type:    []string{"integer"},
+							Format:  "int32",
+						},
+					},
+					"schedule": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+				},
+				Required: []string{"type"},
+			},
+		},
+	}
+}
+
+func schema_pkg_apis_registryman_v1alpha1_RetentionPolicy(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "RetentionPolicy describes the tag retention rules of a project. A tag is kept if any of the rules retains it, the other tags are deleted.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"rules": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-type": "atomic",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "Rules of the retention policy.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/kubermatic-labs/registryman/pkg/apis/registryman/v1alpha1.RetentionRule"),
+									},
+								},
+							},
+						},
+					},
+				},
+				Required: []string{"rules"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/kubermatic-labs/registryman/pkg/apis/registryman/v1alpha1.RetentionRule"},
+	}
+}
+
+func schema_pkg_apis_registryman_v1alpha1_RetentionRule(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "RetentionRule selects the tags to be kept from the matching repositories.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"repositories": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Repositories is a doublestar pattern that selects the repositories of the project, e.g. \"backend/**\". All repositories are selected if it is not set.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"tags": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Tags is a doublestar pattern that selects the tags of the repositories, e.g. \"v*\". All tags are selected if it is not set.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"retain": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Retain selects how the tags are retained. LatestPushed keeps the Count most recently pushed tags, PushedWithinDays keeps the tags pushed within the last Count days.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"count": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Storage used by the project in bytes.",
+							Description: "Count is the number of tags or days, depending on Retain.",
 							Default:     0,
 							Type:        []string{"integer"},
 							Format:      "int32",
 						},
 					},
-					"scannerStatus": {
-						SchemaProps: spec.SchemaProps{
-							Description: "Scanner of the project.",
-							Default:     map[string]interface{}{},
-							Ref:         ref("github.com/kubermatic-labs/registryman/pkg/apis/registryman/v1alpha1.ScannerStatus"),
-						},
-					},
 				},
-				Required: []string{"name", "members", "replicationRules", "storageUsed", "scannerStatus"},
+				Required: []string{"retain", "count"},
 			},
 		},
-		Dependencies: []string{
-			"github.com/kubermatic-labs/registryman/pkg/apis/registryman/v1alpha1.MemberStatus", "github.com/kubermatic-labs/registryman/pkg/apis/registryman/v1alpha1.ReplicationRuleStatus", "github.com/kubermatic-labs/registryman/pkg/apis/registryman/v1alpha1.ScannerStatus"},
 	}
 }
 
-func schema_pkg_apis_registryman_v1alpha1_Registry(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_pkg_apis_registryman_v1alpha1_RobotAccount(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "Registry describes the expected state of a registry Object",
+				Description: "RobotAccount resource describes a registry level (system) robot account which can access multiple projects of a registry.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
 					"kind": {
@@ -418,13 +2074,8 @@ func schema_pkg_apis_registryman_v1alpha1_Registry(ref common.ReferenceCallback)
 					},
 					"spec": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Spec describes the Registry Specification.",
-							Ref:         ref("github.com/kubermatic-labs/registryman/pkg/apis/registryman/v1alpha1.RegistrySpec"),
-						},
-					},
-					"status": {
-						SchemaProps: spec.SchemaProps{
-							Ref: ref("github.com/kubermatic-labs/registryman/pkg/apis/registryman/v1alpha1.RegistryStatus"),
+							Description: "Spec describes the RobotAccount Specification.",
+							Ref:         ref("github.com/kubermatic-labs/registryman/pkg/apis/registryman/v1alpha1.RobotAccountSpec"),
 						},
 					},
 				},
@@ -432,389 +2083,479 @@ func schema_pkg_apis_registryman_v1alpha1_Registry(ref common.ReferenceCallback)
 			},
 		},
 		Dependencies: []string{
-			"github.com/kubermatic-labs/registryman/pkg/apis/registryman/v1alpha1.RegistrySpec", "github.com/kubermatic-labs/registryman/pkg/apis/registryman/v1alpha1.RegistryStatus", "k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"},
+			"github.com/kubermatic-labs/registryman/pkg/apis/registryman/v1alpha1.RobotAccountSpec", "k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"},
 	}
 }
 
-func schema_pkg_apis_registryman_v1alpha1_RegistryCapabilities(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_pkg_apis_registryman_v1alpha1_RobotAccountList(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Type: []string{"object"},
+				Description: "RobotAccountList collects RobotAccount resources.",
+				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"canCreateProject": {
+					"kind": {
 						SchemaProps: spec.SchemaProps{
-							Description: "CanCreateProject shows whether the registry can create projects.",
-							Default:     false,
-							Type:        []string{"boolean"},
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"canDeleteProject": {
+					"apiVersion": {
 						SchemaProps: spec.SchemaProps{
-							Description: "CanDeleteProject shows whether the registry can delete projects.",
-							Default:     false,
-							Type:        []string{"boolean"},
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"canPullReplicate": {
+					"metadata": {
 						SchemaProps: spec.SchemaProps{
-							Description: "CanPullReplicate shows whether the registry can pull repositories from remote registries.",
-							Default:     false,
-							Type:        []string{"boolean"},
-							Format:      "",
+							Default: map[string]interface{}{},
+							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"),
 						},
 					},
-					"canPushReplicate": {
+					"items": {
 						SchemaProps: spec.SchemaProps{
-							Description: "CanPushReplicate shows whether the registry can push repositories from remote registries.",
-							Default:     false,
-							Type:        []string{"boolean"},
-							Format:      "",
+							Type: []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/kubermatic-labs/registryman/pkg/apis/registryman/v1alpha1.RobotAccount"),
+									},
+								},
+							},
 						},
 					},
-					"canManipulateProjectMembers": {
+				},
+				Required: []string{"items"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/kubermatic-labs/registryman/pkg/apis/registryman/v1alpha1.RobotAccount", "k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"},
+	}
+}
+
+func schema_pkg_apis_registryman_v1alpha1_RobotAccountProject(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "RobotAccountProject describes the permissions of a robot account in a project.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"name": {
 						SchemaProps: spec.SchemaProps{
-							Description: "CanManipulateProjectMembers shows whether the registry can add/remove members to the projects.",
-							Default:     false,
-							Type:        []string{"boolean"},
+							Description: "Name of the project. \"*\" means all projects of the registry.",
+							Default:     "",
+							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"canManipulateScanners": {
+					"permissions": {
 						SchemaProps: spec.SchemaProps{
-							Description: "CanManipulateProjectScanners shows whether the registry can add/remove scanners to the projects.",
-							Default:     false,
-							Type:        []string{"boolean"},
-							Format:      "",
+							Description: "Permissions of the robot account in the project.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
 						},
 					},
-					"canManipulateReplicationRules": {
+				},
+				Required: []string{"name", "permissions"},
+			},
+		},
+	}
+}
+
+func schema_pkg_apis_registryman_v1alpha1_RobotAccountSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "RobotAccountSpec describes the scope and the lifetime of a robot account.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"registry": {
 						SchemaProps: spec.SchemaProps{
-							Description: "CanManipulateProjectReplicationRules shows whether the registry can add/remove replication rules to the projects.",
-							Default:     false,
-							Type:        []string{"boolean"},
+							Description: "Registry is the name of the Registry resource where the robot account is created.",
+							Default:     "",
+							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"hasProjectMembers": {
+					"description": {
 						SchemaProps: spec.SchemaProps{
-							Description: "HasProjectMembers shows whether the registry understands the concept of project membership.",
-							Default:     false,
-							Type:        []string{"boolean"},
+							Description: "Description of the robot account.",
+							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"hasProjectScanners": {
+					"projects": {
 						SchemaProps: spec.SchemaProps{
-							Description: "HasProjectScanners shows whether the registry understands the concept of project level vulnerability scanners.",
-							Default:     false,
-							Type:        []string{"boolean"},
-							Format:      "",
+							Description: "Projects describes the permissions of the robot account in the projects.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/kubermatic-labs/registryman/pkg/apis/registryman/v1alpha1.RobotAccountProject"),
+									},
+								},
+							},
 						},
 					},
-					"hasProjectReplicationRules": {
+					"expiresIn": {
 						SchemaProps: spec.SchemaProps{
-							Description: "HasProjectReplicationRules shows whether the registry understands the concept of project level replication rules.",
-							Default:     false,
-							Type:        []string{"boolean"},
-							Format:      "",
+							Description: "ExpiresIn is the lifetime of the robot account in days. The robot account never expires, if omitted.",
+							Type:        []string{"integer"},
+							Format:      "int32",
 						},
 					},
-					"hasProjectStorageReport": {
+					"rotateBefore": {
 						SchemaProps: spec.SchemaProps{
-							Description: "HasProjectStorageReport shows whether the registry understands the concept of project level storage reporting.",
-							Default:     false,
-							Type:        []string{"boolean"},
-							Format:      "",
+							Description: "RotateBefore is the number of days before the expiration when the secret of the robot account is rotated. Defaults to 7 days, but at most the half of the lifetime.",
+							Type:        []string{"integer"},
+							Format:      "int32",
 						},
 					},
 				},
-				Required: []string{"canCreateProject", "canDeleteProject", "canPullReplicate", "canPushReplicate", "canManipulateProjectMembers", "canManipulateScanners", "canManipulateReplicationRules", "hasProjectMembers", "hasProjectScanners", "hasProjectReplicationRules", "hasProjectStorageReport"},
+				Required: []string{"registry", "projects"},
 			},
 		},
+		Dependencies: []string{
+			"github.com/kubermatic-labs/registryman/pkg/apis/registryman/v1alpha1.RobotAccountProject"},
 	}
 }
 
-func schema_pkg_apis_registryman_v1alpha1_RegistryList(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_pkg_apis_registryman_v1alpha1_RobotAccountStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "RegistryList collects Registry resources.",
+				Description: "RobotAccountStatus specifies the status of a registry level robot account.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"kind": {
+					"name": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Description: "Name of the robot account.",
+							Default:     "",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"apiVersion": {
+					"description": {
 						SchemaProps: spec.SchemaProps{
-							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Description: "Description of the robot account.",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"metadata": {
-						SchemaProps: spec.SchemaProps{
-							Default: map[string]interface{}{},
-							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"),
-						},
-					},
-					"items": {
+					"projects": {
 						SchemaProps: spec.SchemaProps{
-							Type: []string{"array"},
+							Description: "Projects describes the permissions of the robot account in the projects.",
+							Type:        []string{"array"},
 							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
 										Default: map[string]interface{}{},
-										Ref:     ref("github.com/kubermatic-labs/registryman/pkg/apis/registryman/v1alpha1.Registry"),
+										Ref:     ref("github.com/kubermatic-labs/registryman/pkg/apis/registryman/v1alpha1.RobotAccountProject"),
 									},
 								},
 							},
 						},
 					},
+					"duration": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Duration is the lifetime of the robot account in days. -1 means that the robot account never expires.",
+							Default:     0,
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"expiresAt": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ExpiresAt shows when the robot account expires. It is not set when the robot account never expires.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+					"rotateBefore": {
+						SchemaProps: spec.SchemaProps{
+							Description: "RotateBefore is the number of days before the expiration when the secret of the robot account is rotated.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
 				},
-				Required: []string{"items"},
+				Required: []string{"name", "projects", "duration"},
 			},
 		},
 		Dependencies: []string{
-			"github.com/kubermatic-labs/registryman/pkg/apis/registryman/v1alpha1.Registry", "k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"},
+			"github.com/kubermatic-labs/registryman/pkg/apis/registryman/v1alpha1.RobotAccountProject", "k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
 	}
 }
 
-func schema_pkg_apis_registryman_v1alpha1_RegistrySpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_pkg_apis_registryman_v1alpha1_RoleMapping(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "RegistrySpec describes the specification of a Registry.",
+				Description: "RoleMapping maps a project member role to a provider specific role.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"provider": {
+					"type": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Provider identifies the actual registry type, e.g. Harbor, Docker Hub, etc.",
-							Default:     "",
-							Type:        []string{"string"},
-							Format:      "",
+							Description: "Type of the project members the mapping applies to, e.g. User, Group, Robot. If not set, the default value (User) is applied.",
+							Type:        []string{"integer"},
+							Format:      "int32",
 						},
 					},
-					"apiEndpoint": {
+					"role": {
 						SchemaProps: spec.SchemaProps{
-							Description: "APIEndpoint identifies the registry API endpoint in a registry implementation specific way. It can be for example an HTTP endpoint, like \"http://harbor.example.com:8080\".",
-							Default:     "",
-							Type:        []string{"string"},
-							Format:      "",
+							Description: "Role of the project members, e.g. Developer, Maintainer, etc.",
+							Default:     0,
+							Type:        []string{"integer"},
+							Format:      "int32",
 						},
 					},
-					"username": {
+					"providerRole": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Username is the user name to be used during the authentication at the APIEndpoint interface.",
+							Description: "ProviderRole is the provider specific role the project member role is mapped to. Its format depends on the provider of the registry, see the documentation of the role tables.",
 							Default:     "",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"password": {
+				},
+				Required: []string{"role", "providerRole"},
+			},
+		},
+	}
+}
+
+func schema_pkg_apis_registryman_v1alpha1_Scanner(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "Scanner resource describes the configuration of an external vulnerability scanner.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kind": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Password is the password to be used during the authentication at the APIEndpoint interface.",
-							Default:     "",
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"role": {
+					"apiVersion": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Role specifies whether the registry is a Global Hub or a Local registry.",
-							Default:     "",
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"insecureSkipTlsVerify": {
+					"metadata": {
 						SchemaProps: spec.SchemaProps{
-							Description: "InsecureSkipTlsVerify shows whether the TLS validation of the registry endpoint can be skipped or not.",
-							Default:     false,
-							Type:        []string{"boolean"},
-							Format:      "",
+							Default: map[string]interface{}{},
+							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"),
+						},
+					},
+					"spec": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Spec describes the Scanner Specification.",
+							Ref:         ref("github.com/kubermatic-labs/registryman/pkg/apis/registryman/v1alpha1.ScannerSpec"),
 						},
 					},
 				},
-				Required: []string{"provider", "apiEndpoint", "username", "password", "role", "insecureSkipTlsVerify"},
+				Required: []string{"spec"},
 			},
 		},
+		Dependencies: []string{
+			"github.com/kubermatic-labs/registryman/pkg/apis/registryman/v1alpha1.ScannerSpec", "k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"},
 	}
 }
 
-func schema_pkg_apis_registryman_v1alpha1_RegistryStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_pkg_apis_registryman_v1alpha1_ScannerList(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "RegistryStatus specifies the status of a registry.",
+				Description: "ScannerList collects Registry resources.",
 				Type:        []string{"object"},
-				Properties: map[string]spec.Schema{
-					"projects": {
-						VendorExtensible: spec.VendorExtensible{
-							Extensions: spec.Extensions{
-								"x-kubernetes-list-map-keys": []interface{}{
-									"name",
-								},
-								"x-kubernetes-list-type": "map",
-							},
+				Properties: map[string]spec.Schema{
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"apiVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"metadata": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"),
 						},
+					},
+					"items": {
 						SchemaProps: spec.SchemaProps{
 							Type: []string{"array"},
 							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
 										Default: map[string]interface{}{},
-										Ref:     ref("github.com/kubermatic-labs/registryman/pkg/apis/registryman/v1alpha1.ProjectStatus"),
+										Ref:     ref("github.com/kubermatic-labs/registryman/pkg/apis/registryman/v1alpha1.Scanner"),
 									},
 								},
 							},
 						},
 					},
-					"capabilities": {
-						SchemaProps: spec.SchemaProps{
-							Default: map[string]interface{}{},
-							Ref:     ref("github.com/kubermatic-labs/registryman/pkg/apis/registryman/v1alpha1.RegistryCapabilities"),
-						},
-					},
 				},
-				Required: []string{"projects", "capabilities"},
+				Required: []string{"items"},
 			},
 		},
 		Dependencies: []string{
-			"github.com/kubermatic-labs/registryman/pkg/apis/registryman/v1alpha1.ProjectStatus", "github.com/kubermatic-labs/registryman/pkg/apis/registryman/v1alpha1.RegistryCapabilities"},
+			"github.com/kubermatic-labs/registryman/pkg/apis/registryman/v1alpha1.Scanner", "k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"},
 	}
 }
 
-func schema_pkg_apis_registryman_v1alpha1_RemoteRegistryStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_pkg_apis_registryman_v1alpha1_ScannerSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "RemoteRegistrySpec specifies the remote registry of a replication rule.",
-				Type:        []string{"object"},
+				Type: []string{"object"},
 				Properties: map[string]spec.Schema{
-					"name": {
+					"url": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Name of the remote registry.",
-							Default:     "",
+							Description: "A base URL of the scanner adapter.",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"provider": {
+					"accessCredential": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Provider identifies the actual registry type, e.g. Harbor, Docker Hub, etc.",
-							Default:     "",
+							Description: "An optional value of the HTTP Authorization header sent with each request to the Scanner Adapter API. Use AccessCredentialSecretRef instead to keep the credential out of the resource.",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"apiEndpoint": {
+					"accessCredentialSecretRef": {
 						SchemaProps: spec.SchemaProps{
-							Description: "APIEndpoint identifies the registry API endpoint in a registry implementation specific way. It can be for example an HTTP endpoint, like \"http://harbor.example.com:8080\".",
-							Default:     "",
-							Type:        []string{"string"},
-							Format:      "",
+							Description: "AccessCredentialSecretRef selects the Secret key which contains the value of the HTTP Authorization header sent with each request to the Scanner Adapter API. It takes precedence over AccessCredential.",
+							Ref:         ref("github.com/kubermatic-labs/registryman/pkg/apis/registryman/v1alpha1.SecretKeyRef"),
 						},
 					},
-					"username": {
+				},
+			},
+		},
+		Dependencies: []string{
+			"github.com/kubermatic-labs/registryman/pkg/apis/registryman/v1alpha1.SecretKeyRef"},
+	}
+}
+
+func schema_pkg_apis_registryman_v1alpha1_ScannerStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "ScannerStatus specifies the status of a project's external vulnerability scanner.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"name": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Username is the user name to be used during the authentication at the APIEndpoint interface.",
+							Description: "Name of the scanner.",
 							Default:     "",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"insecureSkipTlsVerify": {
+					"url": {
 						SchemaProps: spec.SchemaProps{
-							Description: "InsecureSkipTlsVerify shows whether the TLS validation of the registry endpoint can be skipped or not.",
-							Default:     false,
-							Type:        []string{"boolean"},
+							Description: "URL of the scanner.",
+							Default:     "",
+							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
 				},
-				Required: []string{"name", "provider", "apiEndpoint", "username", "insecureSkipTlsVerify"},
+				Required: []string{"name", "url"},
 			},
 		},
 	}
 }
 
-func schema_pkg_apis_registryman_v1alpha1_ReplicationRuleStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_pkg_apis_registryman_v1alpha1_ScheduledReplicationStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "ReplicationRuleStatus specifies the status of project replication rule.",
+				Description: "ScheduledReplicationStatus describes when the scheduler of the operator last started the replication rules of a project.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"remoteRegistry": {
+					"project": {
 						SchemaProps: spec.SchemaProps{
-							Description: "RemoteRegistry indicates the remote registry which the current registry shall synchronize with.",
-							Default:     map[string]interface{}{},
-							Ref:         ref("github.com/kubermatic-labs/registryman/pkg/apis/registryman/v1alpha1.RemoteRegistryStatus"),
+							Description: "Project is the name of the replicated project.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"trigger": {
+					"lastRunTime": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Trigger describes the event that shall trigger the replication.",
+							Description: "LastRunTime shows when the replication rules were last started.",
 							Default:     map[string]interface{}{},
-							Ref:         ref("github.com/kubermatic-labs/registryman/pkg/apis/registryman/v1alpha1.ReplicationTrigger"),
-						},
-					},
-					"direction": {
-						SchemaProps: spec.SchemaProps{
-							Description: "Direction shows whether the replication is of type pull or push.",
-							Default:     "",
-							Type:        []string{"string"},
-							Format:      "",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
 						},
 					},
 				},
-				Required: []string{"remoteRegistry", "trigger", "direction"},
+				Required: []string{"project", "lastRunTime"},
 			},
 		},
 		Dependencies: []string{
-			"github.com/kubermatic-labs/registryman/pkg/apis/registryman/v1alpha1.RemoteRegistryStatus", "github.com/kubermatic-labs/registryman/pkg/apis/registryman/v1alpha1.ReplicationTrigger"},
+			"k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
 	}
 }
 
-func schema_pkg_apis_registryman_v1alpha1_ReplicationTrigger(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_pkg_apis_registryman_v1alpha1_SecretKeyRef(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "ReplicationTrigger indicates the replication trigger of a project.",
+				Description: "SecretKeyRef selects a key of a Secret residing in the namespace of the referring resource.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"type": {
+					"name": {
 						SchemaProps: spec.SchemaProps{
-							Default: 0,
-							Type:    []string{"integer"},
-							Format:  "int32",
+							Description: "Name of the Secret.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"schedule": {
+					"key": {
 						SchemaProps: spec.SchemaProps{
-							Type:   []string{"string"},
-							Format: "",
+							Description: "Key of the Secret data.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
 				},
-				Required: []string{"type"},
+				Required: []string{"name", "key"},
 			},
 		},
 	}
 }
 
-func schema_pkg_apis_registryman_v1alpha1_Scanner(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_pkg_apis_registryman_v1alpha1_Team(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "Scanner resource describes the configuration of an external vulnerability scanner.",
+				Description: "Team resource describes a reusable list of project members. The Projects referring to the Team get its members.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
 					"kind": {
@@ -839,8 +2580,8 @@ func schema_pkg_apis_registryman_v1alpha1_Scanner(ref common.ReferenceCallback)
 					},
 					"spec": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Spec describes the Scanner Specification.",
-							Ref:         ref("github.com/kubermatic-labs/registryman/pkg/apis/registryman/v1alpha1.ScannerSpec"),
+							Description: "Spec describes the Team Specification.",
+							Ref:         ref("github.com/kubermatic-labs/registryman/pkg/apis/registryman/v1alpha1.TeamSpec"),
 						},
 					},
 				},
@@ -848,15 +2589,15 @@ func schema_pkg_apis_registryman_v1alpha1_Scanner(ref common.ReferenceCallback)
 			},
 		},
 		Dependencies: []string{
-			"github.com/kubermatic-labs/registryman/pkg/apis/registryman/v1alpha1.ScannerSpec", "k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"},
+			"github.com/kubermatic-labs/registryman/pkg/apis/registryman/v1alpha1.TeamSpec", "k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"},
 	}
 }
 
-func schema_pkg_apis_registryman_v1alpha1_ScannerList(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_pkg_apis_registryman_v1alpha1_TeamList(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "ScannerList collects Registry resources.",
+				Description: "TeamList collects Team resources.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
 					"kind": {
@@ -886,7 +2627,7 @@ func schema_pkg_apis_registryman_v1alpha1_ScannerList(ref common.ReferenceCallba
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
 										Default: map[string]interface{}{},
-										Ref:     ref("github.com/kubermatic-labs/registryman/pkg/apis/registryman/v1alpha1.Scanner"),
+										Ref:     ref("github.com/kubermatic-labs/registryman/pkg/apis/registryman/v1alpha1.Team"),
 									},
 								},
 							},
@@ -897,62 +2638,210 @@ func schema_pkg_apis_registryman_v1alpha1_ScannerList(ref common.ReferenceCallba
 			},
 		},
 		Dependencies: []string{
-			"github.com/kubermatic-labs/registryman/pkg/apis/registryman/v1alpha1.Scanner", "k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"},
+			"github.com/kubermatic-labs/registryman/pkg/apis/registryman/v1alpha1.Team", "k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"},
 	}
 }
 
-func schema_pkg_apis_registryman_v1alpha1_ScannerSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_pkg_apis_registryman_v1alpha1_TeamSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Type: []string{"object"},
+				Description: "TeamSpec describes the members of a team.",
+				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"url": {
+					"members": {
 						SchemaProps: spec.SchemaProps{
-							Description: "A base URL of the scanner adapter.",
+							Description: "Members enumerates the members of the team and their capabilities.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Ref: ref("github.com/kubermatic-labs/registryman/pkg/apis/registryman/v1alpha1.ProjectMember"),
+									},
+								},
+							},
+						},
+					},
+				},
+				Required: []string{"members"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/kubermatic-labs/registryman/pkg/apis/registryman/v1alpha1.ProjectMember"},
+	}
+}
+
+func schema_pkg_apis_registryman_v1alpha1_UserGroupStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "UserGroupStatus specifies the status of a registry level user group.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"name": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Name of the user group.",
+							Default:     "",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"accessCredential": {
+					"groupType": {
+						SchemaProps: spec.SchemaProps{
+							Description: "GroupType is the type of the user group, e.g. LDAP or OIDC.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"dn": {
 						SchemaProps: spec.SchemaProps{
-							Description: "An optional value of the HTTP Authorization header sent with each request to the Scanner Adapter API.",
+							Description: "DN is the distinguished name of an LDAP group.",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
+					"owned": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Owned shows whether the user group was created by registryman. Only the owned user groups are removed when they are not used anymore.",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
 				},
+				Required: []string{"name", "groupType"},
 			},
 		},
 	}
 }
 
-func schema_pkg_apis_registryman_v1alpha1_ScannerStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_pkg_apis_registryman_v1alpha1_WebhookStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "ScannerStatus specifies the status of a project's external vulnerability scanner.",
+				Description: "WebhookStatus specifies the status of a project webhook.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
 					"name": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Name of the scanner.",
+							Description: "Name of the webhook.",
 							Default:     "",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"url": {
+					"endpoint": {
 						SchemaProps: spec.SchemaProps{
-							Description: "URL of the scanner.",
+							Description: "Endpoint where the notifications are sent to.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"eventTypes": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-type": "set",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "Events that trigger a notification.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+					"skipCertVerify": {
+						SchemaProps: spec.SchemaProps{
+							Description: "SkipCertVerify shows whether the TLS certificate of the endpoint is verified.",
+							Default:     false,
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+					"enabled": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Enabled shows whether the notifications are sent.",
+							Default:     false,
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"name", "endpoint", "eventTypes", "skipCertVerify", "enabled"},
+			},
+		},
+	}
+}
+
+func schema_pkg_apis_registryman_v1alpha1_WebhookTarget(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "WebhookTarget describes an HTTP endpoint that is notified about the events of a project.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"name": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Name of the webhook. It identifies the webhook within the project.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"endpoint": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Endpoint is the URL where the notifications are sent to.",
 							Default:     "",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
+					"eventTypes": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-type": "set",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "EventTypes lists the events that trigger a notification.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+					"authHeaderSecretRef": {
+						SchemaProps: spec.SchemaProps{
+							Description: "AuthHeaderSecretRef selects the Secret key which contains the value of the Authorization header sent with the notifications.",
+							Ref:         ref("github.com/kubermatic-labs/registryman/pkg/apis/registryman/v1alpha1.SecretKeyRef"),
+						},
+					},
+					"skipCertVerify": {
+						SchemaProps: spec.SchemaProps{
+							Description: "SkipCertVerify disables the verification of the endpoint's TLS certificate.",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
 				},
-				Required: []string{"name", "url"},
+				Required: []string{"name", "endpoint", "eventTypes"},
 			},
 		},
+		Dependencies: []string{
+			"github.com/kubermatic-labs/registryman/pkg/apis/registryman/v1alpha1.SecretKeyRef"},
 	}
 }
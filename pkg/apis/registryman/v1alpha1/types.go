@@ -17,9 +17,11 @@
 package v1alpha1
 
 import (
+	"crypto/sha256"
 	"encoding/json"
 	"fmt"
 
+	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 )
@@ -55,7 +57,7 @@ var _ runtime.Object = &Registry{}
 // RegistrySpec describes the specification of a Registry.
 type RegistrySpec struct {
 
-	// +kubebuilder:validation:Enum=harbor;acr;artifactory
+	// +kubebuilder:validation:Enum=harbor;acr;artifactory;quay;distribution;gitlab
 
 	// Provider identifies the actual registry type, e.g. Harbor, Docker Hub,
 	// etc.
@@ -72,9 +74,26 @@ type RegistrySpec struct {
 	// APIEndpoint interface.
 	Username string `json:"username"`
 
+	// +kubebuilder:validation:Optional
+
 	// Password is the password to be used during the authentication at the
-	// APIEndpoint interface.
-	Password string `json:"password"`
+	// APIEndpoint interface. Use PasswordSecretRef instead to keep the
+	// password out of the resource.
+	Password string `json:"password,omitempty"`
+
+	// +kubebuilder:validation:Optional
+
+	// PasswordSecretRef selects the Secret key which contains the password
+	// to be used during the authentication at the APIEndpoint interface.
+	// It takes precedence over Password.
+	PasswordSecretRef *SecretKeyRef `json:"passwordSecretRef,omitempty"`
+
+	// +kubebuilder:validation:Optional
+
+	// TokenSecretRef selects the Secret key which contains the API token of
+	// the registry. It is used by the providers which authenticate certain
+	// API calls with a token, e.g. the project based Artifactory.
+	TokenSecretRef *SecretKeyRef `json:"tokenSecretRef,omitempty"`
 
 	// +kubebuilder:default=Local
 	// +kubebuilder:validation:Optional
@@ -90,6 +109,29 @@ type RegistrySpec struct {
 	// InsecureSkipTlsVerify shows whether the TLS validation of the
 	// registry endpoint can be skipped or not.
 	InsecureSkipTlsVerify bool `json:"insecureSkipTlsVerify"`
+
+	// +kubebuilder:validation:Optional
+
+	// RoleMapping overrides the provider specific roles of the project
+	// member roles, e.g. to map the Developer role to a custom Artifactory
+	// permission target action list or to a Quay team role.
+	RoleMapping []RoleMapping `json:"roleMapping,omitempty"`
+}
+
+// RoleMapping maps a project member role to a provider specific role.
+type RoleMapping struct {
+
+	// Type of the project members the mapping applies to, e.g. User,
+	// Group, Robot. If not set, the default value (User) is applied.
+	Type MemberType `json:"type,omitempty"`
+
+	// Role of the project members, e.g. Developer, Maintainer, etc.
+	Role MemberRole `json:"role"`
+
+	// ProviderRole is the provider specific role the project member role is
+	// mapped to. Its format depends on the provider of the registry, see
+	// the documentation of the role tables.
+	ProviderRole string `json:"providerRole"`
 }
 
 // RegistryStatus specifies the status of a registry.
@@ -98,6 +140,139 @@ type RegistryStatus struct {
 	// +listMapKey=name
 	Projects     []ProjectStatus      `json:"projects"`
 	Capabilities RegistryCapabilities `json:"capabilities"`
+
+	// +kubebuilder:validation:Optional
+
+	// ManagedReplications describes the replications into the registry
+	// that are performed by registryman, because neither the source nor
+	// the destination registry can replicate natively.
+	//
+	// +listType=map
+	// +listMapKey=project
+	// +listMapKey=source
+	ManagedReplications []ManagedReplicationStatus `json:"managedReplications,omitempty"`
+
+	// +kubebuilder:validation:Optional
+
+	// ScheduledReplications describes the replication executions of the
+	// projects that are started by the scheduler of the operator.
+	//
+	// +listType=map
+	// +listMapKey=project
+	ScheduledReplications []ScheduledReplicationStatus `json:"scheduledReplications,omitempty"`
+
+	// +kubebuilder:validation:Optional
+
+	// RobotAccounts describes the registry level (system) robot accounts.
+	// Nil when the robot accounts of the registry are not managed.
+	//
+	// +listType=map
+	// +listMapKey=name
+	RobotAccounts []RobotAccountStatus `json:"robotAccounts,omitempty"`
+
+	// +kubebuilder:validation:Optional
+
+	// UserGroups describes the user groups (LDAP or OIDC groups) of the
+	// registry. Nil when the registry has no user groups.
+	//
+	// +listType=map
+	// +listMapKey=name
+	UserGroups []UserGroupStatus `json:"userGroups,omitempty"`
+}
+
+// UserGroupStatus specifies the status of a registry level user group.
+type UserGroupStatus struct {
+
+	// Name of the user group.
+	Name string `json:"name"`
+
+	// GroupType is the type of the user group, e.g. LDAP or OIDC.
+	GroupType string `json:"groupType"`
+
+	// +kubebuilder:validation:Optional
+
+	// DN is the distinguished name of an LDAP group.
+	DN string `json:"dn,omitempty"`
+
+	// +kubebuilder:validation:Optional
+
+	// Owned shows whether the user group was created by registryman. Only
+	// the owned user groups are removed when they are not used anymore.
+	Owned bool `json:"owned,omitempty"`
+}
+
+// RobotAccountStatus specifies the status of a registry level robot account.
+type RobotAccountStatus struct {
+
+	// Name of the robot account.
+	Name string `json:"name"`
+
+	// +kubebuilder:validation:Optional
+
+	// Description of the robot account.
+	Description string `json:"description,omitempty"`
+
+	// Projects describes the permissions of the robot account in the
+	// projects.
+	Projects []RobotAccountProject `json:"projects"`
+
+	// Duration is the lifetime of the robot account in days. -1 means
+	// that the robot account never expires.
+	Duration int `json:"duration"`
+
+	// +kubebuilder:validation:Optional
+
+	// ExpiresAt shows when the robot account expires. It is not set when
+	// the robot account never expires.
+	ExpiresAt *metav1.Time `json:"expiresAt,omitempty"`
+
+	// +kubebuilder:validation:Optional
+
+	// RotateBefore is the number of days before the expiration when the
+	// secret of the robot account is rotated.
+	RotateBefore int `json:"rotateBefore,omitempty"`
+}
+
+// ScheduledReplicationStatus describes when the scheduler of the operator last
+// started the replication rules of a project.
+type ScheduledReplicationStatus struct {
+
+	// Project is the name of the replicated project.
+	Project string `json:"project"`
+
+	// LastRunTime shows when the replication rules were last started.
+	LastRunTime metav1.Time `json:"lastRunTime"`
+}
+
+// ManagedReplicationStatus describes the state of a replication that is
+// performed by registryman.
+type ManagedReplicationStatus struct {
+
+	// Project is the name of the replicated project.
+	Project string `json:"project"`
+
+	// Source is the name of the registry where the project is replicated
+	// from.
+	Source string `json:"source"`
+
+	// +kubebuilder:validation:Optional
+
+	// LastRunTime shows when the last synchronization was started.
+	LastRunTime *metav1.Time `json:"lastRunTime,omitempty"`
+
+	// +kubebuilder:validation:Optional
+
+	// LastSyncTime shows when the project was last synchronized
+	// successfully.
+	LastSyncTime *metav1.Time `json:"lastSyncTime,omitempty"`
+
+	// +kubebuilder:validation:Optional
+
+	// SyncedDigests maps the synchronized images, in repository:tag
+	// format, to the digest of their manifest at the last
+	// synchronization. The images whose digest has not changed are not
+	// copied again.
+	SyncedDigests map[string]string `json:"syncedDigests,omitempty"`
 }
 
 type RegistryCapabilities struct {
@@ -131,6 +306,10 @@ type RegistryCapabilities struct {
 	// of project membership.
 	HasProjectMembers bool `json:"hasProjectMembers"`
 
+	// CanRotateProjectMemberCredentials shows whether the registry can
+	// rotate the credentials of the project members.
+	CanRotateProjectMemberCredentials bool `json:"canRotateProjectMemberCredentials"`
+
 	// HasProjectScanners shows whether the registry understands the concept
 	// of project level vulnerability scanners.
 	HasProjectScanners bool `json:"hasProjectScanners"`
@@ -142,6 +321,62 @@ type RegistryCapabilities struct {
 	// HasProjectStorageReport shows whether the registry understands the concept
 	// of project level storage reporting.
 	HasProjectStorageReport bool `json:"hasProjectStorageReport"`
+
+	// HasProjectStorageQuota shows whether the registry understands the
+	// concept of project level storage quotas.
+	HasProjectStorageQuota bool `json:"hasProjectStorageQuota"`
+
+	// CanManipulateProjectStorageQuota shows whether the registry can
+	// change the storage quota of the projects.
+	CanManipulateProjectStorageQuota bool `json:"canManipulateProjectStorageQuota"`
+
+	// HasProjectSettings shows whether the registry understands the concept
+	// of project level settings.
+	HasProjectSettings bool `json:"hasProjectSettings"`
+
+	// CanManipulateProjectSettings shows whether the registry can change
+	// the settings of the projects.
+	CanManipulateProjectSettings bool `json:"canManipulateProjectSettings"`
+
+	// HasProjectRetention shows whether the registry understands the
+	// concept of project level tag retention.
+	HasProjectRetention bool `json:"hasProjectRetention"`
+
+	// CanManipulateProjectRetention shows whether the registry can change
+	// the tag retention rules of the projects.
+	CanManipulateProjectRetention bool `json:"canManipulateProjectRetention"`
+
+	// HasProjectImmutableTags shows whether the registry understands the
+	// concept of project level immutable tag rules.
+	HasProjectImmutableTags bool `json:"hasProjectImmutableTags"`
+
+	// CanManipulateProjectImmutableTags shows whether the registry can
+	// add/remove immutable tag rules to the projects.
+	CanManipulateProjectImmutableTags bool `json:"canManipulateProjectImmutableTags"`
+
+	// HasProjectWebhooks shows whether the registry understands the concept
+	// of project level webhook notifications.
+	HasProjectWebhooks bool `json:"hasProjectWebhooks"`
+
+	// CanManipulateProjectWebhooks shows whether the registry can
+	// add/update/remove the webhook notifications of the projects.
+	CanManipulateProjectWebhooks bool `json:"canManipulateProjectWebhooks"`
+
+	// HasRobotAccounts shows whether the registry understands the concept
+	// of registry level robot accounts.
+	HasRobotAccounts bool `json:"hasRobotAccounts"`
+
+	// CanManipulateRobotAccounts shows whether the registry can
+	// add/update/remove registry level robot accounts.
+	CanManipulateRobotAccounts bool `json:"canManipulateRobotAccounts"`
+
+	// HasUserGroups shows whether the registry understands the concept of
+	// registry level user groups.
+	HasUserGroups bool `json:"hasUserGroups"`
+
+	// CanManipulateUserGroups shows whether the registry can remove the
+	// user groups which are not used anymore.
+	CanManipulateUserGroups bool `json:"canManipulateUserGroups"`
 }
 
 // ProjectStatus specifies the status of a registry project.
@@ -164,6 +399,28 @@ type ProjectStatus struct {
 	// Storage used by the project in bytes.
 	StorageUsed int `json:"storageUsed"`
 
+	// Storage quota of the project in bytes. -1 means that the storage of
+	// the project is not limited.
+	StorageQuota int `json:"storageQuota"`
+
+	// Settings of the project. Empty when the settings are not managed.
+	Settings *ProjectSettings `json:"settings,omitempty"`
+
+	// Tag retention policy of the project. Empty when the tag retention is
+	// not managed.
+	Retention *RetentionPolicy `json:"retention,omitempty"`
+
+	// Immutable tag rules of the project. Empty when the immutable tag
+	// rules are not managed.
+	ImmutableTags *ImmutableTagPolicy `json:"immutableTags,omitempty"`
+
+	// Webhook notifications of the project. Empty when the webhooks are not
+	// managed.
+	//
+	// +listType=map
+	// +listMapKey=name
+	Webhooks []WebhookStatus `json:"webhooks,omitempty"`
+
 	// Scanner of the project.
 	ScannerStatus ScannerStatus `json:"scannerStatus"`
 }
@@ -182,6 +439,39 @@ type MemberStatus struct {
 
 	// Distinguished name of the project member. Empty when omitted.
 	DN string `json:"dn,omitempty"`
+
+	// +kubebuilder:validation:Optional
+
+	// GroupType is the type of a group member, e.g. LDAP or OIDC. Empty
+	// for the other member types.
+	GroupType string `json:"groupType,omitempty"`
+
+	// +kubebuilder:validation:Optional
+
+	// Rotation is the expected credential rotation policy of a robot
+	// member. The actual status shows only the lifetime of the
+	// credentials.
+	Rotation *CredentialRotation `json:"rotation,omitempty"`
+
+	// +kubebuilder:validation:Optional
+
+	// ExpiresAt shows when the credentials of a robot member expire. It is
+	// not set when the credentials never expire.
+	ExpiresAt *metav1.Time `json:"expiresAt,omitempty"`
+
+	// +kubebuilder:validation:Optional
+
+	// LastRotationTime shows when the credentials of a robot member were
+	// created or last rotated. It is read from the Secret containing the
+	// credentials.
+	LastRotationTime *metav1.Time `json:"lastRotationTime,omitempty"`
+
+	// +kubebuilder:validation:Optional
+
+	// TargetNamespaces lists the namespaces where the pull secret of a
+	// robot member is expected to be copied to. The actual status is read
+	// from the Secret containing the credentials.
+	TargetNamespaces []string `json:"targetNamespaces,omitempty"`
 }
 
 // RemoteRegistrySpec specifies the remote registry of a replication rule.
@@ -220,6 +510,67 @@ type ReplicationRuleStatus struct {
 
 	// Direction shows whether the replication is of type pull or push.
 	Direction string `json:"direction"`
+
+	// ReplicationOptions describes which artifacts are replicated and
+	// how.
+	ReplicationOptions `json:",inline"`
+
+	// Enabled shows whether the replication rule is active.
+	Enabled bool `json:"enabled"`
+
+	// +kubebuilder:validation:Optional
+
+	// LastExecution describes the most recent execution of the
+	// replication rule. It is not set if the rule has not been executed
+	// yet or the registry does not report the executions.
+	LastExecution *ReplicationExecutionStatus `json:"lastExecution,omitempty"`
+}
+
+// ReplicationExecutionState describes the state of a replication execution.
+// +kubebuilder:validation:Enum=InProgress;Succeeded;Failed;Stopped
+type ReplicationExecutionState string
+
+const (
+	// InProgressReplicationExecutionState shows that the replication is
+	// running.
+	InProgressReplicationExecutionState ReplicationExecutionState = "InProgress"
+
+	// SucceededReplicationExecutionState shows that the replication has
+	// finished successfully.
+	SucceededReplicationExecutionState ReplicationExecutionState = "Succeeded"
+
+	// FailedReplicationExecutionState shows that the replication has
+	// finished with failures.
+	FailedReplicationExecutionState ReplicationExecutionState = "Failed"
+
+	// StoppedReplicationExecutionState shows that the replication has
+	// been stopped before finishing.
+	StoppedReplicationExecutionState ReplicationExecutionState = "Stopped"
+)
+
+// ReplicationExecutionStatus describes an execution of a replication rule.
+type ReplicationExecutionStatus struct {
+
+	// ID identifies the execution in the registry.
+	ID int `json:"id"`
+
+	// State of the execution.
+	State ReplicationExecutionState `json:"state"`
+
+	// +kubebuilder:validation:Optional
+
+	// StartTime shows when the execution started.
+	StartTime *metav1.Time `json:"startTime,omitempty"`
+
+	// +kubebuilder:validation:Optional
+
+	// EndTime shows when the execution finished. It is not set while the
+	// execution is in progress.
+	EndTime *metav1.Time `json:"endTime,omitempty"`
+
+	// FailedTasks is the number of the failed tasks, e.g. artifacts that
+	// could not be copied.
+	FailedTasks int `json:"failedTasks"`
 }
 
 // ScannerStatus specifies the status of a project's external vulnerability scanner.
@@ -270,8 +621,20 @@ var _ runtime.Object = &Project{}
 // ProjectSpec describes the spec field of the Project resource
 type ProjectSpec struct {
 
-	// Type selects whether the project is global or local.
-	Type ProjectType `json:"type"`
+	// +kubebuilder:validation:Optional
+
+	// Type selects whether the project is global or local. If it is not
+	// set, the type of the project class is used, or Global if the project
+	// class does not set it either.
+	Type ProjectType `json:"type,omitempty"`
+
+	// +kubebuilder:validation:Optional
+
+	// ProjectClass is the name of the ProjectClass resource whose defaults
+	// are applied to the project. The fields set in the project take
+	// precedence over the defaults. If it is not set, the project class is
+	// selected by the registryman.kubermatic.com/projectClass label.
+	ProjectClass string `json:"projectClass,omitempty"`
 
 	// LocalRegistries lists the registry names at which the local project
 	// shall be provisioned at.
@@ -280,6 +643,22 @@ type ProjectSpec struct {
 	// +kubebuilder:validation:Optional
 	LocalRegistries []string `json:"localRegistries,omitempty"`
 
+	// LocalRegistrySelector selects the Local registries by their labels at
+	// which the local project shall be provisioned at, in addition to the
+	// registries listed in LocalRegistries.
+	//
+	// +kubebuilder:validation:Optional
+	LocalRegistrySelector *metav1.LabelSelector `json:"localRegistrySelector,omitempty"`
+
+	// +kubebuilder:validation:Optional
+
+	// Hub is the name of the GlobalHub registry of a global project. The
+	// repositories of the project are replicated between the hub and the
+	// local registries. If it is not set, the default hub is used, i.e.
+	// the only GlobalHub registry or the one annotated with
+	// registryman.kubermatic.com/defaultHub: "true".
+	Hub string `json:"hub,omitempty"`
+
 	// Members enumerates the project members and their capabilities
 	// provisioned for the specific registry.
 	//
@@ -288,6 +667,15 @@ type ProjectSpec struct {
 	// +listMapKey=name
 	Members []*ProjectMember `json:"members,omitempty"`
 
+	// +kubebuilder:validation:Optional
+	// +listType=set
+
+	// Teams lists the names of the Team resources whose members are the
+	// members of the project, too. If a member is listed both inline and in
+	// a team, the inline member takes precedence. If a member is listed in
+	// several teams, the first team takes precedence.
+	Teams []string `json:"teams,omitempty"`
+
 	// +kubebuilder:validation:Optional
 
 	// Scanner specifies the name of the assigned scanner.
@@ -299,6 +687,390 @@ type ProjectSpec struct {
 	// possible to implement the selected replication trigger, the trigger
 	// may be overridden.
 	Trigger ReplicationTrigger `json:"trigger,omitempty"`
+
+	// +kubebuilder:validation:Optional
+
+	// Replication specifies between which registries the repositories of
+	// the project are replicated. If it is not set, the repositories of a
+	// global project are replicated from its hub to the local registries
+	// and the local projects are not replicated.
+	Replication *ProjectReplication `json:"replication,omitempty"`
+
+	// +kubebuilder:validation:Optional
+
+	// StorageQuota limits the storage the project can use, e.g. 10Gi. If
+	// it is not set, the storage of the project is not limited.
+	StorageQuota *resource.Quantity `json:"storageQuota,omitempty"`
+
+	// +kubebuilder:validation:Optional
+
+	// Settings specifies the project level settings, like visibility or
+	// vulnerability scanning. If it is not set, the settings of the
+	// project are not managed.
+	Settings *ProjectSettings `json:"settings,omitempty"`
+
+	// +kubebuilder:validation:Optional
+
+	// Retention specifies which tags of the project shall be kept. If it
+	// is not set, the tag retention of the project is not managed.
+	Retention *RetentionPolicy `json:"retention,omitempty"`
+
+	// +kubebuilder:validation:Optional
+
+	// ImmutableTags specifies the tags of the project that cannot be
+	// overwritten or deleted. If it is not set, the immutable tag rules of
+	// the project are not managed.
+	ImmutableTags *ImmutableTagPolicy `json:"immutableTags,omitempty"`
+
+	// +kubebuilder:validation:Optional
+
+	// Webhooks specifies the notification targets of the project. If it is
+	// not set, the webhooks of the project are not managed.
+	//
+	// +listType=map
+	// +listMapKey=name
+	Webhooks []WebhookTarget `json:"webhooks,omitempty"`
+}
+
+// WebhookEventType is the type of a project event that triggers a webhook
+// notification.
+//
+// +kubebuilder:validation:Enum=Push;Delete;ScanningCompleted;ScanningFailed
+type WebhookEventType string
+
+const (
+	// PushWebhookEvent is triggered when an artifact is pushed.
+	PushWebhookEvent WebhookEventType = "Push"
+
+	// DeleteWebhookEvent is triggered when an artifact is deleted.
+	DeleteWebhookEvent WebhookEventType = "Delete"
+
+	// ScanningCompletedWebhookEvent is triggered when the vulnerability scan
+	// of an artifact is completed.
+	ScanningCompletedWebhookEvent WebhookEventType = "ScanningCompleted"
+
+	// ScanningFailedWebhookEvent is triggered when the vulnerability scan of
+	// an artifact fails.
+	ScanningFailedWebhookEvent WebhookEventType = "ScanningFailed"
+)
+
+// WebhookTarget describes an HTTP endpoint that is notified about the events
+// of a project.
+type WebhookTarget struct {
+
+	// Name of the webhook. It identifies the webhook within the project.
+	Name string `json:"name"`
+
+	// Endpoint is the URL where the notifications are sent to.
+	Endpoint string `json:"endpoint"`
+
+	// +kubebuilder:validation:MinItems=1
+
+	// EventTypes lists the events that trigger a notification.
+	//
+	// +listType=set
+	EventTypes []WebhookEventType `json:"eventTypes"`
+
+	// +kubebuilder:validation:Optional
+
+	// AuthHeaderSecretRef selects the Secret key which contains the value
+	// of the Authorization header sent with the notifications.
+	AuthHeaderSecretRef *SecretKeyRef `json:"authHeaderSecretRef,omitempty"`
+
+	// +kubebuilder:validation:Optional
+
+	// SkipCertVerify disables the verification of the endpoint's TLS
+	// certificate.
+	SkipCertVerify bool `json:"skipCertVerify,omitempty"`
+}
+
+// SecretKeyRef selects a key of a Secret residing in the namespace of the
+// referring resource.
+type SecretKeyRef struct {
+
+	// Name of the Secret.
+	Name string `json:"name"`
+
+	// Key of the Secret data.
+	Key string `json:"key"`
+}
+
+// WebhookStatus specifies the status of a project webhook.
+type WebhookStatus struct {
+
+	// Name of the webhook.
+	Name string `json:"name"`
+
+	// Endpoint where the notifications are sent to.
+	Endpoint string `json:"endpoint"`
+
+	// Events that trigger a notification.
+	//
+	// +listType=set
+	EventTypes []WebhookEventType `json:"eventTypes"`
+
+	// SkipCertVerify shows whether the TLS certificate of the endpoint is
+	// verified.
+	SkipCertVerify bool `json:"skipCertVerify"`
+
+	// Enabled shows whether the notifications are sent.
+	Enabled bool `json:"enabled"`
+
+	// AuthHeader is the value of the Authorization header sent with the
+	// notifications. It is never serialized to keep the secret out of the
+	// resource status.
+	AuthHeader string `json:"-"`
+
+	// AuthHeaderDigest is the digest of AuthHeader calculated by
+	// AuthHeaderDigest. The webhooks are compared by the digests, because
+	// the registries may not return the auth header as it was written.
+	AuthHeaderDigest string `json:"-"`
+}
+
+// AuthHeaderDigest returns the SHA-256 digest of the auth header of a webhook.
+// Empty string is returned for an empty auth header.
+func AuthHeaderDigest(authHeader string) string {
+	if authHeader == "" {
+		return ""
+	}
+	return fmt.Sprintf("sha256:%x", sha256.Sum256([]byte(authHeader)))
+}
+
+// ProjectReplication describes the replication topology of a project.
+type ProjectReplication struct {
+
+	// +kubebuilder:validation:Optional
+
+	// Routes lists the registry pairs between which the repositories of
+	// the project are replicated. Routes can be chained, e.g. from the hub
+	// to a regional registry and from the regional registry to an edge
+	// registry. If it is empty, the default topology of the project type
+	// is used.
+	//
+	// +listType=atomic
+	Routes []ReplicationRoute `json:"routes,omitempty"`
+
+	// +kubebuilder:validation:Optional
+
+	// Exclude lists the registries which do not take part in the
+	// replication of the project.
+	//
+	// +listType=set
+	Exclude []string `json:"exclude,omitempty"`
+
+	// +kubebuilder:validation:Optional
+
+	// Filters selects the artifacts of the project that are replicated.
+	// If it is not set, all artifacts are replicated.
+	Filters *ReplicationFilters `json:"filters,omitempty"`
+
+	// +kubebuilder:validation:Optional
+
+	// Deletion shows whether the deletion of an artifact is replicated
+	// too. If it is not set, the deletions are replicated.
+	Deletion *bool `json:"deletion,omitempty"`
+
+	// +kubebuilder:validation:Optional
+
+	// Override shows whether the artifacts of the destination registry
+	// are overwritten if they already exist. If it is not set, the
+	// artifacts are overwritten.
+	Override *bool `json:"override,omitempty"`
+}
+
+// ReplicationRoute describes that the repositories of a project are replicated
+// from a source registry to a destination registry.
+type ReplicationRoute struct {
+
+	// From is the name of the source registry.
+	From string `json:"from"`
+
+	// To is the name of the destination registry.
+	To string `json:"to"`
+}
+
+// ReplicationResourceType describes the type of the replicated artifacts.
+// +kubebuilder:validation:Enum=image;chart
+type ReplicationResourceType string
+
+const (
+	// ImageReplicationResourceType selects the container images.
+	ImageReplicationResourceType ReplicationResourceType = "image"
+
+	// ChartReplicationResourceType selects the Helm charts.
+	ChartReplicationResourceType ReplicationResourceType = "chart"
+)
+
+// ReplicationFilters selects the artifacts that are replicated. An artifact is
+// replicated if it matches all the filters that are set.
+type ReplicationFilters struct {
+
+	// +kubebuilder:validation:Optional
+
+	// Tags is a doublestar pattern that selects the tags of the
+	// replicated artifacts, e.g. "v*" or "{release-*,latest}".
+	Tags string `json:"tags,omitempty"`
+
+	// +kubebuilder:validation:Optional
+
+	// ExcludeTags inverts the Tags pattern, i.e. the artifacts with
+	// matching tags are not replicated. E.g. the pattern "*.sig" with
+	// ExcludeTags skips the signatures.
+	ExcludeTags bool `json:"excludeTags,omitempty"`
+
+	// +kubebuilder:validation:Optional
+
+	// Label selects the artifacts that have the given label.
+	Label string `json:"label,omitempty"`
+
+	// +kubebuilder:validation:Optional
+
+	// ResourceType selects the type of the replicated artifacts. If it is
+	// not set, all types are replicated.
+	ResourceType ReplicationResourceType `json:"resourceType,omitempty"`
+}
+
+// ReplicationOptions describes which artifacts are replicated by a replication
+// rule and how.
+type ReplicationOptions struct {
+
+	// +kubebuilder:validation:Optional
+
+	// Filters selects the replicated artifacts.
+	Filters ReplicationFilters `json:"filters,omitempty"`
+
+	// Deletion shows whether the deletion of an artifact is replicated.
+	Deletion bool `json:"deletion"`
+
+	// Override shows whether the existing artifacts of the destination
+	// registry are overwritten.
+	Override bool `json:"override"`
+}
+
+// ImmutableTagPolicy describes the immutable tag rules of a project. A tag is
+// immutable if any of the rules matches it.
+type ImmutableTagPolicy struct {
+
+	// Rules of the immutable tag policy.
+	//
+	// +listType=atomic
+	Rules []ImmutableTagRule `json:"rules"`
+}
+
+// ImmutableTagRule selects the tags that are immutable.
+type ImmutableTagRule struct {
+
+	// +kubebuilder:validation:Optional
+
+	// Repositories is a doublestar pattern that selects the repositories of
+	// the project, e.g. "backend/**". All repositories are selected if
+	// it is not set.
+	Repositories string `json:"repositories,omitempty"`
+
+	// Tags is a doublestar pattern that selects the immutable tags, e.g.
+	// "v*".
+	Tags string `json:"tags"`
+}
+
+// RepositoriesPattern returns the repository pattern of the rule. If it is not
+// set, the pattern matching all repositories is returned.
+func (itr ImmutableTagRule) RepositoriesPattern() string {
+	if itr.Repositories == "" {
+		return "**"
+	}
+	return itr.Repositories
+}
+
+// RetentionPolicy describes the tag retention rules of a project. A tag is
+// kept if any of the rules retains it, the other tags are deleted.
+type RetentionPolicy struct {
+
+	// Rules of the retention policy.
+	//
+	// +listType=atomic
+	Rules []RetentionRule `json:"rules"`
+}
+
+// RetentionRule selects the tags to be kept from the matching repositories.
+type RetentionRule struct {
+
+	// +kubebuilder:validation:Optional
+
+	// Repositories is a doublestar pattern that selects the repositories of
+	// the project, e.g. "backend/**". All repositories are selected if
+	// it is not set.
+	Repositories string `json:"repositories,omitempty"`
+
+	// +kubebuilder:validation:Optional
+
+	// Tags is a doublestar pattern that selects the tags of the
+	// repositories, e.g. "v*". All tags are selected if it is not set.
+	Tags string `json:"tags,omitempty"`
+
+	// +kubebuilder:validation:Enum=LatestPushed;PushedWithinDays
+
+	// Retain selects how the tags are retained. LatestPushed keeps the
+	// Count most recently pushed tags, PushedWithinDays keeps the tags
+	// pushed within the last Count days.
+	Retain string `json:"retain"`
+
+	// +kubebuilder:validation:Minimum=1
+
+	// Count is the number of tags or days, depending on Retain.
+	Count int `json:"count"`
+}
+
+// RepositoriesPattern returns the repository pattern of the rule. If it is not
+// set, the pattern matching all repositories is returned.
+func (rr RetentionRule) RepositoriesPattern() string {
+	if rr.Repositories == "" {
+		return "**"
+	}
+	return rr.Repositories
+}
+
+// TagsPattern returns the tag pattern of the rule. If it is not set, the
+// pattern matching all tags is returned.
+func (rr RetentionRule) TagsPattern() string {
+	if rr.Tags == "" {
+		return "**"
+	}
+	return rr.Tags
+}
+
+// ProjectSettings describes the project level settings of a registry.
+type ProjectSettings struct {
+
+	// +kubebuilder:validation:Optional
+
+	// Public shows whether the repositories of the project can be pulled
+	// without authentication.
+	Public bool `json:"public,omitempty"`
+
+	// +kubebuilder:validation:Optional
+
+	// AutoScan shows whether the images are scanned for vulnerabilities
+	// automatically when they are pushed.
+	AutoScan bool `json:"autoScan,omitempty"`
+
+	// +kubebuilder:validation:Optional
+
+	// EnableContentTrust shows whether only signed images can be pulled.
+	EnableContentTrust bool `json:"enableContentTrust,omitempty"`
+
+	// +kubebuilder:validation:Optional
+
+	// PreventVulnerableImages shows whether the images with
+	// vulnerabilities of at least the configured Severity are prevented
+	// from being pulled.
+	PreventVulnerableImages bool `json:"preventVulnerableImages,omitempty"`
+
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Enum=none;low;medium;high;critical
+
+	// Severity is the vulnerability severity threshold used when
+	// PreventVulnerableImages is set.
+	Severity string `json:"severity,omitempty"`
 }
 
 //------------------------------------------------
@@ -310,10 +1082,13 @@ type ProjectSpec struct {
 type ProjectType int
 
 const (
+	// UndefinedProjectType denotes that the type of the project is not
+	// set.
+	UndefinedProjectType ProjectType = iota
 	// GlobalProjectType is a registry type that hosts all global
 	// projects which are then replicated to all registries of the
 	// LocalProjectType.
-	GlobalProjectType ProjectType = iota
+	GlobalProjectType
 	// LocalProjectType is a registry type that hosts selected local
 	// projects and all Global projects.
 	LocalProjectType
@@ -323,6 +1098,8 @@ func (rt ProjectType) string() (string, error) {
 	switch rt {
 	default:
 		return "", fmt.Errorf("unhandled RegistryType (%d)", rt)
+	case UndefinedProjectType:
+		return "", nil
 	case GlobalProjectType:
 		return "Global", nil
 	case LocalProjectType:
@@ -349,6 +1126,8 @@ func (rt *ProjectType) UnmarshalText(text []byte) error {
 	switch string(text) {
 	default:
 		return fmt.Errorf("failed unmarshalling %s to ProjectType", string(text))
+	case "":
+		*rt = UndefinedProjectType
 	case "Global":
 		*rt = GlobalProjectType
 	case "Local":
@@ -376,6 +1155,52 @@ type ProjectMember struct {
 
 	// DN is optional distinguished name of the user. Used with LDAP integration.
 	DN string `json:"dn,omitempty"`
+
+	// +kubebuilder:validation:Optional
+
+	// GroupType selects the identity provider of a Group member. If
+	// omitted, LDAP is assumed. LDAP groups are identified by their DN,
+	// OIDC groups by their name. It is ignored for the other member types.
+	GroupType GroupType `json:"groupType,omitempty"`
+
+	// +kubebuilder:validation:Optional
+
+	// Rotation describes the expiration and the credential rotation policy
+	// of a Robot member. It is ignored for the other member types.
+	Rotation *CredentialRotation `json:"rotation,omitempty"`
+
+	// +kubebuilder:validation:Optional
+
+	// TargetNamespaces lists the namespaces where the pull secret of a
+	// Robot member is copied to. It is ignored for the other member types.
+	TargetNamespaces []string `json:"targetNamespaces,omitempty"`
+}
+
+// CredentialRotation describes the lifetime of the robot credentials and when
+// they are rotated.
+type CredentialRotation struct {
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Minimum=0
+
+	// ExpiresIn is the lifetime of the robot in days. The robot never
+	// expires, if omitted.
+	ExpiresIn int `json:"expiresIn,omitempty"`
+
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Minimum=0
+
+	// RotateEvery is the number of days after which the secret of the
+	// robot is rotated. The secret is not rotated periodically, if
+	// omitted.
+	RotateEvery int `json:"rotateEvery,omitempty"`
+
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Minimum=0
+
+	// RotateBefore is the number of days before the expiration when the
+	// robot is renewed. Defaults to 7 days, but at most the half of the
+	// lifetime.
+	RotateBefore int `json:"rotateBefore,omitempty"`
 }
 
 func (pm *ProjectMember) UnmarshalJSON(data []byte) error {
@@ -445,6 +1270,21 @@ func (mt *MemberType) UnmarshalText(text []byte) error {
 	return nil
 }
 
+// +kubebuilder:validation:Enum=LDAP;OIDC
+
+// GroupType selects the identity provider of a Group member.
+type GroupType string
+
+const (
+	// LdapGroupType is a group whose members are looked up in LDAP. The
+	// group is identified by its DN.
+	LdapGroupType GroupType = "LDAP"
+
+	// OidcGroupType is a group whose members are provided by the groups
+	// claim of the OIDC provider. The group is identified by its name.
+	OidcGroupType GroupType = "OIDC"
+)
+
 // +kubebuilder:validation:Type=string
 
 // MemberRole shows the capabilities, the role of the member within the project.
@@ -625,8 +1465,16 @@ type ScannerSpec struct {
 	Url string `json:"url,omitempty"`
 
 	// An optional value of the HTTP Authorization header sent with each
-	// request to the Scanner Adapter API.
+	// request to the Scanner Adapter API. Use AccessCredentialSecretRef
+	// instead to keep the credential out of the resource.
 	AccessCredential string `json:"accessCredential,omitempty"`
+
+	// +kubebuilder:validation:Optional
+
+	// AccessCredentialSecretRef selects the Secret key which contains the
+	// value of the HTTP Authorization header sent with each request to the
+	// Scanner Adapter API. It takes precedence over AccessCredential.
+	AccessCredentialSecretRef *SecretKeyRef `json:"accessCredentialSecretRef,omitempty"`
 }
 
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
@@ -637,3 +1485,240 @@ type ScannerList struct {
 	metav1.ListMeta `json:"metadata,omitempty"`
 	Items           []Scanner `json:"items"`
 }
+
+//  ____       _           _      _                             _
+// |  _ \ ___ | |__   ___ | |_   / \   ___ ___ ___  _   _ _ __ | |_
+// | |_) / _ \| '_ \ / _ \| __| / _ \ / __/ __/ _ \| | | | '_ \| __|
+// |  _ < (_) | |_) | (_) | |_ / ___ \ (_| (_| (_) | |_| | | | | |_
+// |_| \_\___/|_.__/ \___/ \__/_/   \_\___\___\___/ \__,_|_| |_|\__|
+
+// +genclient
+// +kubebuilder:resource:path=robotaccounts,scope=Namespaced,singular=robotaccount
+// +kubebuilder:resource:categories="registryman"
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// RobotAccount resource describes a registry level (system) robot account
+// which can access multiple projects of a registry.
+type RobotAccount struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+	// Spec describes the RobotAccount Specification.
+	Spec *RobotAccountSpec `json:"spec"`
+}
+
+// RobotAccountSpec describes the scope and the lifetime of a robot account.
+type RobotAccountSpec struct {
+	// Registry is the name of the Registry resource where the robot
+	// account is created.
+	Registry string `json:"registry"`
+
+	// +kubebuilder:validation:Optional
+
+	// Description of the robot account.
+	Description string `json:"description,omitempty"`
+
+	// +kubebuilder:validation:MinItems=1
+
+	// Projects describes the permissions of the robot account in the
+	// projects.
+	Projects []RobotAccountProject `json:"projects"`
+
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Minimum=0
+
+	// ExpiresIn is the lifetime of the robot account in days. The robot
+	// account never expires, if omitted.
+	ExpiresIn int `json:"expiresIn,omitempty"`
+
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Minimum=0
+
+	// RotateBefore is the number of days before the expiration when the
+	// secret of the robot account is rotated. Defaults to 7 days, but at
+	// most the half of the lifetime.
+	RotateBefore int `json:"rotateBefore,omitempty"`
+}
+
+// RobotAccountProject describes the permissions of a robot account in a
+// project.
+type RobotAccountProject struct {
+	// Name of the project. "*" means all projects of the registry.
+	Name string `json:"name"`
+
+	// +kubebuilder:validation:MinItems=1
+
+	// Permissions of the robot account in the project.
+	Permissions []RobotPermission `json:"permissions"`
+}
+
+// RobotPermission is the type of the permissions that a robot account can
+// have in a project.
+// +kubebuilder:validation:Enum=Pull;Push;DeleteArtifact;ReadHelmChart;PushHelmChart
+type RobotPermission string
+
+const (
+	// PullRobotPermission allows pulling the repositories of the project.
+	PullRobotPermission RobotPermission = "Pull"
+
+	// PushRobotPermission allows pushing the repositories of the project.
+	PushRobotPermission RobotPermission = "Push"
+
+	// DeleteArtifactRobotPermission allows deleting the artifacts of the
+	// project.
+	DeleteArtifactRobotPermission RobotPermission = "DeleteArtifact"
+
+	// ReadHelmChartRobotPermission allows reading the helm charts of the
+	// project.
+	ReadHelmChartRobotPermission RobotPermission = "ReadHelmChart"
+
+	// PushHelmChartRobotPermission allows pushing helm chart versions to
+	// the project.
+	PushHelmChartRobotPermission RobotPermission = "PushHelmChart"
+)
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// RobotAccountList collects RobotAccount resources.
+type RobotAccountList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []RobotAccount `json:"items"`
+}
+
+//  _____
+// |_   _|__  __ _ _ __ ___
+//   | |/ _ \/ _` | '_ ` _ \
+//   | |  __/ (_| | | | | | |
+//   |_|\___|\__,_|_| |_| |_|
+
+// +genclient
+// +kubebuilder:resource:path=teams,scope=Namespaced,singular=team
+// +kubebuilder:resource:categories="registryman"
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// Team resource describes a reusable list of project members. The Projects
+// referring to the Team get its members.
+type Team struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+	// Spec describes the Team Specification.
+	Spec *TeamSpec `json:"spec"`
+}
+
+// TeamSpec describes the members of a team.
+type TeamSpec struct {
+	// +kubebuilder:validation:MinItems=1
+	// +listType=map
+	// +listMapKey=name
+
+	// Members enumerates the members of the team and their capabilities.
+	Members []*ProjectMember `json:"members"`
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// TeamList collects Team resources.
+type TeamList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []Team `json:"items"`
+}
+
+//  ____            _           _    ____ _
+// |  _ \ _ __ ___ (_) ___  ___| |_ / ___| | __ _ ___ ___
+// | |_) | '__/ _ \| |/ _ \/ __| __| |   | |/ _` / __/ __|
+// |  __/| | | (_) | |  __/ (__| |_| |___| | (_| \__ \__ \
+// |_|   |_|  \___// |\___|\___|\__|\____|_|\__,_|___/___/
+//               |__/
+
+// +genclient
+// +kubebuilder:resource:path=projectclasses,scope=Namespaced,singular=projectclass
+// +kubebuilder:resource:categories="registryman"
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// ProjectClass resource describes the defaults of the Projects referring to
+// it.
+type ProjectClass struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+	// Spec describes the ProjectClass Specification.
+	Spec *ProjectClassSpec `json:"spec"`
+}
+
+// ProjectClassSpec describes the defaults of the projects. The meaning of the
+// fields is the same as in ProjectSpec.
+type ProjectClassSpec struct {
+
+	// +kubebuilder:validation:Optional
+
+	// Type specifies the default type of the projects.
+	Type ProjectType `json:"type,omitempty"`
+
+	// +kubebuilder:validation:Optional
+	// +listType=map
+	// +listMapKey=name
+
+	// Members enumerates the default members of the projects. They are
+	// added to the members of the project unless a member of the same type
+	// and name is listed in the project.
+	Members []*ProjectMember `json:"members,omitempty"`
+
+	// +kubebuilder:validation:Optional
+	// +listType=set
+
+	// Teams lists the names of the Team resources which are added to the
+	// teams of the projects.
+	Teams []string `json:"teams,omitempty"`
+
+	// +kubebuilder:validation:Optional
+
+	// Scanner specifies the name of the default scanner.
+	Scanner string `json:"scanner,omitempty"`
+
+	// +kubebuilder:validation:Optional
+
+	// Trigger specifies the default replication trigger.
+	Trigger ReplicationTrigger `json:"trigger,omitempty"`
+
+	// +kubebuilder:validation:Optional
+
+	// Replication specifies the default replication of the projects.
+	Replication *ProjectReplication `json:"replication,omitempty"`
+
+	// +kubebuilder:validation:Optional
+
+	// StorageQuota specifies the default storage quota of the projects.
+	StorageQuota *resource.Quantity `json:"storageQuota,omitempty"`
+
+	// +kubebuilder:validation:Optional
+
+	// Settings specifies the default project level settings.
+	Settings *ProjectSettings `json:"settings,omitempty"`
+
+	// +kubebuilder:validation:Optional
+
+	// Retention specifies the default tag retention policy.
+	Retention *RetentionPolicy `json:"retention,omitempty"`
+
+	// +kubebuilder:validation:Optional
+
+	// ImmutableTags specifies the default immutable tag policy.
+	ImmutableTags *ImmutableTagPolicy `json:"immutableTags,omitempty"`
+
+	// +kubebuilder:validation:Optional
+
+	// Webhooks specifies the default notification targets of the projects.
+	//
+	// +listType=map
+	// +listMapKey=name
+	Webhooks []WebhookTarget `json:"webhooks,omitempty"`
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// ProjectClassList collects ProjectClass resources.
+type ProjectClassList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []ProjectClass `json:"items"`
+}
@@ -37,6 +37,15 @@ var projectCRDYaml []byte
 //go:embed registryman.kubermatic.com_scanners.yaml
 var scannerCRDYaml []byte
 
+//go:embed registryman.kubermatic.com_robotaccounts.yaml
+var robotAccountCRDYaml []byte
+
+//go:embed registryman.kubermatic.com_teams.yaml
+var teamCRDYaml []byte
+
+//go:embed registryman.kubermatic.com_projectclasses.yaml
+var projectClassCRDYaml []byte
+
 // RegistryValidator can validate a resource against the CRD validation rules of
 // a Registry resource.
 var RegistryValidator *validate.SchemaValidator
@@ -49,6 +58,18 @@ var ProjectValidator *validate.SchemaValidator
 // a Scanner resource.
 var ScannerValidator *validate.SchemaValidator
 
+// RobotAccountValidator can validate a resource against the CRD validation
+// rules of a RobotAccount resource.
+var RobotAccountValidator *validate.SchemaValidator
+
+// TeamValidator can validate a resource against the CRD validation rules of a
+// Team resource.
+var TeamValidator *validate.SchemaValidator
+
+// ProjectClassValidator can validate a resource against the CRD validation
+// rules of a ProjectClass resource.
+var ProjectClassValidator *validate.SchemaValidator
+
 func init() {
 	scheme := runtime.NewScheme()
 	err := apiextv1.AddToScheme(scheme)
@@ -111,6 +132,51 @@ func init() {
 		panic("scanner CRD yaml is not a valid CustomResourceDefinition")
 	}
 
+	robotAccountCRDv1, _, err := serializer.Decode(robotAccountCRDYaml, nil, nil)
+	if err != nil {
+		panic(err)
+	}
+
+	robotAccountCRDObject, err := scheme.ConvertToVersion(robotAccountCRDv1, apiext.SchemeGroupVersion)
+	if err != nil {
+		panic(err)
+	}
+
+	robotAccountCRD, ok := robotAccountCRDObject.(*apiext.CustomResourceDefinition)
+	if !ok {
+		panic("robot account CRD yaml is not a valid CustomResourceDefinition")
+	}
+
+	teamCRDv1, _, err := serializer.Decode(teamCRDYaml, nil, nil)
+	if err != nil {
+		panic(err)
+	}
+
+	teamCRDObject, err := scheme.ConvertToVersion(teamCRDv1, apiext.SchemeGroupVersion)
+	if err != nil {
+		panic(err)
+	}
+
+	teamCRD, ok := teamCRDObject.(*apiext.CustomResourceDefinition)
+	if !ok {
+		panic("team CRD yaml is not a valid CustomResourceDefinition")
+	}
+
+	projectClassCRDv1, _, err := serializer.Decode(projectClassCRDYaml, nil, nil)
+	if err != nil {
+		panic(err)
+	}
+
+	projectClassCRDObject, err := scheme.ConvertToVersion(projectClassCRDv1, apiext.SchemeGroupVersion)
+	if err != nil {
+		panic(err)
+	}
+
+	projectClassCRD, ok := projectClassCRDObject.(*apiext.CustomResourceDefinition)
+	if !ok {
+		panic("project class CRD yaml is not a valid CustomResourceDefinition")
+	}
+
 	RegistryValidator, _, err = validation.NewSchemaValidator(registryCRD.Spec.Validation)
 	if err != nil {
 		panic(err)
@@ -125,4 +191,19 @@ func init() {
 	if err != nil {
 		panic(err)
 	}
+
+	RobotAccountValidator, _, err = validation.NewSchemaValidator(robotAccountCRD.Spec.Validation)
+	if err != nil {
+		panic(err)
+	}
+
+	TeamValidator, _, err = validation.NewSchemaValidator(teamCRD.Spec.Validation)
+	if err != nil {
+		panic(err)
+	}
+
+	ProjectClassValidator, _, err = validation.NewSchemaValidator(projectClassCRD.Spec.Validation)
+	if err != nil {
+		panic(err)
+	}
 }
@@ -97,6 +97,39 @@ var _ = Describe("Validation", func() {
 		Expect(results.HasErrorsOrWarnings()).To(BeFalse())
 	})
 
+	It("can validate valid RobotAccount resources", func() {
+		robotAccount, err := objectFromFile("testdata/robot-account.yaml")
+		Expect(err).ToNot(HaveOccurred())
+
+		results := api.RobotAccountValidator.Validate(robotAccount)
+		if results.HasErrors() {
+			fmt.Fprintln(GinkgoWriter, results.AsError().Error())
+		}
+		Expect(results.HasErrorsOrWarnings()).To(BeFalse())
+	})
+
+	It("can validate valid Team resources", func() {
+		team, err := objectFromFile("testdata/team.yaml")
+		Expect(err).ToNot(HaveOccurred())
+
+		results := api.TeamValidator.Validate(team)
+		if results.HasErrors() {
+			fmt.Fprintln(GinkgoWriter, results.AsError().Error())
+		}
+		Expect(results.HasErrorsOrWarnings()).To(BeFalse())
+	})
+
+	It("can validate valid ProjectClass resources", func() {
+		projectClass, err := objectFromFile("testdata/projectclass.yaml")
+		Expect(err).ToNot(HaveOccurred())
+
+		results := api.ProjectClassValidator.Validate(projectClass)
+		if results.HasErrors() {
+			fmt.Fprintln(GinkgoWriter, results.AsError().Error())
+		}
+		Expect(results.HasErrorsOrWarnings()).To(BeFalse())
+	})
+
 	It("will fail for invalid Registry resources", func() {
 		registry, err := objectFromFile("testdata/registry-wrong-apiendpoint.yaml")
 		Expect(err).ToNot(HaveOccurred())
@@ -133,4 +166,58 @@ var _ = Describe("Validation", func() {
 			}
 		}
 	})
+	It("will fail for invalid RobotAccount resources", func() {
+		robotAccount, err := objectFromFile("testdata/robot-account-wrong-permission.yaml")
+		Expect(err).ToNot(HaveOccurred())
+
+		results := api.RobotAccountValidator.Validate(robotAccount)
+		Expect(results.HasErrorsOrWarnings()).To(BeTrue())
+		if results.HasErrors() {
+			fmt.Fprintln(GinkgoWriter, results.AsError().Error())
+			c, ok := results.AsError().(*errors.CompositeError)
+			Expect(ok).To(BeTrue())
+			Expect(len(c.Errors)).Should(Equal(1))
+			for _, e := range c.Errors {
+				v := e.(*errors.Validation)
+				Expect(v.Name).To(Equal("spec.projects[0].permissions[0]"))
+				Expect(v.Code()).Should(Equal(int32(errors.EnumFailCode)))
+			}
+		}
+	})
+	It("will fail for invalid Team resources", func() {
+		team, err := objectFromFile("testdata/team-without-members.yaml")
+		Expect(err).ToNot(HaveOccurred())
+
+		results := api.TeamValidator.Validate(team)
+		Expect(results.HasErrorsOrWarnings()).To(BeTrue())
+		if results.HasErrors() {
+			fmt.Fprintln(GinkgoWriter, results.AsError().Error())
+			c, ok := results.AsError().(*errors.CompositeError)
+			Expect(ok).To(BeTrue())
+			Expect(len(c.Errors)).Should(Equal(1))
+			for _, e := range c.Errors {
+				v := e.(*errors.Validation)
+				Expect(v.Name).To(Equal("spec.members"))
+				Expect(v.Code()).Should(Equal(int32(errors.MinItemsFailCode)))
+			}
+		}
+	})
+	It("will fail for invalid ProjectClass resources", func() {
+		projectClass, err := objectFromFile("testdata/projectclass-invalid-group-type.yaml")
+		Expect(err).ToNot(HaveOccurred())
+
+		results := api.ProjectClassValidator.Validate(projectClass)
+		Expect(results.HasErrorsOrWarnings()).To(BeTrue())
+		if results.HasErrors() {
+			fmt.Fprintln(GinkgoWriter, results.AsError().Error())
+			c, ok := results.AsError().(*errors.CompositeError)
+			Expect(ok).To(BeTrue())
+			Expect(len(c.Errors)).Should(Equal(1))
+			for _, e := range c.Errors {
+				v := e.(*errors.Validation)
+				Expect(v.Name).To(Equal("spec.members[0].groupType"))
+				Expect(v.Code()).Should(Equal(int32(errors.EnumFailCode)))
+			}
+		}
+	})
 })
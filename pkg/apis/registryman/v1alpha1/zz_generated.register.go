@@ -59,11 +59,17 @@ func init() {
 func addKnownTypes(scheme *runtime.Scheme) error {
 	scheme.AddKnownTypes(SchemeGroupVersion,
 		&Project{},
+		&ProjectClass{},
+		&ProjectClassList{},
 		&ProjectList{},
 		&Registry{},
 		&RegistryList{},
+		&RobotAccount{},
+		&RobotAccountList{},
 		&Scanner{},
 		&ScannerList{},
+		&Team{},
+		&TeamList{},
 	)
 	// AddToGroupVersion allows the serialization of client types like ListOptions.
 	v1.AddToGroupVersion(scheme, SchemeGroupVersion)
@@ -18,6 +18,7 @@ package pathbased
 
 import (
 	"context"
+	"strings"
 
 	"github.com/kubermatic-labs/registryman/pkg/globalregistry"
 )
@@ -30,6 +31,9 @@ const (
 type projectMemberEntity struct {
 	Name  string   `json:"name"`
 	Roles []string `json:"roles"`
+
+	// registryman role of the member
+	role string
 }
 
 type projectMember projectMemberEntity
@@ -45,7 +49,7 @@ func (m projectMember) GetType() string {
 }
 
 func (m projectMember) GetRole() string {
-	return roleFromList(m.Roles)
+	return m.role
 }
 
 type groupMember projectMemberEntity
@@ -61,7 +65,7 @@ func (m groupMember) GetType() string {
 }
 
 func (m groupMember) GetRole() string {
-	return roleFromList(m.Roles)
+	return m.role
 }
 
 func (r *pathRegistry) getMembers(ctx context.Context, p *project) ([]globalregistry.ProjectMember, error) {
@@ -72,11 +76,16 @@ func (r *pathRegistry) getMembers(ctx context.Context, p *project) ([]globalregi
 
 	projectMembersResult := make([]globalregistry.ProjectMember, len(projectMembers.Principals.Users)+len(projectMembers.Principals.Groups))
 
+	roleTable, err := r.roleTable()
+	if err != nil {
+		return nil, err
+	}
 	c := 0
 	for user, roles := range projectMembers.Principals.Users {
 		projectMembersResult[c] = projectMember{
 			Name:  user,
 			Roles: roles,
+			role:  roleTable.RoleOfPermissions(userType, strings.Join(roles, ",")),
 		}
 		c++
 	}
@@ -84,6 +93,7 @@ func (r *pathRegistry) getMembers(ctx context.Context, p *project) ([]globalregi
 		projectMembersResult[c] = groupMember{
 			Name:  group,
 			Roles: roles,
+			role:  roleTable.RoleOfPermissions(groupType, strings.Join(roles, ",")),
 		}
 		c++
 	}
@@ -19,7 +19,6 @@ package pathbased
 import (
 	"context"
 	"fmt"
-	"strings"
 
 	"github.com/kubermatic-labs/registryman/pkg/globalregistry"
 )
@@ -56,10 +55,15 @@ func (p *project) Delete(ctx context.Context) error {
 }
 
 func (p *project) AssignMember(ctx context.Context, member globalregistry.ProjectMember) (*globalregistry.ProjectMemberCredentials, error) {
-	role, err := roleFromString(member.GetRole())
+	roleTable, err := p.registry.roleTable()
 	if err != nil {
 		return nil, err
 	}
+	providerRole, err := roleTable.ProviderRoleOf(member.GetType(), member.GetRole())
+	if err != nil {
+		return nil, err
+	}
+	permissions := globalregistry.SplitProviderRole(providerRole)
 	permissionReqBody, err := p.registry.getPermission(ctx, p.registry.GetDockerRegistryName()+"_"+p.GetName())
 	if err != nil {
 		return nil, err
@@ -73,14 +77,14 @@ func (p *project) AssignMember(ctx context.Context, member globalregistry.Projec
 			permissionReqBody.Principals.Users = make(map[string][]string)
 		}
 
-		permissionReqBody.Principals.Users[member.GetName()] = strings.Split(role.String(), ",")
+		permissionReqBody.Principals.Users[member.GetName()] = permissions
 
 	case groupType:
 		if permissionReqBody.Principals.Groups == nil {
 			permissionReqBody.Principals.Groups = make(map[string][]string)
 		}
 
-		permissionReqBody.Principals.Groups[member.GetName()] = strings.Split(role.String(), ",")
+		permissionReqBody.Principals.Groups[member.GetName()] = permissions
 	}
 
 	err = p.registry.createPermission(ctx, p.GetName(), permissionReqBody)
@@ -17,74 +17,29 @@
 package pathbased
 
 import (
-	"fmt"
-	"sort"
-	"strings"
+	"github.com/kubermatic-labs/registryman/pkg/globalregistry"
 )
 
-type role int
-
-const (
-	projectAdminRole role = 1
-	developerRole    role = 2
-	guestRole        role = 3
-	maintainerRole   role = 4
-)
-
-// String method implements the Stringer interface for Role.
-func (r role) String() string {
-	switch r {
-	case projectAdminRole:
-		return "r,mxm,d,w,m,n"
-	case developerRole:
-		return "r,d,w,n"
-	case guestRole:
-		return "r"
-	case maintainerRole:
-		return "r,d,w,m,n"
-	default:
-		return "*unknown-role*"
-	}
-}
-
-func roleFromList(s []string) string {
-	sort.Strings(s)
-	if contains(s, "r") {
-		if contains(s, "d") && contains(s, "w") && contains(s, "n") {
-			if contains(s, "m") {
-				if contains(s, "mxm") {
-					return "ProjectAdmin"
-				}
-				return "Maintainer"
-			}
-			return "Developer"
-		}
-		return "Guest"
-	}
-	fmt.Printf("%v\n", s)
-	return "*unknown-role*"
-}
-
-func contains(s []string, searchterm string) bool {
-	i := sort.SearchStrings(s, searchterm)
-	return i < len(s) && s[i] == searchterm
+// Roles is the role table of the path based Artifactory registries. The
+// provider specific roles are the lists of the permissions that the members
+// get in the permission target of the project.
+var Roles = globalregistry.RoleTable{
+	userType: {
+		"Guest":        "r",
+		"Developer":    "r,d,w,n",
+		"Maintainer":   "r,d,w,m,n",
+		"ProjectAdmin": "r,mxm,d,w,m,n",
+	},
+	groupType: {
+		"Guest":        "r",
+		"Developer":    "r,d,w,n",
+		"Maintainer":   "r,d,w,m,n",
+		"ProjectAdmin": "r,mxm,d,w,m,n",
+	},
 }
 
-func roleFromString(s string) (role, error) {
-	for _, r := range strings.Split(s, ",") {
-
-		switch r {
-		case "ProjectAdmin":
-			return projectAdminRole, nil
-		case "Developer":
-			return developerRole, nil
-		case "Guest":
-			return guestRole, nil
-		case "Maintainer":
-			return maintainerRole, nil
-		default:
-			return role(-1), fmt.Errorf("unknown role: %s", s)
-		}
-	}
-	return role(-1), fmt.Errorf("unknown role: %s", s)
+// roleTable returns the role table of the registry, i.e. the path based
+// Artifactory role table with the role mapping of the registry applied.
+func (r *pathRegistry) roleTable() (globalregistry.RoleTable, error) {
+	return globalregistry.GetRoleTable(r.Registry)
 }
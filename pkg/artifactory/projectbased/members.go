@@ -36,6 +36,9 @@ type projectMembers struct {
 type projectMember struct {
 	Name  string   `json:"name"`
 	Roles []string `json:"roles"`
+
+	// registryman role of the member
+	role string
 }
 
 var _ globalregistry.ProjectMember = &projectMember{}
@@ -49,7 +52,7 @@ func (m *projectMember) GetType() string {
 }
 
 func (m *projectMember) GetRole() string {
-	return strings.Join(m.Roles, ",")
+	return m.role
 }
 
 func (m *projectMember) toProjectMember() globalregistry.ProjectMember {
@@ -89,5 +92,13 @@ func (r *projectRegistry) getMembers(ctx context.Context, projectKey string) ([]
 		r.logger.Info(b.String())
 		fmt.Printf("body: %+v\n", b.String())
 	}
+	roleTable, err := r.roleTable()
+	if err != nil {
+		return nil, err
+	}
+	for i := range projectMembersResult.Members {
+		m := &projectMembersResult.Members[i]
+		m.role = roleTable.Role(userType, strings.Join(m.Roles, ","))
+	}
 	return projectMembersResult.Members, err
 }
@@ -36,6 +36,8 @@ var _ globalregistry.ProjectWithMembers = &project{}
 
 // var _ globalregistry.MemberManipulatorProject = &project{}
 var _ globalregistry.DestructibleProject = &project{}
+var _ globalregistry.ProjectWithStorageQuota = &project{}
+var _ globalregistry.StorageQuotaManipulatorProject = &project{}
 
 func (p *project) GetName() string {
 	return p.Name
@@ -76,10 +78,8 @@ func (p *project) GetMembers(ctx context.Context) ([]globalregistry.ProjectMembe
 	}
 	projectMembers := make([]globalregistry.ProjectMember, len(members))
 
-	c := 0
-	for _, m := range members {
-		projectMembers[c] = m.toProjectMember()
-		c++
+	for i := range members {
+		projectMembers[i] = members[i].toProjectMember()
 	}
 
 	return projectMembers, nil
@@ -97,3 +97,15 @@ func (p *project) deleteRepository(ctx context.Context, r string) error {
 func (p *project) GetUsedStorage(ctx context.Context) (int, error) {
 	return p.registry.getUsedStorage(ctx, p)
 }
+
+// GetStorageQuota implements the globalregistry.ProjectWithStorageQuota
+// interface.
+func (p *project) GetStorageQuota(ctx context.Context) (int, error) {
+	return p.registry.getStorageQuota(ctx, p)
+}
+
+// SetStorageQuota implements the globalregistry.StorageQuotaManipulatorProject
+// interface.
+func (p *project) SetStorageQuota(ctx context.Context, storageQuota int) error {
+	return p.registry.setStorageQuota(ctx, p, storageQuota)
+}
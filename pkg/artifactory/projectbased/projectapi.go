@@ -353,3 +353,91 @@ func (r *projectRegistry) getUsedStorage(ctx context.Context, proj *project) (in
 	}
 	return -1, nil
 }
+
+// getProjectData decodes the project returned by the Artifactory API into the
+// data parameter.
+func (r *projectRegistry) getProjectData(ctx context.Context, proj *project, data interface{}) error {
+	apiUrl := *r.parsedUrl
+	apiUrl.Path = fmt.Sprintf("%s/%s", projectPath, proj.key)
+	req, err := http.NewRequest(http.MethodGet, apiUrl.String(), nil)
+	if err != nil {
+		return err
+	}
+
+	req.Header.Add("Authorization", "Bearer "+r.getAccessToken())
+	req.Header.Add("Accept", "application/json")
+
+	resp, err := r.do(ctx, req)
+	if err != nil {
+		return err
+	}
+
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("getting project %s failed: %s", proj.key, resp.Status)
+	}
+	return json.NewDecoder(resp.Body).Decode(data)
+}
+
+func (r *projectRegistry) getStorageQuota(ctx context.Context, proj *project) (int, error) {
+	r.logger.V(1).Info("getting storage quota of a project",
+		"projectName", proj.Name,
+	)
+
+	projectData := &projectStatus{}
+	err := r.getProjectData(ctx, proj, projectData)
+	if err != nil {
+		return -1, err
+	}
+	if projectData.StorageQuotaBytes <= 0 {
+		return -1, nil
+	}
+	return projectData.StorageQuotaBytes, nil
+}
+
+func (r *projectRegistry) setStorageQuota(ctx context.Context, proj *project, storageQuota int) error {
+	r.logger.V(1).Info("setting storage quota of a project",
+		"projectName", proj.Name,
+		"storageQuota", storageQuota,
+	)
+
+	// The update endpoint replaces the project, so the project is read
+	// first and sent back with only the storage quota changed. The project
+	// is kept as raw JSON, so that the fields unknown to registryman are
+	// kept as well. Artifactory handles -1 as unlimited storage.
+	projectData := map[string]json.RawMessage{}
+	err := r.getProjectData(ctx, proj, &projectData)
+	if err != nil {
+		return err
+	}
+	projectData["storage_quota_bytes"], err = json.Marshal(storageQuota)
+	if err != nil {
+		return err
+	}
+
+	apiUrl := *r.parsedUrl
+	apiUrl.Path = fmt.Sprintf("%s/%s", projectPath, proj.key)
+	reqBodyBuf := bytes.NewBuffer(nil)
+	err = json.NewEncoder(reqBodyBuf).Encode(projectData)
+	if err != nil {
+		return err
+	}
+	req, err := http.NewRequest(http.MethodPut, apiUrl.String(), reqBodyBuf)
+	if err != nil {
+		return err
+	}
+
+	req.Header["Content-Type"] = []string{"application/json"}
+	req.Header.Add("Authorization", "Bearer "+r.getAccessToken())
+	req.Header.Add("Accept", "application/json")
+
+	resp, err := r.do(ctx, req)
+	if err != nil {
+		return err
+	}
+
+	defer resp.Body.Close()
+
+	return nil
+}
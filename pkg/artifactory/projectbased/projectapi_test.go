@@ -0,0 +1,87 @@
+/*
+   Copyright 2021 The Kubermatic Kubernetes Platform contributors.
+
+   Licensed under the Apache License, Version 2.0 (the "License");
+   you may not use this file except in compliance with the License.
+   You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+   Unless required by applicable law or agreed to in writing, software
+   distributed under the License is distributed on an "AS IS" BASIS,
+   WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+   See the License for the specific language governing permissions and
+   limitations under the License.
+*/
+
+package projectbased
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+
+	"github.com/go-logr/logr"
+	"github.com/kubermatic-labs/registryman/pkg/globalregistry/globalregistrytest"
+)
+
+func TestSetStorageQuota(t *testing.T) {
+	const storedProject = `{
+		"display_name": "Applications",
+		"description": "application images",
+		"admin_privileges": {"manage_members": true, "manage_resources": true},
+		"storage_quota_bytes": 1024,
+		"soft_limit": true,
+		"storage_quota_email_notification": true,
+		"project_key": "apps"
+	}`
+	var updated map[string]interface{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		if req.URL.Path != projectPath+"/apps" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		switch req.Method {
+		case http.MethodGet:
+			_, _ = io.WriteString(w, storedProject)
+		case http.MethodPut:
+			if err := json.NewDecoder(req.Body).Decode(&updated); err != nil {
+				w.WriteHeader(http.StatusBadRequest)
+				return
+			}
+			w.WriteHeader(http.StatusOK)
+		default:
+			w.WriteHeader(http.StatusMethodNotAllowed)
+		}
+	}))
+	defer server.Close()
+
+	reg, err := NewRegistry(logr.Discard(), server.Client(), &globalregistrytest.Registry{
+		Provider:    "artifactory",
+		APIEndpoint: server.URL,
+	}, "token")
+	if err != nil {
+		t.Fatal(err)
+	}
+	proj := &project{
+		key:      "apps",
+		registry: reg.(*projectRegistry),
+		Name:     "Applications",
+	}
+	if err = proj.registry.setStorageQuota(context.Background(), proj, 2048); err != nil {
+		t.Fatal(err)
+	}
+
+	var expected map[string]interface{}
+	if err = json.Unmarshal([]byte(storedProject), &expected); err != nil {
+		t.Fatal(err)
+	}
+	expected["storage_quota_bytes"] = float64(2048)
+	if !reflect.DeepEqual(updated, expected) {
+		t.Errorf("unexpected update request body: %v", updated)
+	}
+}
@@ -17,43 +17,22 @@
 package projectbased
 
 import (
-	"encoding/json"
+	"github.com/kubermatic-labs/registryman/pkg/globalregistry"
 )
 
-type role int
-
-const (
-	projectAdminRole role = 1
-	developerRole    role = 2
-	guestRole        role = 3
-	maintainerRole   role = 4
-)
-
-func (r *role) UnmarshalJSON(b []byte) error {
-	var i int
-	if err := json.Unmarshal(b, &i); err != nil {
-		return err
-	}
-	*r = role(i)
-	return nil
-}
-
-func (r role) MarshalJSON() ([]byte, error) {
-	return json.Marshal(int(r))
+// Roles is the role table of the project based Artifactory registries. The
+// provider specific roles are the Artifactory project roles.
+var Roles = globalregistry.RoleTable{
+	userType: {
+		"Guest":        "Viewer",
+		"Developer":    "Developer",
+		"Maintainer":   "Release Manager",
+		"ProjectAdmin": "Project Admin",
+	},
 }
 
-// String method implements the Stringer interface for Role.
-func (r role) String() string {
-	switch r {
-	case projectAdminRole:
-		return "Project Admin"
-	case developerRole:
-		return "Developer"
-	case guestRole:
-		return "Viewer"
-	case maintainerRole:
-		return "Release Manager"
-	default:
-		return "*unknown-role*"
-	}
+// roleTable returns the role table of the registry, i.e. the project based
+// Artifactory role table with the role mapping of the registry applied.
+func (r *projectRegistry) roleTable() (globalregistry.RoleTable, error) {
+	return globalregistry.GetRoleTable(r.Registry)
 }
@@ -34,6 +34,7 @@ func init() {
 		"artifactory",
 		newRegistry,
 		artifactoryRegistryCapabilities{},
+		artifactoryRoleCapabilities{},
 	)
 }
 
@@ -51,16 +52,11 @@ func newRegistry(logger logr.Logger, config globalregistry.Registry) (globalregi
 		return err
 	}
 
-	dockerRegistryName := ""
-	if val, ok := config.GetAnnotations()["registryman.kubermatic.com/dockerRegistryName"]; ok {
-		dockerRegistryName = val
-	}
+	dockerRegistryName := getDockerRegistryName(config)
 
 	if dockerRegistryName == "" {
-		accessToken := ""
-		if val, ok := config.GetAnnotations()["registryman.kubermatic.com/accessToken"]; ok {
-			accessToken = val
-
+		accessToken := getAccessToken(config)
+		if accessToken != "" {
 			c, err := projectbased.NewRegistry(
 				logger,
 				client,
@@ -83,6 +79,34 @@ func newRegistry(logger logr.Logger, config globalregistry.Registry) (globalregi
 	return c, nil
 }
 
+// getDockerRegistryName returns the name of the docker registry configured by
+// the registryman.kubermatic.com/dockerRegistryName annotation. Path based
+// Artifactory registries are used when it is set.
+func getDockerRegistryName(config globalregistry.Registry) string {
+	return config.GetAnnotations()["registryman.kubermatic.com/dockerRegistryName"]
+}
+
+// getAccessToken returns the access token of the registry. Project based
+// Artifactory registries are used when it is set.
+func getAccessToken(config globalregistry.Registry) string {
+	if registryWithToken, ok := config.(globalregistry.RegistryWithToken); ok {
+		return registryWithToken.GetToken()
+	}
+	return config.GetAnnotations()["registryman.kubermatic.com/accessToken"]
+}
+
+type artifactoryRoleCapabilities struct{}
+
+// GetRoleTable returns the role table of the path based or the project based
+// Artifactory implementation, depending on which one would be constructed for
+// the given registry.
+func (cap artifactoryRoleCapabilities) GetRoleTable(config globalregistry.Registry) globalregistry.RoleTable {
+	if getDockerRegistryName(config) == "" && getAccessToken(config) != "" {
+		return projectbased.Roles
+	}
+	return pathbased.Roles
+}
+
 type artifactoryRegistryCapabilities struct{}
 
 func (cap artifactoryRegistryCapabilities) CanPull() bool {
@@ -23,8 +23,11 @@ import (
 	_ "github.com/kubermatic-labs/registryman/pkg/acr"
 	api "github.com/kubermatic-labs/registryman/pkg/apis/registryman/v1alpha1"
 	_ "github.com/kubermatic-labs/registryman/pkg/artifactory"
+	_ "github.com/kubermatic-labs/registryman/pkg/distribution"
+	_ "github.com/kubermatic-labs/registryman/pkg/gitlab"
 	"github.com/kubermatic-labs/registryman/pkg/globalregistry"
 	_ "github.com/kubermatic-labs/registryman/pkg/harbor"
+	_ "github.com/kubermatic-labs/registryman/pkg/quay"
 
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -47,6 +50,13 @@ func init() {
 		panic(err)
 	}
 	secretScheme.AddKnownTypeWithName(secret.GroupVersionKind(), secret)
+	configMap := &corev1.ConfigMap{
+		TypeMeta: metav1.TypeMeta{
+			Kind:       "ConfigMap",
+			APIVersion: "v1",
+		},
+	}
+	secretScheme.AddKnownTypeWithName(configMap.GroupVersionKind(), configMap)
 }
 
 // ApiObjectStore interface is an abstract interface that hides the difference
@@ -61,12 +71,42 @@ type ApiObjectStore interface {
 	// GetRegistries returns the parsed registries as API objects.
 	GetRegistries(context.Context) []*api.Registry
 
-	// GetProjects returns the parsed projects as API objects.
+	// GetProjects returns the parsed projects as API objects. The defaults
+	// of the project classes are applied to the projects.
 	GetProjects(context.Context) []*api.Project
 
+	// GetRawProjects returns the parsed projects as API objects without the
+	// defaults of the project classes applied.
+	GetRawProjects(context.Context) []*api.Project
+
 	// GetScanners returns the parsed scanners as API objects.
 	GetScanners(context.Context) []*api.Scanner
 
+	// GetRobotAccounts returns the parsed robot accounts as API objects.
+	GetRobotAccounts(context.Context) []*api.RobotAccount
+
+	// GetTeams returns the parsed teams as API objects.
+	GetTeams(context.Context) []*api.Team
+
+	// GetProjectClasses returns the parsed project classes as API objects.
+	GetProjectClasses(context.Context) []*api.ProjectClass
+
+	// GetSecretValue returns the value of the Secret key selected by ref.
+	// An error is returned if the value cannot be resolved.
+	GetSecretValue(ctx context.Context, ref *api.SecretKeyRef) (string, error)
+
+	// GetSecret returns the Secret with the given name. nil is returned if
+	// the Secret does not exist.
+	GetSecret(ctx context.Context, name string) (*corev1.Secret, error)
+
+	// GetSecretAnnotations returns the annotations of the Secret with the
+	// given name. nil is returned if the Secret does not exist.
+	GetSecretAnnotations(ctx context.Context, name string) (map[string]string, error)
+
+	// GetConfigMapData returns the data of the ConfigMap with the given
+	// name. nil is returned if the ConfigMap does not exist.
+	GetConfigMapData(ctx context.Context, name string) (map[string]string, error)
+
 	// GetGlobalRegistryOptions returns the ApiObjectStore related CLI options of an
 	// apply.
 	GetGlobalRegistryOptions() globalregistry.RegistryOptions
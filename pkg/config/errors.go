@@ -22,14 +22,31 @@ import "errors"
 // project refers to a non-existing registry.
 var ErrValidationInvalidLocalRegistryInProject error = errors.New("validation error: project contains invalid registry name")
 
+// ErrValidationInvalidLocalRegistrySelector error indicates that the local
+// registry selector of a project is invalid or the project is not local.
+var ErrValidationInvalidLocalRegistrySelector error = errors.New("validation error: project contains invalid local registry selector")
+
 // ErrValidationMultipleGlobalRegistries error indicates that there are multiple
-// global registries configured.
-var ErrValidationMultipleGlobalRegistries error = errors.New("validation error: multiple global registries found")
+// global registries annotated as the default hub.
+var ErrValidationMultipleGlobalRegistries error = errors.New("validation error: multiple default global registries found")
+
+// ErrValidationInvalidHubInProject error indicates that the hub of a global
+// project cannot be determined or that a local project refers to a hub.
+var ErrValidationInvalidHubInProject error = errors.New("validation error: project refers to an invalid hub")
 
 // ErrValidationArtifactoryAnnotations error indicates that the annotations are
 // wrongly set.
 var ErrValidationArtifactoryAnnotations error = errors.New("validation error: wrong artifactory annotations")
 
+// ErrValidationInvalidReplicationRoute error indicates that the replication
+// configuration of a project refers to a registry where the project is not
+// provisioned.
+var ErrValidationInvalidReplicationRoute error = errors.New("validation error: project has invalid replication route")
+
+// ErrValidationInvalidCronSchedule error indicates that the cron schedule of a
+// project's replication trigger cannot be parsed.
+var ErrValidationInvalidCronSchedule error = errors.New("validation error: project has invalid cron schedule")
+
 // ErrValidationScannerNameNotUnique error indicates that there are multiple
 // scanners configured with the same name.
 var ErrValidationScannerNameNotUnique error = errors.New("validation error: multiple scanners present with the same name")
@@ -46,6 +63,60 @@ var ErrValidationRegistryNameNotUnique error = errors.New("validation error: mul
 // non-existing Scanner.
 var ErrValidationScannerNameReference error = errors.New("validation error: project refers to a non-existing scanner")
 
-// ErrValidationScannerNameReference error indicates that a project refers to a
-// non-existing Scanner.
+// ErrValidationGroupWithoutDN error indicates that an LDAP group member of a
+// project has no DN.
 var ErrValidationGroupWithoutDN error = errors.New("validation error: project group member with missing DN field")
+
+// ErrValidationOidcGroupWithDN error indicates that an OIDC group member of a
+// project has DN. OIDC groups are identified by their names.
+var ErrValidationOidcGroupWithDN error = errors.New("validation error: OIDC group member with DN field")
+
+// ErrValidationRobotAccountRegistryReference error indicates that a robot
+// account refers to a non-existing registry.
+var ErrValidationRobotAccountRegistryReference error = errors.New("validation error: robot account refers to a non-existing registry")
+
+// ErrValidationRobotAccountProjectReference error indicates that a robot
+// account refers to a non-existing project.
+var ErrValidationRobotAccountProjectReference error = errors.New("validation error: robot account refers to a non-existing project")
+
+// ErrValidationRobotAccountNameNotUnique error indicates that there are
+// multiple robot accounts with the same name in a registry.
+var ErrValidationRobotAccountNameNotUnique error = errors.New("validation error: multiple robot accounts present with the same name")
+
+// ErrValidationUnsupportedRole error indicates that a project member has a
+// role that a registry of the project cannot express.
+var ErrValidationUnsupportedRole error = errors.New("validation error: project member role is not supported by the registry")
+
+// ErrValidationUnsupportedRetention error indicates that a project with a tag
+// retention policy is provisioned in a registry that cannot manage it.
+var ErrValidationUnsupportedRetention error = errors.New("validation error: tag retention is not supported by the registry")
+
+// ErrValidationInvalidRoleMapping error indicates that the role mapping of a
+// registry maps a role more than once or maps a role to an empty provider
+// specific role.
+var ErrValidationInvalidRoleMapping error = errors.New("validation error: invalid role mapping")
+
+// ErrValidationTeamReference error indicates that a project refers to a
+// non-existing team.
+var ErrValidationTeamReference error = errors.New("validation error: project refers to a non-existing team")
+
+// ErrValidationTeamNameNotUnique error indicates that there are multiple teams
+// configured with the same name.
+var ErrValidationTeamNameNotUnique error = errors.New("validation error: multiple teams present with the same name")
+
+// ErrValidationProjectClassReference error indicates that a project refers to a
+// non-existing project class.
+var ErrValidationProjectClassReference error = errors.New("validation error: project refers to a non-existing project class")
+
+// ErrValidationProjectClassNameNotUnique error indicates that there are
+// multiple project classes configured with the same name.
+var ErrValidationProjectClassNameNotUnique error = errors.New("validation error: multiple project classes present with the same name")
+
+// ErrValidationSecretReference error indicates that a Secret key referred by a
+// registry, a scanner or a project webhook cannot be resolved.
+var ErrValidationSecretReference error = errors.New("validation error: referred secret cannot be resolved")
+
+// ErrValidationUnresolvableLdapGroup error indicates that the DN of an LDAP
+// group member cannot be resolved by a registry where the project is
+// provisioned.
+var ErrValidationUnresolvableLdapGroup error = errors.New("validation error: LDAP group cannot be resolved")
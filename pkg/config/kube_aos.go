@@ -29,6 +29,7 @@ import (
 	"github.com/kubermatic-labs/registryman/pkg/globalregistry"
 	"github.com/spf13/pflag"
 	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
 	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/schema"
@@ -137,22 +138,95 @@ func (aos *kubeApiObjectStore) WriteResource(ctx context.Context, obj runtime.Ob
 		logger.V(1).Info("creating a new secret",
 			"name", secret.GetName(),
 		)
-		applyConfig := applyCoreV1.Secret(secret.Name, aos.namespace).
-			WithData(secret.Data).
-			WithStringData(secret.StringData).
-			WithType(secret.Type)
-		_, err := aos.kubeClient.CoreV1().Secrets(aos.namespace).Apply(ctx,
+		// the copies of the previous version of the Secret are removed from
+		// the namespaces which are not targeted anymore
+		staleNamespaces := map[string]bool{}
+		previous, err := aos.kubeClient.CoreV1().Secrets(aos.namespace).Get(ctx, secret.GetName(), v1.GetOptions{})
+		switch {
+		case err == nil:
+			for _, ns := range secretTargetNamespaces(previous) {
+				staleNamespaces[ns] = true
+			}
+		case !errors.IsNotFound(err):
+			return fmt.Errorf("error getting secret: %w", err)
+		}
+		err = aos.applySecret(ctx, secret, aos.namespace)
+		if err != nil {
+			return err
+		}
+		for _, ns := range secretTargetNamespaces(secret) {
+			delete(staleNamespaces, ns)
+			logger.V(1).Info("copying secret",
+				"name", secret.GetName(),
+				"namespace", ns,
+			)
+			err = aos.applySecret(ctx, secretCopy(secret, ns), ns)
+			if err != nil {
+				return err
+			}
+		}
+		for ns := range staleNamespaces {
+			err = aos.deleteSecret(ctx, secret.GetName(), ns)
+			if err != nil {
+				return err
+			}
+		}
+	case schema.GroupVersionKind{
+		Group:   "",
+		Version: "v1",
+		Kind:    "ConfigMap",
+	}:
+		configMap := obj.(*corev1.ConfigMap)
+		logger.V(1).Info("applying configmap",
+			"name", configMap.GetName(),
+		)
+		applyConfig := applyCoreV1.ConfigMap(configMap.GetName(), aos.namespace).
+			WithAnnotations(configMap.GetAnnotations()).
+			WithData(configMap.Data)
+		_, err := aos.kubeClient.CoreV1().ConfigMaps(aos.namespace).Apply(ctx,
 			applyConfig,
 			v1.ApplyOptions{
 				FieldManager: fieldManager,
 			})
 		if err != nil {
-			return fmt.Errorf("error applying secret: %w", err)
+			return fmt.Errorf("error applying configmap: %w", err)
 		}
 	}
 	return nil
 }
 
+// applySecret creates or updates the Secret in the given namespace.
+func (aos *kubeApiObjectStore) applySecret(ctx context.Context, secret *corev1.Secret, namespace string) error {
+	applyConfig := applyCoreV1.Secret(secret.Name, namespace).
+		WithAnnotations(secret.GetAnnotations()).
+		WithData(secret.Data).
+		WithStringData(secret.StringData).
+		WithType(secret.Type)
+	_, err := aos.kubeClient.CoreV1().Secrets(namespace).Apply(ctx,
+		applyConfig,
+		v1.ApplyOptions{
+			FieldManager: fieldManager,
+		})
+	if err != nil {
+		return fmt.Errorf("error applying secret in namespace %s: %w", namespace, err)
+	}
+	return nil
+}
+
+// deleteSecret removes the Secret from the given namespace. It is not an error
+// if the Secret does not exist.
+func (aos *kubeApiObjectStore) deleteSecret(ctx context.Context, name, namespace string) error {
+	logger.V(1).Info("removing secret",
+		"name", name,
+		"namespace", namespace,
+	)
+	err := aos.kubeClient.CoreV1().Secrets(namespace).Delete(ctx, name, v1.DeleteOptions{})
+	if err != nil && !errors.IsNotFound(err) {
+		return fmt.Errorf("error removing secret from namespace %s: %w", namespace, err)
+	}
+	return nil
+}
+
 // RemoveResource removes the file from the filesystem. The path where
 // the file is removed from is set when the ReadManifests function
 // creates the ApiObjectStore.
@@ -176,10 +250,23 @@ func (aos *kubeApiObjectStore) RemoveResource(ctx context.Context, obj runtime.O
 		Kind:    "Secret",
 	}:
 		secret := obj.(*corev1.Secret)
+		// the copies are listed in the annotation of the stored Secret
+		stored, err := aos.kubeClient.CoreV1().Secrets(aos.namespace).Get(ctx, secret.GetName(), v1.GetOptions{})
+		switch {
+		case err == nil:
+			for _, ns := range secretTargetNamespaces(stored) {
+				err = aos.deleteSecret(ctx, secret.GetName(), ns)
+				if err != nil {
+					return err
+				}
+			}
+		case !errors.IsNotFound(err):
+			return fmt.Errorf("error getting secret: %w", err)
+		}
 		logger.V(1).Info("removing secret",
 			"name", secret.GetName(),
 		)
-		err := aos.kubeClient.CoreV1().Secrets(aos.namespace).Delete(ctx, secret.GetName(), v1.DeleteOptions{})
+		err = aos.kubeClient.CoreV1().Secrets(aos.namespace).Delete(ctx, secret.GetName(), v1.DeleteOptions{})
 		if err != nil {
 			return fmt.Errorf("error removing secret: %w", err)
 		}
@@ -203,8 +290,15 @@ func (aos *kubeApiObjectStore) GetRegistries(ctx context.Context) []*api.Registr
 	return apiRegistries
 }
 
-// GetProjects returns the parsed projects as API objects.
+// GetProjects returns the parsed projects as API objects. The defaults of the
+// project classes are applied to the projects.
 func (aos *kubeApiObjectStore) GetProjects(ctx context.Context) []*api.Project {
+	return ApplyProjectClasses(aos.GetRawProjects(ctx), aos.GetProjectClasses(ctx))
+}
+
+// GetRawProjects returns the parsed projects as API objects without the
+// defaults of the project classes applied.
+func (aos *kubeApiObjectStore) GetRawProjects(ctx context.Context) []*api.Project {
 	projectList, err := aos.regmanClient.RegistrymanV1alpha1().Projects(aos.namespace).List(ctx, v1.ListOptions{})
 	if err != nil {
 		panic(err)
@@ -229,6 +323,94 @@ func (aos *kubeApiObjectStore) GetScanners(ctx context.Context) []*api.Scanner {
 	return apiScanners
 }
 
+// GetRobotAccounts returns the parsed robot accounts as API objects.
+func (aos *kubeApiObjectStore) GetRobotAccounts(ctx context.Context) []*api.RobotAccount {
+	robotAccountList, err := aos.regmanClient.RegistrymanV1alpha1().RobotAccounts(aos.namespace).List(ctx, v1.ListOptions{})
+	if err != nil {
+		panic(err)
+	}
+	apiRobotAccounts := make([]*api.RobotAccount, len(robotAccountList.Items))
+	for i := range robotAccountList.Items {
+		apiRobotAccounts[i] = &robotAccountList.Items[i]
+	}
+	return apiRobotAccounts
+}
+
+// GetTeams returns the parsed teams as API objects.
+func (aos *kubeApiObjectStore) GetTeams(ctx context.Context) []*api.Team {
+	teamList, err := aos.regmanClient.RegistrymanV1alpha1().Teams(aos.namespace).List(ctx, v1.ListOptions{})
+	if err != nil {
+		panic(err)
+	}
+	apiTeams := make([]*api.Team, len(teamList.Items))
+	for i := range teamList.Items {
+		apiTeams[i] = &teamList.Items[i]
+	}
+	return apiTeams
+}
+
+// GetProjectClasses returns the parsed project classes as API objects.
+func (aos *kubeApiObjectStore) GetProjectClasses(ctx context.Context) []*api.ProjectClass {
+	projectClassList, err := aos.regmanClient.RegistrymanV1alpha1().ProjectClasses(aos.namespace).List(ctx, v1.ListOptions{})
+	if err != nil {
+		panic(err)
+	}
+	apiProjectClasses := make([]*api.ProjectClass, len(projectClassList.Items))
+	for i := range projectClassList.Items {
+		apiProjectClasses[i] = &projectClassList.Items[i]
+	}
+	return apiProjectClasses
+}
+
+// GetSecretValue returns the value of the Secret key selected by ref. The
+// Secret is read from the namespace of the ApiObjectStore.
+func (aos *kubeApiObjectStore) GetSecretValue(ctx context.Context, ref *api.SecretKeyRef) (string, error) {
+	secret, err := aos.kubeClient.CoreV1().Secrets(aos.namespace).Get(ctx, ref.Name, v1.GetOptions{})
+	if err != nil {
+		return "", err
+	}
+	return secretKeyValue(secret, ref.Key)
+}
+
+// GetSecret returns the Secret with the given name. The Secret is read from the
+// namespace of the ApiObjectStore. nil is returned if the Secret does not
+// exist.
+func (aos *kubeApiObjectStore) GetSecret(ctx context.Context, name string) (*corev1.Secret, error) {
+	secret, err := aos.kubeClient.CoreV1().Secrets(aos.namespace).Get(ctx, name, v1.GetOptions{})
+	if errors.IsNotFound(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return secret, nil
+}
+
+// GetSecretAnnotations returns the annotations of the Secret with the given
+// name. The Secret is read from the namespace of the ApiObjectStore. nil is
+// returned if the Secret does not exist.
+func (aos *kubeApiObjectStore) GetSecretAnnotations(ctx context.Context, name string) (map[string]string, error) {
+	secret, err := aos.GetSecret(ctx, name)
+	if err != nil || secret == nil {
+		return nil, err
+	}
+	return secret.GetAnnotations(), nil
+}
+
+// GetConfigMapData returns the data of the ConfigMap with the given name. The
+// ConfigMap is read from the namespace of the ApiObjectStore. nil is returned if
+// the ConfigMap does not exist.
+func (aos *kubeApiObjectStore) GetConfigMapData(ctx context.Context, name string) (map[string]string, error) {
+	configMap, err := aos.kubeClient.CoreV1().ConfigMaps(aos.namespace).Get(ctx, name, v1.GetOptions{})
+	if errors.IsNotFound(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return configMap.Data, nil
+}
+
 // GetGlobalRegistryOptions returns the ApiObjectStore related CLI options of an
 // apply.
 func (aos *kubeApiObjectStore) GetGlobalRegistryOptions() globalregistry.RegistryOptions {
@@ -18,8 +18,10 @@ package config
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"io"
+	"io/fs"
 	"os"
 	"path/filepath"
 	"strings"
@@ -27,6 +29,7 @@ import (
 	"github.com/go-logr/logr"
 	api "github.com/kubermatic-labs/registryman/pkg/apis/registryman/v1alpha1"
 	"github.com/kubermatic-labs/registryman/pkg/globalregistry"
+	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/schema"
@@ -35,7 +38,8 @@ import (
 )
 
 // locaFileApiObjectStore is the database of the configured resources (Projects,
-// Registries and Scanners) that are stored in the local filesystem.
+// Registries, Scanners, RobotAccounts, Teams and ProjectClasses) that are
+// stored in the local filesystem.
 type localFileApiObjectStore struct {
 	store      map[schema.GroupVersionKind][]runtime.Object
 	serializer *json.Serializer
@@ -53,7 +57,8 @@ func getFileName(obj runtime.Object) string {
 // WriteResource serializes the object specified by the obj parameter. The
 // filename is generated from the object name by appending .yaml to it. The path
 // where the file is created is set when the ReadLocalManifests function creates the
-// ApiObjectStore.
+// ApiObjectStore. The copies of a Secret in its target namespaces are written
+// into the same file as separate YAML documents.
 func (aos *localFileApiObjectStore) WriteResource(_ context.Context, obj runtime.Object) error {
 	f, err := os.Create(getFileName(obj))
 	if err != nil {
@@ -65,6 +70,20 @@ func (aos *localFileApiObjectStore) WriteResource(_ context.Context, obj runtime
 	if err != nil {
 		return err
 	}
+	secret, ok := obj.(*corev1.Secret)
+	if !ok {
+		return nil
+	}
+	for _, ns := range secretTargetNamespaces(secret) {
+		_, err = io.WriteString(f, "---\n")
+		if err != nil {
+			return err
+		}
+		err = aos.serializer.Encode(secretCopy(secret, ns), f)
+		if err != nil {
+			return err
+		}
+	}
 	return nil
 }
 
@@ -153,6 +172,12 @@ func validateObjects(o runtime.Object, gvk *schema.GroupVersionKind) error {
 		results = api.ProjectValidator.Validate(o)
 	case "Scanner":
 		results = api.ScannerValidator.Validate(o)
+	case "RobotAccount":
+		results = api.RobotAccountValidator.Validate(o)
+	case "Team":
+		results = api.TeamValidator.Validate(o)
+	case "ProjectClass":
+		results = api.ProjectClassValidator.Validate(o)
 	default:
 		// We have parsed a Kubernetes resource which is not our kind.
 		// Don't validate it.
@@ -179,8 +204,15 @@ func (aos *localFileApiObjectStore) GetRegistries(ctx context.Context) []*api.Re
 	return registries
 }
 
-// GetProjects returns the parsed projects as API objects.
-func (aos *localFileApiObjectStore) GetProjects(context.Context) []*api.Project {
+// GetProjects returns the parsed projects as API objects. The defaults of the
+// project classes are applied to the projects.
+func (aos *localFileApiObjectStore) GetProjects(ctx context.Context) []*api.Project {
+	return ApplyProjectClasses(aos.GetRawProjects(ctx), aos.GetProjectClasses(ctx))
+}
+
+// GetRawProjects returns the parsed projects as API objects without the
+// defaults of the project classes applied.
+func (aos *localFileApiObjectStore) GetRawProjects(context.Context) []*api.Project {
 	projectObjects, found := aos.store[api.SchemeGroupVersion.WithKind("Project")]
 	if !found {
 		return []*api.Project{}
@@ -205,6 +237,112 @@ func (aos *localFileApiObjectStore) GetScanners(context.Context) []*api.Scanner
 	return scanners
 }
 
+// GetRobotAccounts returns the parsed robot accounts as API objects.
+func (aos *localFileApiObjectStore) GetRobotAccounts(context.Context) []*api.RobotAccount {
+	robotAccountObjects, found := aos.store[api.SchemeGroupVersion.WithKind("RobotAccount")]
+	if !found {
+		return []*api.RobotAccount{}
+	}
+	robotAccounts := make([]*api.RobotAccount, len(robotAccountObjects))
+	for i, ra := range robotAccountObjects {
+		robotAccounts[i] = ra.(*api.RobotAccount)
+	}
+	return robotAccounts
+}
+
+// GetTeams returns the parsed teams as API objects.
+func (aos *localFileApiObjectStore) GetTeams(context.Context) []*api.Team {
+	teamObjects, found := aos.store[api.SchemeGroupVersion.WithKind("Team")]
+	if !found {
+		return []*api.Team{}
+	}
+	teams := make([]*api.Team, len(teamObjects))
+	for i, t := range teamObjects {
+		teams[i] = t.(*api.Team)
+	}
+	return teams
+}
+
+// GetProjectClasses returns the parsed project classes as API objects.
+func (aos *localFileApiObjectStore) GetProjectClasses(context.Context) []*api.ProjectClass {
+	projectClassObjects, found := aos.store[api.SchemeGroupVersion.WithKind("ProjectClass")]
+	if !found {
+		return []*api.ProjectClass{}
+	}
+	projectClasses := make([]*api.ProjectClass, len(projectClassObjects))
+	for i, pc := range projectClassObjects {
+		projectClasses[i] = pc.(*api.ProjectClass)
+	}
+	return projectClasses
+}
+
+// GetSecretValue returns the value of the Secret key selected by ref. The
+// value is looked up in the following order:
+//
+// 1. the parsed Secret manifest with the given name,
+//
+// 2. the file named after the key in the directory named after the Secret,
+// i.e. the layout of a Secret mounted as a volume,
+//
+// 3. the environment variable REGISTRYMAN_SECRET_<NAME>_<KEY>, where the name
+// and key are upper-cased and the non-alphanumeric characters are replaced
+// with underscores.
+func (aos *localFileApiObjectStore) GetSecretValue(_ context.Context, ref *api.SecretKeyRef) (string, error) {
+	for _, obj := range aos.store[corev1.SchemeGroupVersion.WithKind("Secret")] {
+		secret := obj.(*corev1.Secret)
+		if secret.GetName() == ref.Name {
+			return secretKeyValue(secret, ref.Key)
+		}
+	}
+	b, err := os.ReadFile(filepath.Join(aos.path, ref.Name, ref.Key))
+	if err == nil {
+		return strings.TrimRight(string(b), "\r\n"), nil
+	}
+	if !errors.Is(err, fs.ErrNotExist) {
+		return "", err
+	}
+	if value, found := os.LookupEnv(secretEnvName(ref)); found {
+		return value, nil
+	}
+	return "", fmt.Errorf("secret %s not found", ref.Name)
+}
+
+// GetSecret returns the parsed Secret manifest with the given name. The copies
+// of the Secret in its target namespaces are ignored. nil is returned if the
+// Secret does not exist.
+func (aos *localFileApiObjectStore) GetSecret(_ context.Context, name string) (*corev1.Secret, error) {
+	for _, obj := range aos.store[corev1.SchemeGroupVersion.WithKind("Secret")] {
+		secret := obj.(*corev1.Secret)
+		if secret.GetName() == name && secret.GetNamespace() == "" {
+			return secret, nil
+		}
+	}
+	return nil, nil
+}
+
+// GetSecretAnnotations returns the annotations of the parsed Secret manifest
+// with the given name. The copies of the Secret in its target namespaces are
+// ignored. nil is returned if the Secret does not exist.
+func (aos *localFileApiObjectStore) GetSecretAnnotations(ctx context.Context, name string) (map[string]string, error) {
+	secret, err := aos.GetSecret(ctx, name)
+	if err != nil || secret == nil {
+		return nil, err
+	}
+	return secret.GetAnnotations(), nil
+}
+
+// GetConfigMapData returns the data of the parsed ConfigMap manifest with the
+// given name. nil is returned if the ConfigMap does not exist.
+func (aos *localFileApiObjectStore) GetConfigMapData(_ context.Context, name string) (map[string]string, error) {
+	for _, obj := range aos.store[corev1.SchemeGroupVersion.WithKind("ConfigMap")] {
+		configMap := obj.(*corev1.ConfigMap)
+		if configMap.GetName() == name {
+			return configMap.Data, nil
+		}
+	}
+	return nil, nil
+}
+
 // GetGlobalRegistryOptions returns the ApiObjectStore related CLI options of an
 // apply.
 func (aos *localFileApiObjectStore) GetGlobalRegistryOptions() globalregistry.RegistryOptions {
@@ -17,10 +17,19 @@
 package config
 
 import (
+	"context"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
 	"testing"
 
+	api "github.com/kubermatic-labs/registryman/pkg/apis/registryman/v1alpha1"
+	"github.com/kubermatic-labs/registryman/pkg/globalregistry"
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
 )
 
 func TestGetFileName(t *testing.T) {
@@ -37,3 +46,204 @@ func TestGetFileName(t *testing.T) {
 	}
 
 }
+
+func TestGetSecretValue(t *testing.T) {
+	secret := &corev1.Secret{
+		Data: map[string][]byte{
+			"password": []byte("from-data"),
+		},
+		StringData: map[string]string{
+			"token": "from-string-data",
+		},
+	}
+	secret.SetName("harbor")
+	dir := t.TempDir()
+	if err := os.Mkdir(filepath.Join(dir, "mounted"), 0700); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(filepath.Join(dir, "mounted", "password"), []byte("from-file\n"), 0600); err != nil {
+		t.Fatal(err)
+	}
+	t.Setenv("REGISTRYMAN_SECRET_CI_WEBHOOK_AUTH_HEADER", "from-env")
+	aos := &localFileApiObjectStore{
+		path: dir,
+		store: map[schema.GroupVersionKind][]runtime.Object{
+			corev1.SchemeGroupVersion.WithKind("Secret"): {secret},
+		},
+	}
+	testCases := []struct {
+		ref   api.SecretKeyRef
+		value string
+	}{
+		{api.SecretKeyRef{Name: "harbor", Key: "password"}, "from-data"},
+		{api.SecretKeyRef{Name: "harbor", Key: "token"}, "from-string-data"},
+		{api.SecretKeyRef{Name: "mounted", Key: "password"}, "from-file"},
+		{api.SecretKeyRef{Name: "ci-webhook", Key: "auth-header"}, "from-env"},
+	}
+	for _, tc := range testCases {
+		value, err := aos.GetSecretValue(context.Background(), &tc.ref)
+		if err != nil {
+			t.Errorf("unexpected error for %s/%s: %v", tc.ref.Name, tc.ref.Key, err)
+			continue
+		}
+		if value != tc.value {
+			t.Errorf("unexpected value for %s/%s: %s", tc.ref.Name, tc.ref.Key, value)
+		}
+	}
+	missingRefs := []api.SecretKeyRef{
+		{Name: "harbor", Key: "missing"},
+		{Name: "missing", Key: "password"},
+	}
+	for _, ref := range missingRefs {
+		if _, err := aos.GetSecretValue(context.Background(), &ref); err == nil {
+			t.Errorf("missing secret %s/%s did not cause an error", ref.Name, ref.Key)
+		}
+	}
+}
+
+func TestGetSecretAnnotations(t *testing.T) {
+	secret := &corev1.Secret{}
+	secret.SetName("creds")
+	secret.SetAnnotations(map[string]string{
+		globalregistry.TargetNamespacesAnnotation: "app",
+	})
+	aos := &localFileApiObjectStore{
+		store: map[schema.GroupVersionKind][]runtime.Object{
+			corev1.SchemeGroupVersion.WithKind("Secret"): {
+				secretCopy(secret, "app"),
+				secret,
+			},
+		},
+	}
+	annotations, err := aos.GetSecretAnnotations(context.Background(), "creds")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(annotations, secret.GetAnnotations()) {
+		t.Errorf("unexpected annotations: %v", annotations)
+	}
+	annotations, err = aos.GetSecretAnnotations(context.Background(), "missing")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if annotations != nil {
+		t.Errorf("unexpected annotations of missing secret: %v", annotations)
+	}
+}
+
+func TestGetConfigMapData(t *testing.T) {
+	configDir := t.TempDir()
+	if err := os.WriteFile(filepath.Join(configDir, "README"), nil, 0600); err != nil {
+		t.Fatal(err)
+	}
+	wd, err := os.Getwd()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err = os.Chdir(configDir); err != nil {
+		t.Fatal(err)
+	}
+	defer os.Chdir(wd)
+
+	aos, err := ReadLocalManifests(configDir, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	configMap := &corev1.ConfigMap{
+		TypeMeta: metav1.TypeMeta{
+			Kind:       "ConfigMap",
+			APIVersion: "v1",
+		},
+		Data: map[string]string{
+			"key": "value",
+		},
+	}
+	configMap.SetName("config")
+	if err = aos.WriteResource(context.Background(), configMap); err != nil {
+		t.Fatal(err)
+	}
+
+	aos, err = ReadLocalManifests(configDir, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	data, err := aos.GetConfigMapData(context.Background(), "config")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(data, configMap.Data) {
+		t.Errorf("unexpected data: %v", data)
+	}
+	data, err = aos.GetConfigMapData(context.Background(), "missing")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if data != nil {
+		t.Errorf("unexpected data of missing configmap: %v", data)
+	}
+}
+
+func TestWriteSecretWithTargetNamespaces(t *testing.T) {
+	configDir := t.TempDir()
+	if err := os.WriteFile(filepath.Join(configDir, "README"), nil, 0600); err != nil {
+		t.Fatal(err)
+	}
+	aos, err := ReadLocalManifests(configDir, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	wd, err := os.Getwd()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err = os.Chdir(t.TempDir()); err != nil {
+		t.Fatal(err)
+	}
+	defer os.Chdir(wd)
+
+	secret := &corev1.Secret{
+		TypeMeta: metav1.TypeMeta{
+			Kind:       "Secret",
+			APIVersion: "v1",
+		},
+		StringData: map[string]string{
+			corev1.DockerConfigJsonKey: "{}",
+		},
+		Type: corev1.SecretTypeDockerConfigJson,
+	}
+	secret.SetName("creds")
+	secret.SetAnnotations(map[string]string{
+		"globalregistry.org/registry-name":        "harbor",
+		globalregistry.TargetNamespacesAnnotation: "app, ci",
+	})
+	if namespaces := secretTargetNamespaces(secret); !reflect.DeepEqual(namespaces, []string{"app", "ci"}) {
+		t.Errorf("unexpected target namespaces: %v", namespaces)
+	}
+	if err = aos.WriteResource(context.Background(), secret); err != nil {
+		t.Fatal(err)
+	}
+	content, err := os.ReadFile("creds.yaml")
+	if err != nil {
+		t.Fatal(err)
+	}
+	documents := strings.Split(string(content), "---\n")
+	if len(documents) != 3 {
+		t.Fatalf("unexpected number of YAML documents: %d", len(documents))
+	}
+	for i, ns := range []string{"app", "ci"} {
+		copied := &corev1.Secret{}
+		_, _, err = aos.serializer.Decode([]byte(documents[i+1]), nil, copied)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if copied.GetNamespace() != ns {
+			t.Errorf("unexpected namespace of copy %d: %s", i, copied.GetNamespace())
+		}
+		if _, found := copied.GetAnnotations()[globalregistry.TargetNamespacesAnnotation]; found {
+			t.Errorf("copy %d lists target namespaces", i)
+		}
+		if copied.GetAnnotations()["globalregistry.org/registry-name"] != "harbor" {
+			t.Errorf("copy %d lost its annotations", i)
+		}
+	}
+}
@@ -68,20 +68,28 @@ func GetProjectByName(ctx context.Context, aos ApiObjectStore, projectName strin
 		if project.GetName() == projectName {
 			switch project.Spec.Type {
 			case api.GlobalProjectType:
+				hub := registry.HubOfProject(project, registries)
+				if hub != nil {
+					return newProject(ctx, aos, hub, project)
+				}
+				if project.Spec.Hub != "" || registry.HasGlobalHub(registries) {
+					return nil, fmt.Errorf("hub of global project %s cannot be determined", projectName)
+				}
+				// Without GlobalHub registry the project is looked up in
+				// the first local registry.
 				for _, reg := range registries {
-					if reg.Spec.Role == "GlobalHub" {
+					if registry.IsProjectProvisioned(project, reg, registries) {
 						return newProject(ctx, aos, reg, project)
 					}
 				}
+				return nil, fmt.Errorf("global project %s is not provisioned in any registry", projectName)
 			case api.LocalProjectType:
-				if len(project.Spec.LocalRegistries) == 0 {
+				localRegistries := registry.LocalRegistriesOfProject(project, registries)
+				if len(localRegistries) == 0 {
 					return nil, fmt.Errorf("local project with no local registries")
 				}
-				localRegistryName := project.Spec.LocalRegistries[0]
-				for _, reg := range registries {
-					if reg.Spec.Role == "Local" && reg.GetName() == localRegistryName {
-						return newProject(ctx, aos, reg, project)
-					}
+				if localRegistries[0].Spec.Role == "Local" {
+					return newProject(ctx, aos, localRegistries[0], project)
 				}
 			default:
 				panic(fmt.Sprintf("unhandled project type: %s", project.Spec.Type.String()))
@@ -0,0 +1,126 @@
+/*
+   Copyright 2021 The Kubermatic Kubernetes Platform contributors.
+
+   Licensed under the Apache License, Version 2.0 (the "License");
+   you may not use this file except in compliance with the License.
+   You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+   Unless required by applicable law or agreed to in writing, software
+   distributed under the License is distributed on an "AS IS" BASIS,
+   WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+   See the License for the specific language governing permissions and
+   limitations under the License.
+*/
+
+package config
+
+import (
+	api "github.com/kubermatic-labs/registryman/pkg/apis/registryman/v1alpha1"
+)
+
+// projectClassLabel is the label of the projects which selects the project
+// class when the projectClass field of the project is not set.
+const projectClassLabel = "registryman.kubermatic.com/projectClass"
+
+// projectClassOf returns the name of the project class selected by the
+// project. The projectClass field takes precedence over the label.
+func projectClassOf(project *api.Project) string {
+	if project.Spec.ProjectClass != "" {
+		return project.Spec.ProjectClass
+	}
+	return project.GetLabels()[projectClassLabel]
+}
+
+// ApplyProjectClasses returns the projects with the defaults of their project
+// classes applied. The projects without type get the type of their project
+// class, or the Global type if the class does not set it either. The modified
+// projects are deep copied, so the original objects are not modified. The
+// other projects are returned as they are.
+func ApplyProjectClasses(projects []*api.Project, projectClasses []*api.ProjectClass) []*api.Project {
+	projectClassesByName := make(map[string]*api.ProjectClass)
+	for _, pc := range projectClasses {
+		projectClassesByName[pc.GetName()] = pc
+	}
+	result := make([]*api.Project, len(projects))
+	for i, project := range projects {
+		className := projectClassOf(project)
+		pc, found := projectClassesByName[className]
+		if className != "" && found && pc.Spec != nil {
+			project = applyProjectClass(project, pc)
+		}
+		if project.Spec.Type == api.UndefinedProjectType {
+			if project == projects[i] {
+				project = project.DeepCopy()
+			}
+			project.Spec.Type = api.GlobalProjectType
+		}
+		result[i] = project
+	}
+	return result
+}
+
+// applyProjectClass returns a copy of the project with the defaults of the
+// project class applied. The fields set in the project are kept. The default
+// members are added to the members of the project unless a member of the same
+// type and name is listed in the project. The default teams are added to the
+// teams of the project.
+func applyProjectClass(project *api.Project, pc *api.ProjectClass) *api.Project {
+	project = project.DeepCopy()
+	defaults := pc.Spec.DeepCopy()
+	spec := project.Spec
+
+	type memberKey struct {
+		memberType api.MemberType
+		name       string
+	}
+	members := make(map[memberKey]bool)
+	for _, member := range spec.Members {
+		members[memberKey{member.Type, member.Name}] = true
+	}
+	for _, member := range defaults.Members {
+		if !members[memberKey{member.Type, member.Name}] {
+			spec.Members = append(spec.Members, member)
+		}
+	}
+
+	teams := make(map[string]bool)
+	for _, team := range spec.Teams {
+		teams[team] = true
+	}
+	for _, team := range defaults.Teams {
+		if !teams[team] {
+			spec.Teams = append(spec.Teams, team)
+		}
+	}
+
+	if spec.Type == api.UndefinedProjectType {
+		spec.Type = defaults.Type
+	}
+	if spec.Scanner == "" {
+		spec.Scanner = defaults.Scanner
+	}
+	if spec.Trigger.Type == api.UndefinedRepliationTriggerType {
+		spec.Trigger = defaults.Trigger
+	}
+	if spec.Replication == nil {
+		spec.Replication = defaults.Replication
+	}
+	if spec.StorageQuota == nil {
+		spec.StorageQuota = defaults.StorageQuota
+	}
+	if spec.Settings == nil {
+		spec.Settings = defaults.Settings
+	}
+	if spec.Retention == nil {
+		spec.Retention = defaults.Retention
+	}
+	if spec.ImmutableTags == nil {
+		spec.ImmutableTags = defaults.ImmutableTags
+	}
+	if spec.Webhooks == nil {
+		spec.Webhooks = defaults.Webhooks
+	}
+	return project
+}
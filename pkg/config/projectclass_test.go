@@ -0,0 +1,114 @@
+/*
+   Copyright 2021 The Kubermatic Kubernetes Platform contributors.
+
+   Licensed under the Apache License, Version 2.0 (the "License");
+   you may not use this file except in compliance with the License.
+   You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+   Unless required by applicable law or agreed to in writing, software
+   distributed under the License is distributed on an "AS IS" BASIS,
+   WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+   See the License for the specific language governing permissions and
+   limitations under the License.
+*/
+
+package config
+
+import (
+	"reflect"
+	"testing"
+
+	api "github.com/kubermatic-labs/registryman/pkg/apis/registryman/v1alpha1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestApplyProjectClasses(t *testing.T) {
+	quota := resource.MustParse("1Gi")
+	projectClass := &api.ProjectClass{
+		ObjectMeta: metav1.ObjectMeta{Name: "standard"},
+		Spec: &api.ProjectClassSpec{
+			Type: api.LocalProjectType,
+			Members: []*api.ProjectMember{
+				{Name: "alpha", Type: api.UserMemberType, Role: api.DeveloperRole},
+				{Name: "beta", Type: api.UserMemberType, Role: api.GuestRole},
+			},
+			Teams:        []string{"developers", "operators"},
+			Scanner:      "default-scanner",
+			StorageQuota: &quota,
+		},
+	}
+	project := &api.Project{
+		ObjectMeta: metav1.ObjectMeta{Name: "project"},
+		Spec: &api.ProjectSpec{
+			ProjectClass: "standard",
+			Members: []*api.ProjectMember{
+				{Name: "alpha", Type: api.UserMemberType, Role: api.MaintainerRole},
+			},
+			Teams:   []string{"operators"},
+			Scanner: "project-scanner",
+		},
+	}
+	labelled := &api.Project{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:   "labelled",
+			Labels: map[string]string{projectClassLabel: "standard"},
+		},
+		Spec: &api.ProjectSpec{
+			Type: api.GlobalProjectType,
+		},
+	}
+	unclassified := &api.Project{
+		ObjectMeta: metav1.ObjectMeta{Name: "unclassified"},
+		Spec: &api.ProjectSpec{
+			Type: api.LocalProjectType,
+		},
+	}
+	untyped := &api.Project{
+		ObjectMeta: metav1.ObjectMeta{Name: "untyped"},
+		Spec:       &api.ProjectSpec{},
+	}
+
+	projects := ApplyProjectClasses(
+		[]*api.Project{project, labelled, unclassified, untyped},
+		[]*api.ProjectClass{projectClass},
+	)
+	if len(projects) != 4 {
+		t.Fatalf("unexpected number of projects: %d", len(projects))
+	}
+	spec := projects[0].Spec
+	if spec.Type != api.LocalProjectType {
+		t.Errorf("type of the project class is not applied: %s", spec.Type)
+	}
+	expectedMembers := []*api.ProjectMember{
+		{Name: "alpha", Type: api.UserMemberType, Role: api.MaintainerRole},
+		{Name: "beta", Type: api.UserMemberType, Role: api.GuestRole},
+	}
+	if !reflect.DeepEqual(spec.Members, expectedMembers) {
+		t.Errorf("unexpected members: %+v", spec.Members)
+	}
+	if expectedTeams := []string{"operators", "developers"}; !reflect.DeepEqual(spec.Teams, expectedTeams) {
+		t.Errorf("unexpected teams: %v", spec.Teams)
+	}
+	if spec.Scanner != "project-scanner" {
+		t.Errorf("scanner of the project is overwritten: %s", spec.Scanner)
+	}
+	if spec.StorageQuota == nil || !spec.StorageQuota.Equal(quota) {
+		t.Errorf("storage quota of the project class is not applied: %v", spec.StorageQuota)
+	}
+	if len(project.Spec.Members) != 1 || project.Spec.StorageQuota != nil ||
+		project.Spec.Type != api.UndefinedProjectType {
+		t.Errorf("original project is modified: %+v", project.Spec)
+	}
+	if spec := projects[1].Spec; spec.Scanner != "default-scanner" || spec.Type != api.GlobalProjectType {
+		t.Errorf("project class selected by label is not applied: %+v", spec)
+	}
+	if projects[2] != unclassified {
+		t.Errorf("project without project class is not returned as it is")
+	}
+	if projects[3].Spec.Type != api.GlobalProjectType || untyped.Spec.Type != api.UndefinedProjectType {
+		t.Errorf("type of the project without type is not defaulted to Global in a copy")
+	}
+}
@@ -0,0 +1,88 @@
+/*
+   Copyright 2021 The Kubermatic Kubernetes Platform contributors.
+
+   Licensed under the Apache License, Version 2.0 (the "License");
+   you may not use this file except in compliance with the License.
+   You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+   Unless required by applicable law or agreed to in writing, software
+   distributed under the License is distributed on an "AS IS" BASIS,
+   WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+   See the License for the specific language governing permissions and
+   limitations under the License.
+*/
+
+package registry
+
+import (
+	"strconv"
+
+	api "github.com/kubermatic-labs/registryman/pkg/apis/registryman/v1alpha1"
+)
+
+// defaultHubAnnotation marks the GlobalHub registry which is used by the
+// global projects that do not select their hub explicitly.
+const defaultHubAnnotation = "registryman.kubermatic.com/defaultHub"
+
+// IsGlobalHub returns true if the registry has the GlobalHub role.
+func IsGlobalHub(reg *api.Registry) bool {
+	return reg.Spec.Role == "GlobalHub"
+}
+
+// IsDefaultHub returns true if the registry is a GlobalHub registry annotated
+// as the default hub.
+func IsDefaultHub(reg *api.Registry) bool {
+	if !IsGlobalHub(reg) {
+		return false
+	}
+	isDefault, err := strconv.ParseBool(reg.Annotations[defaultHubAnnotation])
+	return err == nil && isDefault
+}
+
+// HasGlobalHub returns true if any of the registries has the GlobalHub role.
+// Without GlobalHub registry the global projects are provisioned in the local
+// registries only and they are not replicated.
+func HasGlobalHub(registries []*api.Registry) bool {
+	for _, reg := range registries {
+		if IsGlobalHub(reg) {
+			return true
+		}
+	}
+	return false
+}
+
+// HubOfProject returns the GlobalHub registry of a global project. If the
+// project does not name its hub, the default hub is returned: the only
+// GlobalHub registry or the one annotated as the default hub. nil is returned
+// for local projects, when there is no GlobalHub registry and when the hub
+// cannot be determined.
+func HubOfProject(proj *api.Project, registries []*api.Registry) *api.Registry {
+	if proj.Spec.Type != api.GlobalProjectType {
+		return nil
+	}
+	var hubs, defaultHubs []*api.Registry
+	for _, reg := range registries {
+		if !IsGlobalHub(reg) {
+			continue
+		}
+		if proj.Spec.Hub != "" && reg.GetName() == proj.Spec.Hub {
+			return reg
+		}
+		hubs = append(hubs, reg)
+		if IsDefaultHub(reg) {
+			defaultHubs = append(defaultHubs, reg)
+		}
+	}
+	switch {
+	case proj.Spec.Hub != "":
+		return nil
+	case len(defaultHubs) == 1:
+		return defaultHubs[0]
+	case len(hubs) == 1 && len(defaultHubs) == 0:
+		return hubs[0]
+	default:
+		return nil
+	}
+}
@@ -0,0 +1,123 @@
+/*
+   Copyright 2021 The Kubermatic Kubernetes Platform contributors.
+
+   Licensed under the Apache License, Version 2.0 (the "License");
+   you may not use this file except in compliance with the License.
+   You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+   Unless required by applicable law or agreed to in writing, software
+   distributed under the License is distributed on an "AS IS" BASIS,
+   WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+   See the License for the specific language governing permissions and
+   limitations under the License.
+*/
+
+package registry
+
+import (
+	"context"
+	"testing"
+
+	api "github.com/kubermatic-labs/registryman/pkg/apis/registryman/v1alpha1"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func testRegistry(name, role string, annotations map[string]string) *api.Registry {
+	return &api.Registry{
+		ObjectMeta: v1.ObjectMeta{
+			Name:        name,
+			Annotations: annotations,
+		},
+		Spec: &api.RegistrySpec{
+			Role: role,
+		},
+	}
+}
+
+func testProject(name string, projectType api.ProjectType, hub string) *api.Project {
+	return &api.Project{
+		ObjectMeta: v1.ObjectMeta{
+			Name: name,
+		},
+		Spec: &api.ProjectSpec{
+			Type: projectType,
+			Hub:  hub,
+		},
+	}
+}
+
+func TestHubOfProject(t *testing.T) {
+	europe := testRegistry("europe", "GlobalHub", nil)
+	america := testRegistry("america", "GlobalHub", map[string]string{
+		defaultHubAnnotation: "true",
+	})
+	local := testRegistry("local", "Local", nil)
+
+	hubTest := []struct {
+		id         string
+		project    *api.Project
+		registries []*api.Registry
+		expHub     *api.Registry
+	}{
+		{id: "single hub", project: testProject("p", api.GlobalProjectType, ""), registries: []*api.Registry{europe, local}, expHub: europe},
+		{id: "no hub", project: testProject("p", api.GlobalProjectType, ""), registries: []*api.Registry{local}, expHub: nil},
+		{id: "selected hub", project: testProject("p", api.GlobalProjectType, "europe"), registries: []*api.Registry{europe, america, local}, expHub: europe},
+		{id: "default hub", project: testProject("p", api.GlobalProjectType, ""), registries: []*api.Registry{europe, america, local}, expHub: america},
+		{id: "invalid hub", project: testProject("p", api.GlobalProjectType, "local"), registries: []*api.Registry{europe, america, local}, expHub: nil},
+		{id: "no default hub", project: testProject("p", api.GlobalProjectType, ""), registries: []*api.Registry{europe, testRegistry("asia", "GlobalHub", nil)}, expHub: nil},
+		{id: "local project", project: testProject("p", api.LocalProjectType, ""), registries: []*api.Registry{europe, local}, expHub: nil},
+	}
+
+	for _, tt := range hubTest {
+		t.Run(tt.id, func(t *testing.T) {
+			if got := HubOfProject(tt.project, tt.registries); got != tt.expHub {
+				t.Errorf("TC-%v got %v want %v", tt.id, got, tt.expHub)
+			}
+		})
+	}
+}
+
+func TestListProjectsOfHubs(t *testing.T) {
+	europe := testRegistry("europe", "GlobalHub", nil)
+	america := testRegistry("america", "GlobalHub", nil)
+	local := testRegistry("local", "Local", nil)
+	ap := &mockApiProvider{
+		registries: []*api.Registry{europe, america, local},
+		projects: []*api.Project{
+			testProject("eu-apps", api.GlobalProjectType, "europe"),
+			testProject("us-apps", api.GlobalProjectType, "america"),
+		},
+	}
+
+	listTest := []struct {
+		registry *api.Registry
+		expNames []string
+	}{
+		{registry: europe, expNames: []string{"eu-apps"}},
+		{registry: america, expNames: []string{"us-apps"}},
+		{registry: local, expNames: []string{"eu-apps", "us-apps"}},
+	}
+
+	for _, tt := range listTest {
+		t.Run(tt.registry.GetName(), func(t *testing.T) {
+			projects, err := New(tt.registry, ap).ListProjects(context.Background())
+			if err != nil {
+				t.Fatalf("%v", err)
+			}
+			names := make([]string, len(projects))
+			for i, proj := range projects {
+				names[i] = proj.GetName()
+			}
+			if len(names) != len(tt.expNames) {
+				t.Fatalf("got %v want %v", names, tt.expNames)
+			}
+			for i := range names {
+				if names[i] != tt.expNames[i] {
+					t.Errorf("got %v want %v", names, tt.expNames)
+				}
+			}
+		})
+	}
+}
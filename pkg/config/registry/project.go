@@ -33,55 +33,91 @@ var _ globalregistry.Project = &project{}
 var _ globalregistry.ProjectWithMembers = &project{}
 var _ globalregistry.ProjectWithReplication = &project{}
 var _ globalregistry.ProjectWithScanner = &project{}
+var _ globalregistry.ProjectWithStorageQuota = &project{}
+var _ globalregistry.ProjectWithSettings = &project{}
+var _ globalregistry.ProjectWithRetention = &project{}
+var _ globalregistry.ProjectWithImmutableTags = &project{}
+var _ globalregistry.ProjectWithWebhooks = &project{}
 
-func (proj *project) GetMembers(context.Context) ([]globalregistry.ProjectMember, error) {
-	members := make([]globalregistry.ProjectMember, len(proj.Spec.Members))
-	for i, member := range proj.Spec.Members {
+// GetMembers implements the globalregistry.ProjectWithMembers interface. The
+// members of the teams referred by the project are returned, too.
+func (proj *project) GetMembers(ctx context.Context) ([]globalregistry.ProjectMember, error) {
+	apiMembers := proj.members(ctx)
+	members := make([]globalregistry.ProjectMember, len(apiMembers))
+	for i, member := range apiMembers {
 		pMember := &projectMember{
 			ProjectMember: member,
 		}
-		if member.DN != "" {
-			fmt.Println("GROUP member")
+		switch {
+		case member.Type != api.GroupMemberType:
+			members[i] = pMember
+		case groupTypeOf(member) == api.LdapGroupType:
 			members[i] = &ldapGroupMember{
-				pMember,
+				&groupMember{pMember},
 			}
-		} else {
-			members[i] = pMember
+		default:
+			members[i] = &groupMember{pMember}
 		}
 	}
 	return members, nil
 }
 
+// members returns the inline members of the project and the members of the
+// referred teams.
+func (proj *project) members(ctx context.Context) []*api.ProjectMember {
+	return MembersOfProject(proj.Project, proj.registry.apiProvider.GetTeams(ctx))
+}
+
+// GetReplicationRules returns the replication rules that the registry of the
+// project shall implement. The rules are derived from the edges of the
+// replication topology of the project which touch the registry.
 func (proj *project) GetReplicationRules(ctx context.Context, trigger globalregistry.ReplicationTrigger, direction string) ([]globalregistry.ReplicationRule, error) {
 	rules := []globalregistry.ReplicationRule{}
 	switch proj.Spec.Type {
-	case api.GlobalProjectType:
-		for _, r := range proj.registry.apiProvider.GetRegistries(ctx) {
+	case api.GlobalProjectType, api.LocalProjectType:
+	default:
+		return nil, fmt.Errorf("invalid registry type: %s", proj.Spec.Type.String())
+	}
+	registries := proj.registry.apiProvider.GetRegistries(ctx)
+	edges, err := replicationTopology(proj.Project, registries)
+	if err != nil {
+		return nil, err
+	}
+	for _, edge := range edges {
+		var remoteName string
+		switch proj.registry.GetName() {
+		case edge.source:
+			remoteName = edge.destination
+		case edge.destination:
+			remoteName = edge.source
+		default:
+			continue
+		}
+		for _, r := range registries {
+			if r.GetName() != remoteName {
+				continue
+			}
 			remoteReg := New(r, proj.registry.apiProvider)
-			if proj.registry.GetName() != r.GetName() {
-				calcRepl := calculateReplicationRule(
-					proj.registry.registryCapabilities(),
-					remoteReg.registryCapabilities(),
-				)
-				if calcRepl != noReplication {
-					repRule := &replicationRule{
-						calculatedReplication: calcRepl,
-						project:               proj,
-						remote:                remoteReg,
-					}
-					if trigger != nil && trigger != repRule.Trigger() {
-						continue
-					}
-					if direction != "" && direction != repRule.Direction() {
-						continue
-					}
-					rules = append(rules, repRule)
-				}
+			calcRepl := calculateEdgeReplication(
+				proj.registry.registryCapabilities(edge),
+				remoteReg.registryCapabilities(edge),
+			)
+			if calcRepl == noReplication {
+				continue
+			}
+			repRule := &replicationRule{
+				calculatedReplication: calcRepl,
+				project:               proj,
+				remote:                remoteReg,
+			}
+			if trigger != nil && trigger != repRule.Trigger() {
+				continue
 			}
+			if direction != "" && direction != repRule.Direction() {
+				continue
+			}
+			rules = append(rules, repRule)
 		}
-	case api.LocalProjectType:
-	default:
-		return nil, fmt.Errorf("invalid registry type: %s", proj.Spec.Type.String())
 	}
 	return rules, nil
 }
@@ -93,8 +129,62 @@ func (p *project) GetScanner(ctx context.Context) (globalregistry.Scanner, error
 	scanners := p.registry.apiProvider.GetScanners(ctx)
 	for _, s := range scanners {
 		if s.GetName() == p.Spec.Scanner {
-			return &scanner{s}, nil
+			return newScanner(ctx, p.registry.apiProvider, s)
 		}
 	}
 	return nil, fmt.Errorf("project %s has invalid scanner configuration (%s)", p.GetName(), p.Spec.Scanner)
 }
+
+// GetStorageQuota implements the globalregistry.ProjectWithStorageQuota
+// interface. If no storage quota is configured, -1 is returned.
+func (p *project) GetStorageQuota(context.Context) (int, error) {
+	if p.Spec.StorageQuota == nil {
+		return -1, nil
+	}
+	return int(p.Spec.StorageQuota.Value()), nil
+}
+
+// GetSettings implements the globalregistry.ProjectWithSettings interface.
+func (p *project) GetSettings(context.Context) (*api.ProjectSettings, error) {
+	return p.Spec.Settings, nil
+}
+
+// GetRetentionPolicy implements the globalregistry.ProjectWithRetention
+// interface.
+func (p *project) GetRetentionPolicy(context.Context) (*api.RetentionPolicy, error) {
+	return p.Spec.Retention, nil
+}
+
+// GetImmutableTagPolicy implements the globalregistry.ProjectWithImmutableTags
+// interface.
+func (p *project) GetImmutableTagPolicy(context.Context) (*api.ImmutableTagPolicy, error) {
+	return p.Spec.ImmutableTags, nil
+}
+
+// GetWebhooks implements the globalregistry.ProjectWithWebhooks interface. The
+// authorization headers are resolved from the referred Secrets.
+func (p *project) GetWebhooks(ctx context.Context) ([]api.WebhookStatus, error) {
+	if p.Spec.Webhooks == nil {
+		return nil, nil
+	}
+	webhooks := make([]api.WebhookStatus, len(p.Spec.Webhooks))
+	for i, target := range p.Spec.Webhooks {
+		webhooks[i] = api.WebhookStatus{
+			Name:           target.Name,
+			Endpoint:       target.Endpoint,
+			EventTypes:     target.EventTypes,
+			SkipCertVerify: target.SkipCertVerify,
+			Enabled:        true,
+		}
+		if target.AuthHeaderSecretRef != nil {
+			authHeader, err := p.registry.apiProvider.GetSecretValue(ctx, target.AuthHeaderSecretRef)
+			if err != nil {
+				return nil, fmt.Errorf("cannot resolve the auth header of webhook %s in project %s: %w",
+					target.Name, p.GetName(), err)
+			}
+			webhooks[i].AuthHeader = authHeader
+			webhooks[i].AuthHeaderDigest = api.AuthHeaderDigest(authHeader)
+		}
+	}
+	return webhooks, nil
+}
@@ -19,7 +19,6 @@ package registry
 import (
 	"context"
 
-	api "github.com/kubermatic-labs/registryman/pkg/apis/registryman/v1alpha1"
 	"github.com/kubermatic-labs/registryman/pkg/globalregistry"
 )
 
@@ -44,20 +43,15 @@ func (r *Registry) GetProjectByName(ctx context.Context, name string) (globalreg
 	return nil, nil
 }
 
+// ListProjects returns the projects provisioned in the registry. The global
+// projects are provisioned in their hub and in all local registries, but not in
+// the other GlobalHub registries.
 func (r *Registry) ListProjects(ctx context.Context) ([]globalregistry.Project, error) {
 	projects := r.apiProvider.GetProjects(ctx)
+	registries := r.apiProvider.GetRegistries(ctx)
 	result := make([]globalregistry.Project, 0)
 	for _, proj := range projects {
-		myProject := false
-	LRegLoop:
-		for _, lReg := range proj.Spec.LocalRegistries {
-			if lReg == r.GetName() {
-				myProject = true
-				break LRegLoop
-			}
-		}
-		if proj.Spec.Type == api.GlobalProjectType ||
-			myProject {
+		if IsProjectProvisioned(proj, r.apiRegistry, registries) {
 			result = append(result, &project{
 				Project:  proj,
 				registry: r,
@@ -39,10 +39,54 @@ func (member *projectMember) GetRole() string {
 	return member.ProjectMember.Role.String()
 }
 
-type ldapGroupMember struct {
+var _ globalregistry.MemberWithRotationPolicy = &projectMember{}
+
+// GetRotationPolicy returns the credential rotation policy of the Robot
+// members. nil is returned for the other member types.
+func (member *projectMember) GetRotationPolicy() *api.CredentialRotation {
+	if member.ProjectMember.Type != api.RobotMemberType {
+		return nil
+	}
+	return member.Rotation
+}
+
+var _ globalregistry.MemberWithTargetNamespaces = &projectMember{}
+
+// GetTargetNamespaces returns the namespaces where the pull secret of the Robot
+// members is copied to. nil is returned for the other member types.
+func (member *projectMember) GetTargetNamespaces() []string {
+	if member.ProjectMember.Type != api.RobotMemberType {
+		return nil
+	}
+	return member.TargetNamespaces
+}
+
+// groupMember is a project member of type Group.
+type groupMember struct {
 	*projectMember
 }
 
+var _ globalregistry.GroupMember = &groupMember{}
+
+// GetGroupType returns the type of the group. If it is not set explicitly, the
+// group is an LDAP group.
+func (member *groupMember) GetGroupType() string {
+	return string(groupTypeOf(member.ProjectMember))
+}
+
+// groupTypeOf returns the group type of a Group member. LDAP is returned, if
+// the group type is not set.
+func groupTypeOf(member *api.ProjectMember) api.GroupType {
+	if member.GroupType == "" {
+		return api.LdapGroupType
+	}
+	return member.GroupType
+}
+
+type ldapGroupMember struct {
+	*groupMember
+}
+
 var _ globalregistry.LdapMember = &ldapGroupMember{}
 
 func (member *ldapGroupMember) GetDN() string {
@@ -20,7 +20,9 @@ package registry
 
 import (
 	"context"
+	"fmt"
 	"strconv"
+	"sync"
 
 	"github.com/go-logr/logr"
 	api "github.com/kubermatic-labs/registryman/pkg/apis/registryman/v1alpha1"
@@ -33,6 +35,11 @@ type ApiObjectProvider interface {
 	GetProjects(context.Context) []*api.Project
 	GetRegistries(context.Context) []*api.Registry
 	GetScanners(context.Context) []*api.Scanner
+	GetRobotAccounts(context.Context) []*api.RobotAccount
+	GetTeams(context.Context) []*api.Team
+	GetSecretValue(ctx context.Context, ref *api.SecretKeyRef) (string, error)
+	GetSecretAnnotations(ctx context.Context, name string) (map[string]string, error)
+	GetConfigMapData(ctx context.Context, name string) (map[string]string, error)
 	GetGlobalRegistryOptions() globalregistry.RegistryOptions
 	GetLogger() logr.Logger
 }
@@ -42,9 +49,16 @@ type ApiObjectProvider interface {
 type Registry struct {
 	apiProvider ApiObjectProvider
 	apiRegistry *api.Registry
+
+	// secretValues caches the values resolved from the referred Secrets.
+	// The failed lookups are not cached, they are retried on next use.
+	secretValuesMutex sync.Mutex
+	secretValues      map[api.SecretKeyRef]string
 }
 
 var _ globalregistry.Registry = &Registry{}
+var _ globalregistry.RegistryWithToken = &Registry{}
+var _ globalregistry.RegistryWithRoleMapping = &Registry{}
 
 // New function creates a new Registry value from the API representation of the
 // registry.
@@ -75,11 +89,79 @@ func (reg *Registry) GetUsername() string {
 	return reg.apiRegistry.Spec.Username
 }
 
-// GetPassword method implements the globalregistry.RegistryConfig interface.
+// resolveSecretRef returns the value of the Secret key selected by ref. The
+// resolved values are cached, the failed lookups are not.
+func (reg *Registry) resolveSecretRef(ctx context.Context, ref *api.SecretKeyRef) (string, error) {
+	reg.secretValuesMutex.Lock()
+	defer reg.secretValuesMutex.Unlock()
+	if value, found := reg.secretValues[*ref]; found {
+		return value, nil
+	}
+	value, err := reg.apiProvider.GetSecretValue(ctx, ref)
+	if err != nil {
+		return "", fmt.Errorf("cannot resolve key %s of secret %s for registry %s: %w",
+			ref.Key, ref.Name, reg.apiRegistry.GetName(), err)
+	}
+	if reg.secretValues == nil {
+		reg.secretValues = make(map[api.SecretKeyRef]string)
+	}
+	reg.secretValues[*ref] = value
+	return value, nil
+}
+
+// ResolveCredentials resolves the password and the token of the registry from
+// the referred Secrets. An error is returned if a referred Secret key cannot be
+// resolved.
+func (reg *Registry) ResolveCredentials(ctx context.Context) error {
+	for _, ref := range []*api.SecretKeyRef{
+		reg.apiRegistry.Spec.PasswordSecretRef,
+		reg.apiRegistry.Spec.TokenSecretRef,
+	} {
+		if ref == nil {
+			continue
+		}
+		if _, err := reg.resolveSecretRef(ctx, ref); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// credential returns the value of the Secret key selected by ref. The
+// globalregistry.Registry interface does not allow returning an error, so the
+// error is logged and empty string is returned. The missing Secrets are
+// reported by the validation.
+func (reg *Registry) credential(ref *api.SecretKeyRef) string {
+	value, err := reg.resolveSecretRef(context.Background(), ref)
+	if err != nil {
+		reg.apiProvider.GetLogger().Error(err, "cannot resolve secret reference",
+			"registry", reg.apiRegistry.GetName(),
+			"secret", ref.Name,
+			"key", ref.Key)
+		return ""
+	}
+	return value
+}
+
+// GetPassword method implements the globalregistry.RegistryConfig interface. If
+// the password is referred from a Secret, the resolved value is returned.
 func (reg *Registry) GetPassword() string {
+	if ref := reg.apiRegistry.Spec.PasswordSecretRef; ref != nil {
+		return reg.credential(ref)
+	}
 	return reg.apiRegistry.Spec.Password
 }
 
+// GetToken method implements the globalregistry.RegistryWithToken interface. If
+// no token Secret is referred, the deprecated
+// registryman.kubermatic.com/accessToken annotation is used.
+func (reg *Registry) GetToken() string {
+	if ref := reg.apiRegistry.Spec.TokenSecretRef; ref != nil {
+		return reg.credential(ref)
+	}
+	return reg.apiRegistry.Annotations["registryman.kubermatic.com/accessToken"]
+}
+
 // GetAnnotations method implements the globalregistry.RegistryConfig interface.
 func (reg *Registry) GetAnnotations() map[string]string {
 	return reg.apiRegistry.Annotations
@@ -117,6 +199,20 @@ func (reg *Registry) GetOptions() globalregistry.RegistryOptions {
 	return reg.apiProvider.GetGlobalRegistryOptions()
 }
 
+// GetRoleMapping method implements the globalregistry.RegistryWithRoleMapping
+// interface.
+func (reg *Registry) GetRoleMapping() globalregistry.RoleTable {
+	mapping := globalregistry.RoleTable{}
+	for _, rm := range reg.apiRegistry.Spec.RoleMapping {
+		memberType := rm.Type.String()
+		if mapping[memberType] == nil {
+			mapping[memberType] = make(map[string]string)
+		}
+		mapping[memberType][rm.Role.String()] = rm.ProviderRole
+	}
+	return mapping
+}
+
 // GetInsecureSkipTLSVerify method retuns the value insecureSkipTLSVerify field.
 func (reg *Registry) GetInsecureSkipTLSVerify() bool {
 	return reg.apiRegistry.Spec.InsecureSkipTlsVerify
@@ -128,9 +224,11 @@ func (reg *Registry) ToReal() (globalregistry.Registry, error) {
 	return globalregistry.New(reg.apiProvider.GetLogger(), reg)
 }
 
-func (reg *Registry) registryCapabilities() registryCapabilities {
+// registryCapabilities returns the replication related capabilities of the
+// registry from the perspective of the given replication edge.
+func (reg *Registry) registryCapabilities(edge replicationEdge) registryCapabilities {
 	return registryCapabilities{
-		isGlobal:                reg.apiRegistry.Spec.Role == "GlobalHub",
+		isSource:                reg.GetName() == edge.source,
 		ReplicationCapabilities: globalregistry.GetReplicationCapability(reg.GetProvider()),
 	}
 }
@@ -15,15 +15,37 @@ import (
 
 type mockApiProvider struct {
 	forceDelete bool
+	secrets     map[string]string
+	projects    []*api.Project
+	registries  []*api.Registry
+	teams       []*api.Team
 }
 
-func (ap *mockApiProvider) GetProjects(context.Context) []*api.Project               { return nil }
-func (ap *mockApiProvider) GetRegistries(context.Context) []*api.Registry            { return nil }
+func (ap *mockApiProvider) GetProjects(context.Context) []*api.Project               { return ap.projects }
+func (ap *mockApiProvider) GetRegistries(context.Context) []*api.Registry            { return ap.registries }
 func (ap *mockApiProvider) GetScanners(context.Context) []*api.Scanner               { return nil }
+func (ap *mockApiProvider) GetRobotAccounts(context.Context) []*api.RobotAccount     { return nil }
+func (ap *mockApiProvider) GetTeams(context.Context) []*api.Team                     { return ap.teams }
 func (ap *mockApiProvider) GetGlobalRegistryOptions() globalregistry.RegistryOptions { return ap }
 func (ap *mockApiProvider) GetLogger() logr.Logger                                   { return logger }
 func (ap *mockApiProvider) ForceDeleteProjects() bool                                { return ap.forceDelete }
 
+func (ap *mockApiProvider) GetSecretValue(_ context.Context, ref *api.SecretKeyRef) (string, error) {
+	value, found := ap.secrets[ref.Name+"/"+ref.Key]
+	if !found {
+		return "", fmt.Errorf("secret %s not found", ref.Name)
+	}
+	return value, nil
+}
+
+func (ap *mockApiProvider) GetSecretAnnotations(context.Context, string) (map[string]string, error) {
+	return nil, nil
+}
+
+func (ap *mockApiProvider) GetConfigMapData(context.Context, string) (map[string]string, error) {
+	return nil, nil
+}
+
 var _ ApiObjectProvider = &mockApiProvider{}
 
 var (
@@ -141,3 +163,181 @@ func TestRegistry_GetOptions(t *testing.T) {
 		})
 	}
 }
+
+func TestRegistry_GetCredentials(t *testing.T) {
+	ap := &mockApiProvider{
+		secrets: map[string]string{
+			"creds/password": "secret-password",
+			"creds/token":    "secret-token",
+		},
+	}
+	registryTest := []struct {
+		id       string
+		spec     api.RegistrySpec
+		password string
+		token    string
+	}{
+		{id: "plain", spec: api.RegistrySpec{Password: "plain-password"}, password: "plain-password"},
+		{id: "secret", spec: api.RegistrySpec{
+			Password:          "plain-password",
+			PasswordSecretRef: &api.SecretKeyRef{Name: "creds", Key: "password"},
+			TokenSecretRef:    &api.SecretKeyRef{Name: "creds", Key: "token"},
+		}, password: "secret-password", token: "secret-token"},
+		{id: "missing", spec: api.RegistrySpec{
+			PasswordSecretRef: &api.SecretKeyRef{Name: "missing", Key: "password"},
+		}, password: ""},
+	}
+
+	for _, tt := range registryTest {
+		t.Run(tt.id, func(t *testing.T) {
+			reg := New(&api.Registry{Spec: &tt.spec}, ap)
+			if got := reg.GetPassword(); got != tt.password {
+				t.Errorf("TC-%v password got %q want %q", tt.id, got, tt.password)
+			}
+			if got := reg.GetToken(); got != tt.token {
+				t.Errorf("TC-%v token got %q want %q", tt.id, got, tt.token)
+			}
+		})
+	}
+}
+
+func TestRegistry_ResolveCredentials(t *testing.T) {
+	ap := &mockApiProvider{
+		secrets: map[string]string{},
+	}
+	reg := New(&api.Registry{Spec: &api.RegistrySpec{
+		PasswordSecretRef: &api.SecretKeyRef{Name: "creds", Key: "password"},
+	}}, ap)
+	if err := reg.ResolveCredentials(context.Background()); err == nil {
+		t.Errorf("missing secret resolved without error")
+	}
+	// Failed lookups are not cached, the Secret is looked up again once it
+	// has been created.
+	ap.secrets["creds/password"] = "secret-password"
+	if err := reg.ResolveCredentials(context.Background()); err != nil {
+		t.Errorf("unexpected error: %v", err)
+	}
+	if got := reg.GetPassword(); got != "secret-password" {
+		t.Errorf("password got %q want %q", got, "secret-password")
+	}
+}
+
+func TestRegistry_GetTokenFromAnnotation(t *testing.T) {
+	reg := New(&api.Registry{
+		ObjectMeta: v1.ObjectMeta{
+			Annotations: map[string]string{
+				"registryman.kubermatic.com/accessToken": "annotation-token",
+			},
+		},
+		Spec: &api.RegistrySpec{},
+	}, &mockApiProvider{})
+	if got := reg.GetToken(); got != "annotation-token" {
+		t.Errorf("token got %q want %q", got, "annotation-token")
+	}
+}
+
+func TestRegistry_GetRoleTable(t *testing.T) {
+	globalregistry.RegisterProviderImplementation("test-roles", nil, testRepCap{}, globalregistry.RoleTable{
+		"User": {
+			"Developer":  "write",
+			"Maintainer": "write,delete",
+		},
+		"Robot": {
+			"PullOnly": "read",
+		},
+	})
+	reg := New(&api.Registry{
+		Spec: &api.RegistrySpec{
+			Provider: "test-roles",
+			RoleMapping: []api.RoleMapping{
+				{Role: api.MaintainerRole, ProviderRole: "write,admin"},
+				{Type: api.RobotMemberType, Role: api.PullAndPushRole, ProviderRole: "read,write"},
+				{Type: api.GroupMemberType, Role: api.GuestRole, ProviderRole: "read"},
+			},
+		},
+	}, &mockApiProvider{})
+	roleTable, err := globalregistry.GetRoleTable(reg)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	providerRoleTest := []struct {
+		memberType      string
+		role            string
+		expProviderRole string
+		expFound        bool
+	}{
+		{memberType: "User", role: "Developer", expProviderRole: "write", expFound: true},
+		{memberType: "User", role: "Maintainer", expProviderRole: "write,admin", expFound: true},
+		{memberType: "User", role: "Guest", expFound: false},
+		{memberType: "Robot", role: "PullOnly", expProviderRole: "read", expFound: true},
+		{memberType: "Robot", role: "PullAndPush", expProviderRole: "read,write", expFound: true},
+		{memberType: "Group", role: "Guest", expFound: false},
+	}
+	for _, tt := range providerRoleTest {
+		t.Run(tt.memberType+"/"+tt.role, func(t *testing.T) {
+			providerRole, found := roleTable.ProviderRole(tt.memberType, tt.role)
+			if found != tt.expFound || providerRole != tt.expProviderRole {
+				t.Errorf("got %q, %t want %q, %t", providerRole, found, tt.expProviderRole, tt.expFound)
+			}
+		})
+	}
+
+	roleTest := []struct {
+		memberType   string
+		providerRole string
+		expRole      string
+	}{
+		{memberType: "User", providerRole: "admin,write", expRole: "Maintainer"},
+		{memberType: "User", providerRole: "write", expRole: "Developer"},
+		{memberType: "User", providerRole: "write,delete", expRole: globalregistry.UnknownRole},
+		{memberType: "Robot", providerRole: "write,read", expRole: "PullAndPush"},
+	}
+	for _, tt := range roleTest {
+		t.Run(tt.memberType+"/"+tt.providerRole, func(t *testing.T) {
+			if got := roleTable.Role(tt.memberType, tt.providerRole); got != tt.expRole {
+				t.Errorf("got %q want %q", got, tt.expRole)
+			}
+		})
+	}
+}
+
+func TestRoleTable_RoleOfPermissions(t *testing.T) {
+	roleTable := globalregistry.RoleTable{
+		"User": {
+			"Guest":      "r",
+			"Developer":  "r,d,w,n",
+			"Maintainer": "r,d,w,m,n",
+		},
+	}
+	roleTest := []struct {
+		permissions string
+		expRole     string
+	}{
+		{permissions: "r", expRole: "Guest"},
+		{permissions: "w,n,d,r", expRole: "Developer"},
+		{permissions: "r,d,w,n,x", expRole: "Developer"},
+		{permissions: "r,d,w,m,n,mxm", expRole: "Maintainer"},
+		{permissions: "r,w", expRole: "Guest"},
+		{permissions: "d,w", expRole: globalregistry.UnknownRole},
+		{permissions: "", expRole: globalregistry.UnknownRole},
+	}
+	for _, tt := range roleTest {
+		t.Run(tt.permissions, func(t *testing.T) {
+			if got := roleTable.RoleOfPermissions("User", tt.permissions); got != tt.expRole {
+				t.Errorf("got %q want %q", got, tt.expRole)
+			}
+		})
+	}
+}
+
+func TestRegistry_GetRoleTableOfUnknownProvider(t *testing.T) {
+	reg := New(&api.Registry{
+		Spec: &api.RegistrySpec{
+			Provider: "unknown",
+		},
+	}, &mockApiProvider{})
+	if _, err := globalregistry.GetRoleTable(reg); err == nil {
+		t.Error("unknown provider did not cause an error")
+	}
+}
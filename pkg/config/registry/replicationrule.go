@@ -29,25 +29,31 @@ const (
 	pushReplication
 )
 
+// registryCapabilities describes a registry from the perspective of a
+// replication edge.
 type registryCapabilities struct {
-	isGlobal bool
+	isSource bool
 	globalregistry.ReplicationCapabilities
 }
 
-func calculateReplicationRule(local, remote registryCapabilities) calculatedReplication {
-	if local.isGlobal && remote.isGlobal {
-		panic("both local and remote are global")
+// calculateEdgeReplication calculates the replication rule that the local
+// registry shall implement for a replication edge between the local and the
+// remote registry. The source registry pushes the repositories if it can,
+// otherwise the destination registry pulls them.
+func calculateEdgeReplication(local, remote registryCapabilities) calculatedReplication {
+	if local.isSource && remote.isSource {
+		panic("both local and remote are the source of the replication")
 	}
-	if !local.isGlobal && !remote.isGlobal {
+	if !local.isSource && !remote.isSource {
 		return noReplication
 	}
-	if remote.isGlobal && remote.CanPush() {
+	if remote.isSource && remote.CanPush() {
 		return noReplication
 	}
-	if local.isGlobal && local.CanPush() {
+	if local.isSource && local.CanPush() {
 		return pushReplication
 	}
-	if !local.isGlobal && local.CanPull() {
+	if !local.isSource && local.CanPull() {
 		return pullReplication
 	}
 	return noReplication
@@ -60,6 +66,7 @@ type replicationRule struct {
 }
 
 var _ globalregistry.ReplicationRule = &replicationRule{}
+var _ globalregistry.ReplicationRuleWithOptions = &replicationRule{}
 
 func (rule *replicationRule) GetProjectName() string {
 	return rule.project.GetName()
@@ -136,3 +143,27 @@ func (rule *replicationRule) Direction() string {
 func (rule *replicationRule) RemoteRegistry() globalregistry.Registry {
 	return rule.remote
 }
+
+// Options returns the filters and the behavior flags of the replication
+// configured for the project. Deletions are replicated and the existing
+// artifacts are overwritten unless configured otherwise.
+func (rule *replicationRule) Options() api.ReplicationOptions {
+	options := api.ReplicationOptions{
+		Deletion: true,
+		Override: true,
+	}
+	replication := rule.project.Spec.Replication
+	if replication == nil {
+		return options
+	}
+	if replication.Filters != nil {
+		options.Filters = *replication.Filters
+	}
+	if replication.Deletion != nil {
+		options.Deletion = *replication.Deletion
+	}
+	if replication.Override != nil {
+		options.Override = *replication.Override
+	}
+	return options
+}
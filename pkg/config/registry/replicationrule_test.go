@@ -34,117 +34,117 @@ func (trp testRepCap) CanPull() bool {
 func (trp testRepCap) CanPush() bool {
 	return trp.push
 }
-func TestComputeReplicationRule(t *testing.T) {
-	locNocap := registryCapabilities{false, testRepCap{false, false}}
-	locPush := registryCapabilities{false, testRepCap{true, false}}
-	locPull := registryCapabilities{false, testRepCap{false, true}}
-	locPushPull := registryCapabilities{false, testRepCap{true, true}}
-	globNocap := registryCapabilities{true, testRepCap{false, false}}
-	globPush := registryCapabilities{true, testRepCap{true, false}}
-	globPull := registryCapabilities{true, testRepCap{false, true}}
-	globPushPull := registryCapabilities{true, testRepCap{true, true}}
+func TestComputeEdgeReplication(t *testing.T) {
+	dstNocap := registryCapabilities{false, testRepCap{false, false}}
+	dstPush := registryCapabilities{false, testRepCap{true, false}}
+	dstPull := registryCapabilities{false, testRepCap{false, true}}
+	dstPushPull := registryCapabilities{false, testRepCap{true, true}}
+	srcNocap := registryCapabilities{true, testRepCap{false, false}}
+	srcPush := registryCapabilities{true, testRepCap{true, false}}
+	srcPull := registryCapabilities{true, testRepCap{false, true}}
+	srcPushPull := registryCapabilities{true, testRepCap{true, true}}
 
-	if calculateReplicationRule(globPush, locPush) != pushReplication {
+	if calculateEdgeReplication(srcPush, dstPush) != pushReplication {
 		t.Error("unexpected result")
 	}
-	if calculateReplicationRule(globPush, locPull) != pushReplication {
+	if calculateEdgeReplication(srcPush, dstPull) != pushReplication {
 		t.Error("unexpected result")
 	}
-	if calculateReplicationRule(globPush, locPushPull) != pushReplication {
+	if calculateEdgeReplication(srcPush, dstPushPull) != pushReplication {
 		t.Error("unexpected result")
 	}
-	if calculateReplicationRule(globPush, locNocap) != pushReplication {
+	if calculateEdgeReplication(srcPush, dstNocap) != pushReplication {
 		t.Error("unexpected result")
 	}
 
-	if calculateReplicationRule(locPush, globPush) != noReplication {
+	if calculateEdgeReplication(dstPush, srcPush) != noReplication {
 		t.Error("unexpected result")
 	}
-	if calculateReplicationRule(locPull, globPush) != noReplication {
+	if calculateEdgeReplication(dstPull, srcPush) != noReplication {
 		t.Error("unexpected result")
 	}
-	if calculateReplicationRule(locPushPull, globPush) != noReplication {
+	if calculateEdgeReplication(dstPushPull, srcPush) != noReplication {
 		t.Error("unexpected result")
 	}
-	if calculateReplicationRule(locNocap, globPush) != noReplication {
+	if calculateEdgeReplication(dstNocap, srcPush) != noReplication {
 		t.Error("unexpected result")
 	}
 
-	if calculateReplicationRule(globPull, locPush) != noReplication {
+	if calculateEdgeReplication(srcPull, dstPush) != noReplication {
 		t.Error("unexpected result")
 	}
-	if calculateReplicationRule(globPull, locPull) != noReplication {
+	if calculateEdgeReplication(srcPull, dstPull) != noReplication {
 		t.Error("unexpected result")
 	}
-	if calculateReplicationRule(globPull, locPushPull) != noReplication {
+	if calculateEdgeReplication(srcPull, dstPushPull) != noReplication {
 		t.Error("unexpected result")
 	}
-	if calculateReplicationRule(globPull, locNocap) != noReplication {
+	if calculateEdgeReplication(srcPull, dstNocap) != noReplication {
 		t.Error("unexpected result")
 	}
 
-	if calculateReplicationRule(locPush, globPull) != noReplication {
+	if calculateEdgeReplication(dstPush, srcPull) != noReplication {
 		t.Error("unexpected result")
 	}
-	if calculateReplicationRule(locPull, globPull) != pullReplication {
+	if calculateEdgeReplication(dstPull, srcPull) != pullReplication {
 		t.Error("unexpected result")
 	}
-	if calculateReplicationRule(locPushPull, globPull) != pullReplication {
+	if calculateEdgeReplication(dstPushPull, srcPull) != pullReplication {
 		t.Error("unexpected result")
 	}
-	if calculateReplicationRule(locNocap, globPull) != noReplication {
+	if calculateEdgeReplication(dstNocap, srcPull) != noReplication {
 		t.Error("unexpected result")
 	}
 
-	if calculateReplicationRule(globPushPull, locPush) != pushReplication {
+	if calculateEdgeReplication(srcPushPull, dstPush) != pushReplication {
 		t.Error("unexpected result")
 	}
-	if calculateReplicationRule(globPushPull, locPull) != pushReplication {
+	if calculateEdgeReplication(srcPushPull, dstPull) != pushReplication {
 		t.Error("unexpected result")
 	}
-	if calculateReplicationRule(globPushPull, locPushPull) != pushReplication {
+	if calculateEdgeReplication(srcPushPull, dstPushPull) != pushReplication {
 		t.Error("unexpected result")
 	}
-	if calculateReplicationRule(globPushPull, locNocap) != pushReplication {
+	if calculateEdgeReplication(srcPushPull, dstNocap) != pushReplication {
 		t.Error("unexpected result")
 	}
 
-	if calculateReplicationRule(locPush, globPushPull) != noReplication {
+	if calculateEdgeReplication(dstPush, srcPushPull) != noReplication {
 		t.Error("unexpected result")
 	}
-	if calculateReplicationRule(locPull, globPushPull) != noReplication {
+	if calculateEdgeReplication(dstPull, srcPushPull) != noReplication {
 		t.Error("unexpected result")
 	}
-	if calculateReplicationRule(locPushPull, globPushPull) != noReplication {
+	if calculateEdgeReplication(dstPushPull, srcPushPull) != noReplication {
 		t.Error("unexpected result")
 	}
-	if calculateReplicationRule(locNocap, globPushPull) != noReplication {
+	if calculateEdgeReplication(dstNocap, srcPushPull) != noReplication {
 		t.Error("unexpected result")
 	}
 
-	if calculateReplicationRule(globNocap, locPush) != noReplication {
+	if calculateEdgeReplication(srcNocap, dstPush) != noReplication {
 		t.Error("unexpected result")
 	}
-	if calculateReplicationRule(globNocap, locPull) != noReplication {
+	if calculateEdgeReplication(srcNocap, dstPull) != noReplication {
 		t.Error("unexpected result")
 	}
-	if calculateReplicationRule(globNocap, locPushPull) != noReplication {
+	if calculateEdgeReplication(srcNocap, dstPushPull) != noReplication {
 		t.Error("unexpected result")
 	}
-	if calculateReplicationRule(globNocap, locNocap) != noReplication {
+	if calculateEdgeReplication(srcNocap, dstNocap) != noReplication {
 		t.Error("unexpected result")
 	}
 
-	if calculateReplicationRule(locPush, globNocap) != noReplication {
+	if calculateEdgeReplication(dstPush, srcNocap) != noReplication {
 		t.Error("unexpected result")
 	}
-	if calculateReplicationRule(locPull, globNocap) != pullReplication {
+	if calculateEdgeReplication(dstPull, srcNocap) != pullReplication {
 		t.Error("unexpected result")
 	}
-	if calculateReplicationRule(locPushPull, globNocap) != pullReplication {
+	if calculateEdgeReplication(dstPushPull, srcNocap) != pullReplication {
 		t.Error("unexpected result")
 	}
-	if calculateReplicationRule(locNocap, globNocap) != noReplication {
+	if calculateEdgeReplication(dstNocap, srcNocap) != noReplication {
 		t.Error("unexpected result")
 	}
 }
@@ -250,3 +250,44 @@ func TestReplicationRuleTrigger(t *testing.T) {
 		t.Error("unexpected result")
 	}
 }
+
+func TestReplicationRuleOptions(t *testing.T) {
+	noDeletion := false
+	projectWantsDefaults := &project{
+		Project: &v1alpha1.Project{
+			Spec: &v1alpha1.ProjectSpec{},
+		},
+		registry: &Registry{},
+	}
+	projectWantsFilters := &project{
+		Project: &v1alpha1.Project{
+			Spec: &v1alpha1.ProjectSpec{
+				Replication: &v1alpha1.ProjectReplication{
+					Filters: &v1alpha1.ReplicationFilters{
+						Tags:         "v*",
+						ResourceType: v1alpha1.ImageReplicationResourceType,
+					},
+					Deletion: &noDeletion,
+				},
+			},
+		},
+		registry: &Registry{},
+	}
+
+	if testReplicationRule(projectWantsDefaults, pushReplication).Options() != (v1alpha1.ReplicationOptions{
+		Deletion: true,
+		Override: true,
+	}) {
+		t.Error("unexpected result")
+	}
+	if testReplicationRule(projectWantsFilters, pullReplication).Options() != (v1alpha1.ReplicationOptions{
+		Filters: v1alpha1.ReplicationFilters{
+			Tags:         "v*",
+			ResourceType: v1alpha1.ImageReplicationResourceType,
+		},
+		Deletion: false,
+		Override: true,
+	}) {
+		t.Error("unexpected result")
+	}
+}
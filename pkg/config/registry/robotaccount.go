@@ -0,0 +1,60 @@
+/*
+   Copyright 2021 The Kubermatic Kubernetes Platform contributors.
+
+   Licensed under the Apache License, Version 2.0 (the "License");
+   you may not use this file except in compliance with the License.
+   You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+   Unless required by applicable law or agreed to in writing, software
+   distributed under the License is distributed on an "AS IS" BASIS,
+   WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+   See the License for the specific language governing permissions and
+   limitations under the License.
+*/
+
+package registry
+
+import (
+	"context"
+
+	api "github.com/kubermatic-labs/registryman/pkg/apis/registryman/v1alpha1"
+	"github.com/kubermatic-labs/registryman/pkg/globalregistry"
+)
+
+type robotAccount struct {
+	*api.RobotAccount
+}
+
+var _ globalregistry.RobotAccount = &robotAccount{}
+
+// GetStatus method returns the expected state of the robot account. The
+// RobotAccount resources without expiration are mapped to Duration -1.
+func (ra *robotAccount) GetStatus() api.RobotAccountStatus {
+	status := api.RobotAccountStatus{
+		Name:         ra.GetName(),
+		Description:  ra.Spec.Description,
+		Projects:     ra.Spec.Projects,
+		Duration:     -1,
+		RotateBefore: ra.Spec.RotateBefore,
+	}
+	if ra.Spec.ExpiresIn > 0 {
+		status.Duration = ra.Spec.ExpiresIn
+	}
+	return status
+}
+
+var _ globalregistry.RegistryWithRobotAccounts = &Registry{}
+
+// ListRobotAccounts method returns the robot accounts which refer to the
+// registry.
+func (reg *Registry) ListRobotAccounts(ctx context.Context) ([]globalregistry.RobotAccount, error) {
+	robotAccounts := []globalregistry.RobotAccount{}
+	for _, apiRobotAccount := range reg.apiProvider.GetRobotAccounts(ctx) {
+		if apiRobotAccount.Spec.Registry == reg.GetName() {
+			robotAccounts = append(robotAccounts, &robotAccount{apiRobotAccount})
+		}
+	}
+	return robotAccounts, nil
+}
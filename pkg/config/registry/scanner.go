@@ -17,16 +17,44 @@
 package registry
 
 import (
+	"context"
+	"fmt"
+
 	api "github.com/kubermatic-labs/registryman/pkg/apis/registryman/v1alpha1"
 	"github.com/kubermatic-labs/registryman/pkg/globalregistry"
 )
 
 type scanner struct {
 	*api.Scanner
+	accessCredential string
 }
 
-var _ globalregistry.Scanner = &scanner{}
+var _ globalregistry.ScannerWithAccessCredential = &scanner{}
 
 func (s *scanner) GetURL() string {
 	return s.Spec.Url
 }
+
+// GetAccessCredential implements the
+// globalregistry.ScannerWithAccessCredential interface.
+func (s *scanner) GetAccessCredential() string {
+	return s.accessCredential
+}
+
+// newScanner returns the scanner with its access credential resolved. If the
+// credential is referred from a Secret, the Secret value is used.
+func newScanner(ctx context.Context, aop ApiObjectProvider, s *api.Scanner) (*scanner, error) {
+	accessCredential := s.Spec.AccessCredential
+	if ref := s.Spec.AccessCredentialSecretRef; ref != nil {
+		var err error
+		accessCredential, err = aop.GetSecretValue(ctx, ref)
+		if err != nil {
+			return nil, fmt.Errorf("cannot resolve key %s of secret %s for scanner %s: %w",
+				ref.Key, ref.Name, s.GetName(), err)
+		}
+	}
+	return &scanner{
+		Scanner:          s,
+		accessCredential: accessCredential,
+	}, nil
+}
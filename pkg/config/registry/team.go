@@ -0,0 +1,62 @@
+/*
+   Copyright 2021 The Kubermatic Kubernetes Platform contributors.
+
+   Licensed under the Apache License, Version 2.0 (the "License");
+   you may not use this file except in compliance with the License.
+   You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+   Unless required by applicable law or agreed to in writing, software
+   distributed under the License is distributed on an "AS IS" BASIS,
+   WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+   See the License for the specific language governing permissions and
+   limitations under the License.
+*/
+
+package registry
+
+import (
+	api "github.com/kubermatic-labs/registryman/pkg/apis/registryman/v1alpha1"
+)
+
+// MembersOfProject returns the members of the project, i.e. the inline members
+// followed by the members of the referred teams. A member of a team is skipped
+// if a member of the same type and name has already been collected, so the
+// inline members take precedence over the members of the teams and the
+// earlier teams take precedence over the later ones. The references to
+// non-existing teams are ignored.
+func MembersOfProject(proj *api.Project, teams []*api.Team) []*api.ProjectMember {
+	if len(proj.Spec.Teams) == 0 {
+		return proj.Spec.Members
+	}
+	teamsByName := make(map[string]*api.Team)
+	for _, team := range teams {
+		teamsByName[team.GetName()] = team
+	}
+	type memberKey struct {
+		memberType api.MemberType
+		name       string
+	}
+	members := make([]*api.ProjectMember, 0, len(proj.Spec.Members))
+	found := make(map[memberKey]bool)
+	addMembers := func(pMembers []*api.ProjectMember) {
+		for _, member := range pMembers {
+			key := memberKey{member.Type, member.Name}
+			if found[key] {
+				continue
+			}
+			found[key] = true
+			members = append(members, member)
+		}
+	}
+	addMembers(proj.Spec.Members)
+	for _, teamName := range proj.Spec.Teams {
+		team, ok := teamsByName[teamName]
+		if !ok || team.Spec == nil {
+			continue
+		}
+		addMembers(team.Spec.Members)
+	}
+	return members
+}
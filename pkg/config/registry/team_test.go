@@ -0,0 +1,96 @@
+/*
+   Copyright 2021 The Kubermatic Kubernetes Platform contributors.
+
+   Licensed under the Apache License, Version 2.0 (the "License");
+   you may not use this file except in compliance with the License.
+   You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+   Unless required by applicable law or agreed to in writing, software
+   distributed under the License is distributed on an "AS IS" BASIS,
+   WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+   See the License for the specific language governing permissions and
+   limitations under the License.
+*/
+
+package registry
+
+import (
+	"context"
+	"testing"
+
+	api "github.com/kubermatic-labs/registryman/pkg/apis/registryman/v1alpha1"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func testTeam(name string, members ...*api.ProjectMember) *api.Team {
+	return &api.Team{
+		ObjectMeta: v1.ObjectMeta{
+			Name: name,
+		},
+		Spec: &api.TeamSpec{
+			Members: members,
+		},
+	}
+}
+
+func TestMembersOfProject(t *testing.T) {
+	teams := []*api.Team{
+		testTeam("devs",
+			&api.ProjectMember{Name: "alpha", Role: api.DeveloperRole},
+			&api.ProjectMember{Name: "beta", Role: api.DeveloperRole},
+			&api.ProjectMember{Name: "ci", Type: api.RobotMemberType, Role: api.PullAndPushRole},
+		),
+		testTeam("ops",
+			&api.ProjectMember{Name: "beta", Role: api.MaintainerRole},
+			&api.ProjectMember{Name: "gamma", Role: api.ProjectAdminRole},
+		),
+	}
+	inline := testProject("inline", api.GlobalProjectType, "")
+	inline.Spec.Members = []*api.ProjectMember{
+		{Name: "alpha", Role: api.GuestRole},
+	}
+	withTeams := testProject("with-teams", api.GlobalProjectType, "")
+	withTeams.Spec.Members = []*api.ProjectMember{
+		{Name: "alpha", Role: api.GuestRole},
+		{Name: "ci", Role: api.GuestRole},
+	}
+	withTeams.Spec.Teams = []string{"devs", "ops", "missing"}
+
+	membersTest := []struct {
+		project    *api.Project
+		expMembers []string
+	}{
+		{project: inline, expMembers: []string{"User/alpha/Guest"}},
+		{project: withTeams, expMembers: []string{
+			"User/alpha/Guest",
+			"User/ci/Guest",
+			"User/beta/Developer",
+			"Robot/ci/PullAndPush",
+			"User/gamma/ProjectAdmin",
+		}},
+	}
+
+	for _, tt := range membersTest {
+		t.Run(tt.project.GetName(), func(t *testing.T) {
+			reg := New(testRegistry("registry", "GlobalHub", nil), &mockApiProvider{
+				projects: []*api.Project{tt.project},
+				teams:    teams,
+			})
+			members, err := (&project{tt.project, reg}).GetMembers(context.Background())
+			if err != nil {
+				t.Fatalf("%v", err)
+			}
+			if len(members) != len(tt.expMembers) {
+				t.Fatalf("got %d members want %v", len(members), tt.expMembers)
+			}
+			for i, member := range members {
+				got := member.GetType() + "/" + member.GetName() + "/" + member.GetRole()
+				if got != tt.expMembers[i] {
+					t.Errorf("member %d got %s want %s", i, got, tt.expMembers[i])
+				}
+			}
+		})
+	}
+}
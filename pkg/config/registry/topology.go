@@ -0,0 +1,199 @@
+/*
+   Copyright 2021 The Kubermatic Kubernetes Platform contributors.
+
+   Licensed under the Apache License, Version 2.0 (the "License");
+   you may not use this file except in compliance with the License.
+   You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+   Unless required by applicable law or agreed to in writing, software
+   distributed under the License is distributed on an "AS IS" BASIS,
+   WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+   See the License for the specific language governing permissions and
+   limitations under the License.
+*/
+
+package registry
+
+import (
+	"fmt"
+
+	api "github.com/kubermatic-labs/registryman/pkg/apis/registryman/v1alpha1"
+	"github.com/kubermatic-labs/registryman/pkg/globalregistry"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+)
+
+// replicationEdge is a directed edge of the replication topology of a project:
+// the repositories of the project are replicated from the source registry to
+// the destination registry.
+type replicationEdge struct {
+	source      string
+	destination string
+}
+
+// IsProjectProvisioned returns true if the project shall be provisioned in the
+// registry. The global projects are provisioned in their hub and in all local
+// registries, the local projects are provisioned in the listed local
+// registries and in the Local registries matching the local registry selector.
+func IsProjectProvisioned(proj *api.Project, reg *api.Registry, registries []*api.Registry) bool {
+	switch proj.Spec.Type {
+	case api.GlobalProjectType:
+		if !IsGlobalHub(reg) {
+			return true
+		}
+		hub := HubOfProject(proj, registries)
+		return hub != nil && hub.GetName() == reg.GetName()
+	case api.LocalProjectType:
+		return isListedLocalRegistry(proj, reg) || isSelectedLocalRegistry(proj, reg)
+	}
+	return false
+}
+
+// LocalRegistriesOfProject returns the registries where the local project shall
+// be provisioned. The registries listed in LocalRegistries come first in their
+// order, followed by the other Local registries matching the local registry
+// selector.
+func LocalRegistriesOfProject(proj *api.Project, registries []*api.Registry) []*api.Registry {
+	result := make([]*api.Registry, 0)
+	for _, lReg := range proj.Spec.LocalRegistries {
+		for _, reg := range registries {
+			if reg.GetName() == lReg {
+				result = append(result, reg)
+			}
+		}
+	}
+	for _, reg := range registries {
+		if isSelectedLocalRegistry(proj, reg) && !isListedLocalRegistry(proj, reg) {
+			result = append(result, reg)
+		}
+	}
+	return result
+}
+
+// isListedLocalRegistry returns true if the registry is listed in the
+// LocalRegistries of the project.
+func isListedLocalRegistry(proj *api.Project, reg *api.Registry) bool {
+	for _, lReg := range proj.Spec.LocalRegistries {
+		if lReg == reg.GetName() {
+			return true
+		}
+	}
+	return false
+}
+
+// isSelectedLocalRegistry returns true if the registry is a Local registry
+// and its labels match the local registry selector of the project. An invalid
+// selector matches no registry, it is reported by the validation.
+func isSelectedLocalRegistry(proj *api.Project, reg *api.Registry) bool {
+	if proj.Spec.LocalRegistrySelector == nil || reg.Spec.Role != "Local" {
+		return false
+	}
+	selector, err := metav1.LabelSelectorAsSelector(proj.Spec.LocalRegistrySelector)
+	if err != nil {
+		return false
+	}
+	return selector.Matches(labels.Set(reg.GetLabels()))
+}
+
+// replicationTopology returns the replication edges of the project. If the
+// project lists replication routes, the edges are the routes. Otherwise a
+// global project is replicated from its hub to every local registry and a
+// local project is not replicated. Without GlobalHub registry the global
+// project is not replicated either. The edges touching an excluded registry are
+// omitted.
+func replicationTopology(proj *api.Project, registries []*api.Registry) ([]replicationEdge, error) {
+	edges := make([]replicationEdge, 0)
+	replication := proj.Spec.Replication
+	if replication == nil {
+		replication = &api.ProjectReplication{}
+	}
+	switch {
+	case len(replication.Routes) > 0:
+		for _, route := range replication.Routes {
+			edges = append(edges, replicationEdge{
+				source:      route.From,
+				destination: route.To,
+			})
+		}
+	case proj.Spec.Type == api.GlobalProjectType:
+		hub := HubOfProject(proj, registries)
+		if hub == nil {
+			if proj.Spec.Hub != "" || HasGlobalHub(registries) {
+				return nil, fmt.Errorf("hub of global project %s cannot be determined", proj.GetName())
+			}
+			break
+		}
+		for _, reg := range registries {
+			if IsGlobalHub(reg) {
+				continue
+			}
+			edges = append(edges, replicationEdge{
+				source:      hub.GetName(),
+				destination: reg.GetName(),
+			})
+		}
+	}
+	excluded := make(map[string]bool)
+	for _, regName := range replication.Exclude {
+		excluded[regName] = true
+	}
+	result := make([]replicationEdge, 0, len(edges))
+	for _, edge := range edges {
+		if !excluded[edge.source] && !excluded[edge.destination] {
+			result = append(result, edge)
+		}
+	}
+	return result, nil
+}
+
+// ManagedReplication describes a replication edge of a project that cannot be
+// implemented by the registries, because the source registry cannot push and
+// the destination registry cannot pull. The repositories of these edges are
+// copied by registryman.
+type ManagedReplication struct {
+	Project     *api.Project
+	Source      *api.Registry
+	Destination *api.Registry
+}
+
+// isManagedReplication returns true if neither the source nor the destination
+// registry can implement the replication edge natively.
+func isManagedReplication(source, destination *api.Registry) bool {
+	sourceCap := globalregistry.GetReplicationCapability(source.Spec.Provider)
+	destinationCap := globalregistry.GetReplicationCapability(destination.Spec.Provider)
+	return !sourceCap.CanPush() && !destinationCap.CanPull()
+}
+
+// ManagedReplications returns the replication edges of the projects that shall
+// be performed by registryman. The projects whose replication topology cannot
+// be determined are skipped.
+func ManagedReplications(projects []*api.Project, registries []*api.Registry) []ManagedReplication {
+	registriesByName := make(map[string]*api.Registry)
+	for _, reg := range registries {
+		registriesByName[reg.GetName()] = reg
+	}
+	result := make([]ManagedReplication, 0)
+	for _, proj := range projects {
+		edges, err := replicationTopology(proj, registries)
+		if err != nil {
+			continue
+		}
+		for _, edge := range edges {
+			source := registriesByName[edge.source]
+			destination := registriesByName[edge.destination]
+			if source == nil || destination == nil {
+				continue
+			}
+			if isManagedReplication(source, destination) {
+				result = append(result, ManagedReplication{
+					Project:     proj,
+					Source:      source,
+					Destination: destination,
+				})
+			}
+		}
+	}
+	return result
+}
@@ -0,0 +1,262 @@
+/*
+   Copyright 2021 The Kubermatic Kubernetes Platform contributors.
+
+   Licensed under the Apache License, Version 2.0 (the "License");
+   you may not use this file except in compliance with the License.
+   You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+   Unless required by applicable law or agreed to in writing, software
+   distributed under the License is distributed on an "AS IS" BASIS,
+   WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+   See the License for the specific language governing permissions and
+   limitations under the License.
+*/
+
+package registry
+
+import (
+	"context"
+	"testing"
+
+	api "github.com/kubermatic-labs/registryman/pkg/apis/registryman/v1alpha1"
+	"github.com/kubermatic-labs/registryman/pkg/globalregistry"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func init() {
+	globalregistry.RegisterProviderImplementation("test-push", nil, testRepCap{push: true}, globalregistry.RoleTable{})
+	globalregistry.RegisterProviderImplementation("test-pull", nil, testRepCap{pull: true}, globalregistry.RoleTable{})
+	globalregistry.RegisterProviderImplementation("test-none", nil, testRepCap{}, globalregistry.RoleTable{})
+}
+
+func TestReplicationTopology(t *testing.T) {
+	hub := testRegistry("hub", "GlobalHub", nil)
+	regional := testRegistry("regional", "Local", nil)
+	edge := testRegistry("edge", "Local", nil)
+	registries := []*api.Registry{hub, regional, edge}
+
+	chained := testProject("chained", api.GlobalProjectType, "")
+	chained.Spec.Replication = &api.ProjectReplication{
+		Routes: []api.ReplicationRoute{
+			{From: "hub", To: "regional"},
+			{From: "regional", To: "edge"},
+		},
+	}
+	optOut := testProject("opt-out", api.GlobalProjectType, "")
+	optOut.Spec.Replication = &api.ProjectReplication{
+		Exclude: []string{"edge"},
+	}
+	localToLocal := testProject("local-to-local", api.LocalProjectType, "")
+	localToLocal.Spec.LocalRegistries = []string{"regional", "edge"}
+	localToLocal.Spec.Replication = &api.ProjectReplication{
+		Routes: []api.ReplicationRoute{
+			{From: "edge", To: "regional"},
+		},
+	}
+
+	topologyTest := []struct {
+		project  *api.Project
+		expEdges []replicationEdge
+	}{
+		{project: testProject("default", api.GlobalProjectType, ""), expEdges: []replicationEdge{{"hub", "regional"}, {"hub", "edge"}}},
+		{project: testProject("local", api.LocalProjectType, ""), expEdges: []replicationEdge{}},
+		{project: chained, expEdges: []replicationEdge{{"hub", "regional"}, {"regional", "edge"}}},
+		{project: optOut, expEdges: []replicationEdge{{"hub", "regional"}}},
+		{project: localToLocal, expEdges: []replicationEdge{{"edge", "regional"}}},
+	}
+
+	for _, tt := range topologyTest {
+		t.Run(tt.project.GetName(), func(t *testing.T) {
+			edges, err := replicationTopology(tt.project, registries)
+			if err != nil {
+				t.Fatalf("%v", err)
+			}
+			if len(edges) != len(tt.expEdges) {
+				t.Fatalf("got %v want %v", edges, tt.expEdges)
+			}
+			for i := range edges {
+				if edges[i] != tt.expEdges[i] {
+					t.Errorf("got %v want %v", edges, tt.expEdges)
+				}
+			}
+		})
+	}
+}
+
+func TestReplicationTopologyWithoutHub(t *testing.T) {
+	registries := []*api.Registry{
+		testRegistry("regional", "Local", nil),
+		testRegistry("edge", "Local", nil),
+	}
+	edges, err := replicationTopology(testProject("default", api.GlobalProjectType, ""), registries)
+	if err != nil {
+		t.Fatalf("%v", err)
+	}
+	if len(edges) != 0 {
+		t.Errorf("got %v want no edges", edges)
+	}
+	_, err = replicationTopology(testProject("selected", api.GlobalProjectType, "hub"), registries)
+	if err == nil {
+		t.Errorf("missing hub is not reported")
+	}
+}
+
+func TestLocalRegistriesOfProject(t *testing.T) {
+	hub := testRegistry("hub", "GlobalHub", nil)
+	hub.Labels = map[string]string{"tier": "edge"}
+	regional := testRegistry("regional", "Local", nil)
+	regional.Labels = map[string]string{"tier": "regional"}
+	edge1 := testRegistry("edge-1", "Local", nil)
+	edge1.Labels = map[string]string{"tier": "edge"}
+	edge2 := testRegistry("edge-2", "Local", nil)
+	edge2.Labels = map[string]string{"tier": "edge"}
+	registries := []*api.Registry{hub, regional, edge1, edge2}
+
+	listed := testProject("listed", api.LocalProjectType, "")
+	listed.Spec.LocalRegistries = []string{"edge-2", "regional"}
+	selected := testProject("selected", api.LocalProjectType, "")
+	selected.Spec.LocalRegistrySelector = &metav1.LabelSelector{
+		MatchLabels: map[string]string{"tier": "edge"},
+	}
+	combined := testProject("combined", api.LocalProjectType, "")
+	combined.Spec.LocalRegistries = []string{"edge-2", "regional"}
+	combined.Spec.LocalRegistrySelector = &metav1.LabelSelector{
+		MatchExpressions: []metav1.LabelSelectorRequirement{
+			{Key: "tier", Operator: metav1.LabelSelectorOpIn, Values: []string{"edge"}},
+		},
+	}
+	invalid := testProject("invalid", api.LocalProjectType, "")
+	invalid.Spec.LocalRegistrySelector = &metav1.LabelSelector{
+		MatchExpressions: []metav1.LabelSelectorRequirement{
+			{Key: "tier", Operator: "Like", Values: []string{"edge"}},
+		},
+	}
+
+	localRegistriesTest := []struct {
+		project       *api.Project
+		expRegistries []string
+	}{
+		{project: testProject("none", api.LocalProjectType, ""), expRegistries: []string{}},
+		{project: listed, expRegistries: []string{"edge-2", "regional"}},
+		{project: selected, expRegistries: []string{"edge-1", "edge-2"}},
+		{project: combined, expRegistries: []string{"edge-2", "regional", "edge-1"}},
+		{project: invalid, expRegistries: []string{}},
+	}
+
+	for _, tt := range localRegistriesTest {
+		t.Run(tt.project.GetName(), func(t *testing.T) {
+			localRegistries := LocalRegistriesOfProject(tt.project, registries)
+			got := make([]string, len(localRegistries))
+			for i, reg := range localRegistries {
+				got[i] = reg.GetName()
+				if !IsProjectProvisioned(tt.project, reg, registries) {
+					t.Errorf("project is not provisioned in %s", reg.GetName())
+				}
+			}
+			if len(got) != len(tt.expRegistries) {
+				t.Fatalf("got %v want %v", got, tt.expRegistries)
+			}
+			for i := range got {
+				if got[i] != tt.expRegistries[i] {
+					t.Errorf("got %v want %v", got, tt.expRegistries)
+				}
+			}
+			if IsProjectProvisioned(tt.project, hub, registries) {
+				t.Errorf("local project is provisioned in the hub")
+			}
+		})
+	}
+}
+
+func TestChainedReplicationRules(t *testing.T) {
+	hub := testRegistry("hub", "GlobalHub", nil)
+	hub.Spec.Provider = "test-push"
+	regional := testRegistry("regional", "Local", nil)
+	regional.Spec.Provider = "test-push"
+	edge := testRegistry("edge", "Local", nil)
+	edge.Spec.Provider = "test-pull"
+	proj := testProject("chained", api.GlobalProjectType, "")
+	proj.Spec.Replication = &api.ProjectReplication{
+		Routes: []api.ReplicationRoute{
+			{From: "hub", To: "regional"},
+			{From: "regional", To: "edge"},
+		},
+	}
+	ap := &mockApiProvider{
+		registries: []*api.Registry{hub, regional, edge},
+		projects:   []*api.Project{proj},
+	}
+
+	rulesTest := []struct {
+		registry *api.Registry
+		expRules []string
+	}{
+		{registry: hub, expRules: []string{"Push regional"}},
+		{registry: regional, expRules: []string{"Push edge"}},
+		{registry: edge, expRules: []string{}},
+	}
+
+	for _, tt := range rulesTest {
+		t.Run(tt.registry.GetName(), func(t *testing.T) {
+			p, err := New(tt.registry, ap).GetProjectByName(context.Background(), "chained")
+			if err != nil {
+				t.Fatalf("%v", err)
+			}
+			rules, err := p.(globalregistry.ProjectWithReplication).GetReplicationRules(context.Background(), nil, "")
+			if err != nil {
+				t.Fatalf("%v", err)
+			}
+			got := make([]string, len(rules))
+			for i, rule := range rules {
+				got[i] = rule.Direction() + " " + rule.RemoteRegistry().GetName()
+			}
+			if len(got) != len(tt.expRules) {
+				t.Fatalf("got %v want %v", got, tt.expRules)
+			}
+			for i := range got {
+				if got[i] != tt.expRules[i] {
+					t.Errorf("got %v want %v", got, tt.expRules)
+				}
+			}
+		})
+	}
+}
+
+func TestManagedReplications(t *testing.T) {
+	hub := testRegistry("hub", "GlobalHub", nil)
+	hub.Spec.Provider = "test-none"
+	pushingHub := testRegistry("pushing-hub", "GlobalHub", nil)
+	pushingHub.Spec.Provider = "test-push"
+	acr := testRegistry("acr", "Local", nil)
+	acr.Spec.Provider = "test-none"
+	pulling := testRegistry("pulling", "Local", nil)
+	pulling.Spec.Provider = "test-pull"
+	registries := []*api.Registry{hub, pushingHub, acr, pulling}
+
+	managedReplicationTest := []struct {
+		project *api.Project
+		expEdge []replicationEdge
+	}{
+		{project: testProject("from-hub", api.GlobalProjectType, "hub"), expEdge: []replicationEdge{{"hub", "acr"}}},
+		{project: testProject("from-pushing-hub", api.GlobalProjectType, "pushing-hub"), expEdge: []replicationEdge{}},
+		{project: testProject("local", api.LocalProjectType, ""), expEdge: []replicationEdge{}},
+	}
+
+	for _, tt := range managedReplicationTest {
+		t.Run(tt.project.GetName(), func(t *testing.T) {
+			managedReplications := ManagedReplications([]*api.Project{tt.project}, registries)
+			if len(managedReplications) != len(tt.expEdge) {
+				t.Fatalf("got %v want %v", managedReplications, tt.expEdge)
+			}
+			for i, mr := range managedReplications {
+				if mr.Project != tt.project ||
+					mr.Source.GetName() != tt.expEdge[i].source ||
+					mr.Destination.GetName() != tt.expEdge[i].destination {
+					t.Errorf("got %v want %v", managedReplications, tt.expEdge)
+				}
+			}
+		})
+	}
+}
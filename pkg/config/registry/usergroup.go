@@ -0,0 +1,77 @@
+/*
+   Copyright 2021 The Kubermatic Kubernetes Platform contributors.
+
+   Licensed under the Apache License, Version 2.0 (the "License");
+   you may not use this file except in compliance with the License.
+   You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+   Unless required by applicable law or agreed to in writing, software
+   distributed under the License is distributed on an "AS IS" BASIS,
+   WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+   See the License for the specific language governing permissions and
+   limitations under the License.
+*/
+
+package registry
+
+import (
+	"context"
+
+	api "github.com/kubermatic-labs/registryman/pkg/apis/registryman/v1alpha1"
+	"github.com/kubermatic-labs/registryman/pkg/globalregistry"
+)
+
+// userGroup is a user group which is needed by a Group member of a project.
+type userGroup struct {
+	name      string
+	groupType api.GroupType
+	dn        string
+}
+
+var _ globalregistry.UserGroup = &userGroup{}
+
+func (ug *userGroup) GetName() string {
+	return ug.name
+}
+
+func (ug *userGroup) GetGroupType() string {
+	return string(ug.groupType)
+}
+
+func (ug *userGroup) GetDN() string {
+	return ug.dn
+}
+
+var _ globalregistry.RegistryWithUserGroups = &Registry{}
+
+// ListUserGroups method returns the user groups which are needed by the Group
+// members of the projects provisioned in the registry. Each group is listed
+// once, even if it is a member of several projects.
+func (reg *Registry) ListUserGroups(ctx context.Context) ([]globalregistry.UserGroup, error) {
+	projects, err := reg.ListProjects(ctx)
+	if err != nil {
+		return nil, err
+	}
+	userGroups := []globalregistry.UserGroup{}
+	found := map[userGroup]bool{}
+	for _, proj := range projects {
+		for _, member := range proj.(*project).members(ctx) {
+			if member.Type != api.GroupMemberType {
+				continue
+			}
+			ug := userGroup{
+				name:      member.Name,
+				groupType: groupTypeOf(member),
+				dn:        member.DN,
+			}
+			if found[ug] {
+				continue
+			}
+			found[ug] = true
+			userGroups = append(userGroups, &ug)
+		}
+	}
+	return userGroups, nil
+}
@@ -0,0 +1,79 @@
+/*
+   Copyright 2021 The Kubermatic Kubernetes Platform contributors.
+
+   Licensed under the Apache License, Version 2.0 (the "License");
+   you may not use this file except in compliance with the License.
+   You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+   Unless required by applicable law or agreed to in writing, software
+   distributed under the License is distributed on an "AS IS" BASIS,
+   WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+   See the License for the specific language governing permissions and
+   limitations under the License.
+*/
+
+package config
+
+import (
+	"fmt"
+	"strings"
+
+	api "github.com/kubermatic-labs/registryman/pkg/apis/registryman/v1alpha1"
+	"github.com/kubermatic-labs/registryman/pkg/globalregistry"
+	corev1 "k8s.io/api/core/v1"
+)
+
+// secretKeyValue returns the value of the key of the Secret. Besides the data
+// field, the stringData field is also checked, because the Secret manifests
+// parsed from the local files are not converted by an API server.
+func secretKeyValue(secret *corev1.Secret, key string) (string, error) {
+	if value, found := secret.Data[key]; found {
+		return string(value), nil
+	}
+	if value, found := secret.StringData[key]; found {
+		return value, nil
+	}
+	return "", fmt.Errorf("secret %s has no key %s", secret.GetName(), key)
+}
+
+// secretEnvName returns the name of the environment variable which can hold
+// the value of the Secret key selected by ref.
+func secretEnvName(ref *api.SecretKeyRef) string {
+	normalize := func(r rune) rune {
+		switch {
+		case r >= 'a' && r <= 'z':
+			return r - 'a' + 'A'
+		case r >= 'A' && r <= 'Z', r >= '0' && r <= '9':
+			return r
+		default:
+			return '_'
+		}
+	}
+	return fmt.Sprintf("REGISTRYMAN_SECRET_%s_%s",
+		strings.Map(normalize, ref.Name),
+		strings.Map(normalize, ref.Key))
+}
+
+// secretTargetNamespaces returns the namespaces where the Secret is copied to.
+// The namespaces are listed in the TargetNamespacesAnnotation of the Secret.
+func secretTargetNamespaces(secret *corev1.Secret) []string {
+	return globalregistry.ParseTargetNamespaces(
+		secret.GetAnnotations()[globalregistry.TargetNamespacesAnnotation])
+}
+
+// secretCopy returns the copy of the Secret in the given namespace. The copy
+// does not list the target namespaces, only the original Secret does.
+func secretCopy(secret *corev1.Secret, namespace string) *corev1.Secret {
+	secretCopy := secret.DeepCopy()
+	secretCopy.SetNamespace(namespace)
+	annotations := make(map[string]string, len(secret.GetAnnotations()))
+	for k, v := range secret.GetAnnotations() {
+		if k != globalregistry.TargetNamespacesAnnotation {
+			annotations[k] = v
+		}
+	}
+	secretCopy.SetAnnotations(annotations)
+	return secretCopy
+}
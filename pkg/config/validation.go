@@ -20,6 +20,9 @@ import (
 	"context"
 
 	api "github.com/kubermatic-labs/registryman/pkg/apis/registryman/v1alpha1"
+	"github.com/kubermatic-labs/registryman/pkg/config/registry"
+	"github.com/kubermatic-labs/registryman/pkg/globalregistry"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
 // ValidateConsistency performs all validations that require the full context,
@@ -31,25 +34,106 @@ func ValidateConsistency(aos ApiObjectStore) error {
 	registries := aos.GetRegistries(ctx)
 	projects := aos.GetProjects(ctx)
 	scanners := aos.GetScanners(ctx)
+	robotAccounts := aos.GetRobotAccounts(ctx)
+	teams := aos.GetTeams(ctx)
+	projectClasses := aos.GetProjectClasses(ctx)
 
-	// Forcing maximum one Global registry
+	// Forcing maximum one default Global registry
 	err := checkGlobalRegistryCount(registries)
 	if err != nil {
 		return err
 	}
 
+	// Checking the hubs of the projects
+	err = checkHubsOfProjects(registries, projects)
+	if err != nil {
+		return err
+	}
+
 	// Checking Artifactory annotations
 	err = checkArtifactoryAnnotations(registries)
 	if err != nil {
 		return err
 	}
 
+	// Checking the Secret references
+	err = checkSecretReferences(aos, registries, scanners, projects)
+	if err != nil {
+		return err
+	}
+
 	// Checking local registry names in all local projects
 	err = checkLocalRegistryNamesInProjects(registries, projects)
 	if err != nil {
 		return err
 	}
 
+	// Checking the local registry selectors of the local projects
+	err = checkLocalRegistrySelectorsOfProjects(registries, projects)
+	if err != nil {
+		return err
+	}
+
+	// Checking the replication routes of the projects
+	err = checkReplicationOfProjects(registries, projects)
+	if err != nil {
+		return err
+	}
+
+	// Checking the replication triggers of the projects
+	err = checkReplicationTriggersOfProjects(projects)
+	if err != nil {
+		return err
+	}
+
+	// Checking the project classes referenced by the projects
+	err = checkProjectClassesOfProjects(projects, projectClasses)
+	if err != nil {
+		return err
+	}
+
+	// Checking project class name uniqueness
+	err = checkProjectClassNameUniqueness(projectClasses)
+	if err != nil {
+		return err
+	}
+
+	// Checking the teams referenced by the projects
+	err = checkTeamsOfProjects(projects, teams)
+	if err != nil {
+		return err
+	}
+
+	// Checking team name uniqueness
+	err = checkTeamNameUniqueness(teams)
+	if err != nil {
+		return err
+	}
+
+	// Checking the group members of the projects
+	err = checkGroupMembersOfProjects(projects, teams)
+	if err != nil {
+		return err
+	}
+
+	// Checking the role mappings of the registries
+	err = checkRoleMappingsOfRegistries(registries)
+	if err != nil {
+		return err
+	}
+
+	// Checking the roles of the project members
+	err = checkRolesOfProjectMembers(aos, registries, projects, teams)
+	if err != nil {
+		return err
+	}
+
+	// Checking that the retention policies can be managed
+	err = checkRetentionOfProjects(aos, registries, projects)
+	if err != nil {
+		return err
+	}
+
 	// Checking scanner names in all projects
 	err = checkScannerNamesInProjects(projects, scanners)
 	if err != nil {
@@ -73,36 +157,177 @@ func ValidateConsistency(aos ApiObjectStore) error {
 	if err != nil {
 		return err
 	}
+
+	// Checking the registries and projects referenced by the robot accounts
+	err = checkReferencesOfRobotAccounts(registries, projects, robotAccounts)
+	if err != nil {
+		return err
+	}
+
+	// Checking robot account name uniqueness
+	err = checkRobotAccountNameUniqueness(robotAccounts)
+	if err != nil {
+		return err
+	}
 	return nil
 }
 
-// checkGlobalRegistryCount checks that there is 1 or 0 registry configured with
-// the type GlobalHub.
+// ValidateLdapGroups performs the validations that require the registries to
+// be contacted: the DN of the LDAP group members shall be resolved by the
+// registries where their projects are provisioned. The registries which cannot
+// resolve LDAP groups are skipped, and so are the ones which cannot be reached.
+// If an LDAP group cannot be resolved, ErrValidationUnresolvableLdapGroup is
+// returned.
+func ValidateLdapGroups(ctx context.Context, aos ApiObjectStore) error {
+	logger.V(1).Info("ValidateLdapGroups invoked")
+	registries := aos.GetRegistries(ctx)
+	projects := aos.GetProjects(ctx)
+	teams := aos.GetTeams(ctx)
+	var err error
+	for _, reg := range registries {
+		realRegistry, realErr := registry.New(reg, aos).ToReal()
+		if realErr != nil {
+			return realErr
+		}
+		resolver, ok := realRegistry.(globalregistry.LdapGroupResolverRegistry)
+		if !ok {
+			continue
+		}
+		resolved := make(map[string]bool)
+	ProjectLoop:
+		for _, project := range projects {
+			if !registry.IsProjectProvisioned(project, reg, registries) {
+				continue
+			}
+			for _, member := range registry.MembersOfProject(project, teams) {
+				if member.Type != api.GroupMemberType || member.DN == "" {
+					continue
+				}
+				found, checked := resolved[member.DN]
+				if !checked {
+					var resolveErr error
+					found, resolveErr = resolver.ResolveLdapGroup(ctx, member.DN)
+					if resolveErr != nil {
+						logger.V(-1).Info("LDAP groups cannot be checked",
+							"registry_name", reg.Name,
+							"error", resolveErr.Error())
+						break ProjectLoop
+					}
+					resolved[member.DN] = found
+				}
+				if !found {
+					logger.V(-1).Info("LDAP group cannot be resolved",
+						"project_name", project.Name,
+						"registry_name", reg.Name,
+						"member_name", member.Name,
+						"dn", member.DN)
+					err = ErrValidationUnresolvableLdapGroup
+				}
+			}
+		}
+	}
+	return err
+}
+
+// checkGlobalRegistryCount checks that there is 1 or 0 registry of the type
+// GlobalHub annotated as the default hub.
 func checkGlobalRegistryCount(registries []*api.Registry) error {
-	globalRegistries := make([]string, 0)
-	for _, registry := range registries {
-		if registry.Spec.Role == "GlobalHub" {
-			globalRegistries = append(globalRegistries, registry.Name)
+	defaultHubs := make([]string, 0)
+	for _, reg := range registries {
+		if registry.IsDefaultHub(reg) {
+			defaultHubs = append(defaultHubs, reg.Name)
 		}
 	}
-	if len(globalRegistries) >= 2 {
-		for _, registry := range globalRegistries {
-			logger.V(-1).Info("Multiple Global Registries found",
-				"registry_name", registry)
+	if len(defaultHubs) >= 2 {
+		for _, reg := range defaultHubs {
+			logger.V(-1).Info("Multiple default Global Registries found",
+				"registry_name", reg)
 		}
 		return ErrValidationMultipleGlobalRegistries
 	}
 	return nil
 }
 
-// checkGlobalRegistryCount checks that there is 1 or 0 registry configured with
-// the type GlobalHub.
+// checkHubsOfProjects checks that the hub of each global project can be
+// determined and that the local projects do not select a hub. A global project
+// without hub is valid if there is no GlobalHub registry at all.
+func checkHubsOfProjects(registries []*api.Registry, projects []*api.Project) error {
+	var err error
+	for _, project := range projects {
+		switch project.Spec.Type {
+		case api.GlobalProjectType:
+			if registry.HubOfProject(project, registries) == nil &&
+				(project.Spec.Hub != "" || registry.HasGlobalHub(registries)) {
+				logger.V(-1).Info("Hub of global project cannot be determined",
+					"project_name", project.Name,
+					"hub", project.Spec.Hub)
+				err = ErrValidationInvalidHubInProject
+			}
+		case api.LocalProjectType:
+			if project.Spec.Hub != "" {
+				logger.V(-1).Info("Local project refers to a hub",
+					"project_name", project.Name,
+					"hub", project.Spec.Hub)
+				err = ErrValidationInvalidHubInProject
+			}
+		}
+	}
+	return err
+}
+
+// checkSecretReferences checks that the Secret keys referred by the registries,
+// the scanners and the webhooks of the projects can be resolved.
+func checkSecretReferences(aop registry.ApiObjectProvider, registries []*api.Registry, scanners []*api.Scanner, projects []*api.Project) error {
+	var err error
+	ctx := context.Background()
+	for _, reg := range registries {
+		if resolveErr := registry.New(reg, aop).ResolveCredentials(ctx); resolveErr != nil {
+			logger.V(-1).Info("Secret reference of registry cannot be resolved",
+				"registry_name", reg.Name,
+				"error", resolveErr.Error())
+			err = ErrValidationSecretReference
+		}
+	}
+	for _, scanner := range scanners {
+		ref := scanner.Spec.AccessCredentialSecretRef
+		if ref == nil {
+			continue
+		}
+		if _, resolveErr := aop.GetSecretValue(ctx, ref); resolveErr != nil {
+			logger.V(-1).Info("Secret reference of scanner cannot be resolved",
+				"scanner_name", scanner.Name,
+				"error", resolveErr.Error())
+			err = ErrValidationSecretReference
+		}
+	}
+	for _, project := range projects {
+		for _, webhook := range project.Spec.Webhooks {
+			ref := webhook.AuthHeaderSecretRef
+			if ref == nil {
+				continue
+			}
+			if _, resolveErr := aop.GetSecretValue(ctx, ref); resolveErr != nil {
+				logger.V(-1).Info("Secret reference of webhook cannot be resolved",
+					"project_name", project.Name,
+					"webhook_name", webhook.Name,
+					"error", resolveErr.Error())
+				err = ErrValidationSecretReference
+			}
+		}
+	}
+	return err
+}
+
+// checkArtifactoryAnnotations checks that the Artifactory registries are
+// configured either with the dockerRegistryName annotation or with an access
+// token. The access token can be set via the tokenSecretRef field or via the
+// deprecated accessToken annotation.
 func checkArtifactoryAnnotations(registries []*api.Registry) error {
 	var err error
 	for _, registry := range registries {
 		if registry.Spec.Provider == "artifactory" {
 			hasDockerRegistryNameAnnotation := false
-			hasAccesTokenAnnotation := false
+			hasAccesTokenAnnotation := registry.Spec.TokenSecretRef != nil
 			for annotation := range registry.Annotations {
 				if annotation == "registryman.kubermatic.com/dockerRegistryName" {
 					hasDockerRegistryNameAnnotation = true
@@ -169,6 +394,318 @@ func checkLocalRegistryNamesInProjects(registries []*api.Registry, projects []*a
 	return err
 }
 
+// checkLocalRegistrySelectorsOfProjects checks that the local registry
+// selectors of the projects are valid label selectors and that they are used
+// only by local projects. A selector matching no registry is not an error, as
+// the matching registries can be added later.
+func checkLocalRegistrySelectorsOfProjects(registries []*api.Registry, projects []*api.Project) error {
+	var err error
+	for _, project := range projects {
+		if project.Spec.LocalRegistrySelector == nil {
+			continue
+		}
+		if project.Spec.Type != api.LocalProjectType {
+			logger.V(-1).Info("Local registry selector is set for a non-local project",
+				"project_name", project.Name)
+			err = ErrValidationInvalidLocalRegistrySelector
+			continue
+		}
+		_, selErr := metav1.LabelSelectorAsSelector(project.Spec.LocalRegistrySelector)
+		if selErr != nil {
+			logger.V(-1).Info("Local registry selector is invalid",
+				"project_name", project.Name,
+				"error", selErr.Error())
+			err = ErrValidationInvalidLocalRegistrySelector
+			continue
+		}
+		if len(registry.LocalRegistriesOfProject(project, registries)) == 0 {
+			logger.Info("Local project is not provisioned in any registry",
+				"project_name", project.Name)
+		}
+	}
+	return err
+}
+
+// checkReplicationOfProjects checks that the replication routes of the projects
+// connect different registries where the project is provisioned and that the
+// excluded registries exist.
+func checkReplicationOfProjects(registries []*api.Registry, projects []*api.Project) error {
+	var err error
+	registriesByName := make(map[string]*api.Registry)
+	for _, reg := range registries {
+		registriesByName[reg.GetName()] = reg
+	}
+	isProvisionedIn := func(project *api.Project, regName string) bool {
+		reg, found := registriesByName[regName]
+		return found && registry.IsProjectProvisioned(project, reg, registries)
+	}
+	for _, project := range projects {
+		if project.Spec.Replication == nil {
+			continue
+		}
+		for _, route := range project.Spec.Replication.Routes {
+			if route.From == route.To ||
+				!isProvisionedIn(project, route.From) ||
+				!isProvisionedIn(project, route.To) {
+				logger.V(-1).Info("Project has invalid replication route",
+					"project_name", project.Name,
+					"from", route.From,
+					"to", route.To)
+				err = ErrValidationInvalidReplicationRoute
+			}
+		}
+		for _, regName := range project.Spec.Replication.Exclude {
+			if registriesByName[regName] == nil {
+				logger.V(-1).Info("Project excludes a non-existing registry from replication",
+					"project_name", project.Name,
+					"registry_name", regName)
+				err = ErrValidationInvalidReplicationRoute
+			}
+		}
+	}
+	return err
+}
+
+// checkReplicationTriggersOfProjects checks that the cron schedules of the
+// replication triggers are valid cron expressions.
+func checkReplicationTriggersOfProjects(projects []*api.Project) error {
+	var err error
+	for _, project := range projects {
+		if project.Spec.Trigger.Type != api.CronReplicationTriggerType {
+			continue
+		}
+		if _, cronErr := globalregistry.ParseCronSchedule(project.Spec.Trigger.Schedule); cronErr != nil {
+			logger.V(-1).Info("Project has invalid cron schedule",
+				"project_name", project.Name,
+				"schedule", project.Spec.Trigger.Schedule,
+				"error", cronErr.Error())
+			err = ErrValidationInvalidCronSchedule
+		}
+	}
+	return err
+}
+
+// checkProjectClassesOfProjects checks that the project classes referenced by
+// the projects exist.
+func checkProjectClassesOfProjects(projects []*api.Project, projectClasses []*api.ProjectClass) error {
+	var err error
+	projectClassNames := map[string]bool{}
+	for _, projectClass := range projectClasses {
+		projectClassNames[projectClass.GetName()] = true
+	}
+	for _, project := range projects {
+		projectClass := projectClassOf(project)
+		if projectClass != "" && !projectClassNames[projectClass] {
+			logger.V(-1).Info("Project refers to non-existing project class",
+				"project_name", project.Name,
+				"project_class_name", projectClass)
+			err = ErrValidationProjectClassReference
+		}
+	}
+	return err
+}
+
+// checkProjectClassNameUniqueness checks that there are no 2 project classes
+// with the same name.
+func checkProjectClassNameUniqueness(projectClasses []*api.ProjectClass) error {
+	var err error
+	projectClassNames := map[string]bool{}
+	for _, projectClass := range projectClasses {
+		projectClassName := projectClass.GetName()
+		if projectClassNames[projectClassName] {
+			logger.V(-1).Info("Multiple project classes configured with the same name",
+				"project_class_name", projectClassName,
+			)
+			err = ErrValidationProjectClassNameNotUnique
+		}
+		projectClassNames[projectClassName] = true
+	}
+	return err
+}
+
+// checkTeamsOfProjects checks that the teams referenced by the projects exist.
+func checkTeamsOfProjects(projects []*api.Project, teams []*api.Team) error {
+	var err error
+	teamNames := map[string]bool{}
+	for _, team := range teams {
+		teamNames[team.GetName()] = true
+	}
+	for _, project := range projects {
+		for _, teamName := range project.Spec.Teams {
+			if !teamNames[teamName] {
+				logger.V(-1).Info("Project refers to non-existing team",
+					"project_name", project.Name,
+					"team_name", teamName)
+				err = ErrValidationTeamReference
+			}
+		}
+	}
+	return err
+}
+
+// checkTeamNameUniqueness checks that there are no 2 teams with the same name.
+func checkTeamNameUniqueness(teams []*api.Team) error {
+	var err error
+	teamNames := map[string]bool{}
+	for _, team := range teams {
+		teamName := team.GetName()
+		if teamNames[teamName] {
+			logger.V(-1).Info("Multiple teams configured with the same name",
+				"team_name", teamName,
+			)
+			err = ErrValidationTeamNameNotUnique
+		}
+		teamNames[teamName] = true
+	}
+	return err
+}
+
+// checkGroupMembersOfProjects checks that the LDAP group members have DN and
+// the OIDC group members do not. The group type of the group members defaults
+// to LDAP. The members of the teams referenced by the projects are checked,
+// too.
+func checkGroupMembersOfProjects(projects []*api.Project, teams []*api.Team) error {
+	var err error
+	for _, project := range projects {
+		for _, member := range registry.MembersOfProject(project, teams) {
+			if member.Type != api.GroupMemberType {
+				continue
+			}
+			switch member.GroupType {
+			case "", api.LdapGroupType:
+				if member.DN == "" {
+					logger.V(-1).Info("LDAP group member has no DN",
+						"project_name", project.Name,
+						"member_name", member.Name)
+					err = ErrValidationGroupWithoutDN
+				}
+			case api.OidcGroupType:
+				if member.DN != "" {
+					logger.V(-1).Info("OIDC group member has DN",
+						"project_name", project.Name,
+						"member_name", member.Name,
+						"dn", member.DN)
+					err = ErrValidationOidcGroupWithDN
+				}
+			}
+		}
+	}
+	return err
+}
+
+// checkRoleMappingsOfRegistries checks that the role mappings of the
+// registries map each role of a member type once and to a non-empty provider
+// specific role.
+func checkRoleMappingsOfRegistries(registries []*api.Registry) error {
+	var err error
+	for _, registry := range registries {
+		mapped := make(map[string]bool)
+		for _, rm := range registry.Spec.RoleMapping {
+			key := rm.Type.String() + "/" + rm.Role.String()
+			if mapped[key] {
+				logger.V(-1).Info("Role is mapped multiple times",
+					"registry_name", registry.Name,
+					"member_type", rm.Type.String(),
+					"role", rm.Role.String())
+				err = ErrValidationInvalidRoleMapping
+			}
+			mapped[key] = true
+			if len(globalregistry.SplitProviderRole(rm.ProviderRole)) == 0 {
+				logger.V(-1).Info("Role is mapped to an empty provider role",
+					"registry_name", registry.Name,
+					"member_type", rm.Type.String(),
+					"role", rm.Role.String())
+				err = ErrValidationInvalidRoleMapping
+			}
+		}
+	}
+	return err
+}
+
+// checkRolesOfProjectMembers checks that the roles of the project members can
+// be expressed by the registries where the projects are provisioned. The role
+// tables declared by the registry providers are used for the check, the
+// registries of the providers without a role table are skipped. The members of
+// the teams referenced by the projects are checked, too.
+func checkRolesOfProjectMembers(aop registry.ApiObjectProvider, registries []*api.Registry, projects []*api.Project, teams []*api.Team) error {
+	var err error
+	for _, reg := range registries {
+		roleTable, tableErr := globalregistry.GetRoleTable(registry.New(reg, aop))
+		if tableErr != nil {
+			return tableErr
+		}
+		if len(roleTable) == 0 {
+			continue
+		}
+		for _, project := range projects {
+			if !registry.IsProjectProvisioned(project, reg, registries) {
+				continue
+			}
+			for _, member := range registry.MembersOfProject(project, teams) {
+				_, found := roleTable.ProviderRole(member.Type.String(), member.Role.String())
+				if !found {
+					logger.V(-1).Info("Project member role is not supported by the registry",
+						"project_name", project.Name,
+						"registry_name", reg.Name,
+						"member_name", member.Name,
+						"member_type", member.Type.String(),
+						"role", member.Role.String())
+					err = ErrValidationUnsupportedRole
+				}
+			}
+		}
+	}
+	return err
+}
+
+// checkRetentionOfProjects checks that the projects with a tag retention policy
+// are provisioned only in registries whose provider can manage the retention
+// rules. Otherwise the policy would be silently ignored.
+func checkRetentionOfProjects(aop registry.ApiObjectProvider, registries []*api.Registry, projects []*api.Project) error {
+	var err error
+	for _, reg := range registries {
+		canManipulateRetention, capErr := canManipulateRetention(aop, reg)
+		if capErr != nil {
+			return capErr
+		}
+		if canManipulateRetention {
+			continue
+		}
+		for _, project := range projects {
+			if project.Spec.Retention == nil ||
+				!registry.IsProjectProvisioned(project, reg, registries) {
+				continue
+			}
+			logger.V(-1).Info("Tag retention is not supported by the registry",
+				"project_name", project.Name,
+				"registry_name", reg.Name,
+				"provider", reg.Spec.Provider)
+			err = ErrValidationUnsupportedRetention
+		}
+	}
+	return err
+}
+
+// canManipulateRetention returns true if the provider of the registry can
+// manage the tag retention rules of its projects. The capability is checked on
+// the dummy project of the provider, so no request is sent to the registry.
+func canManipulateRetention(aop registry.ApiObjectProvider, reg *api.Registry) (bool, error) {
+	realRegistry, err := registry.New(reg, aop).ToReal()
+	if err != nil {
+		return false, err
+	}
+	regWithProjects, ok := realRegistry.(globalregistry.RegistryWithProjects)
+	if !ok {
+		return false, nil
+	}
+	dummyProject, err := regWithProjects.GetProjectByName(context.Background(), "")
+	if err != nil {
+		return false, err
+	}
+	_, ok = dummyProject.(globalregistry.RetentionManipulatorProject)
+	return ok, nil
+}
+
 // checkScannerNamesInProjects checks that the scanners referenced by the
 // projects exist.
 func checkScannerNamesInProjects(projects []*api.Project, scanners []*api.Scanner) error {
@@ -244,3 +781,56 @@ func checkRegistryNameUniqueness(registries []*api.Registry) error {
 	}
 	return err
 }
+
+// checkReferencesOfRobotAccounts checks that the registries and the projects
+// referenced by the robot accounts exist. The "*" project name refers to all
+// projects of the registry.
+func checkReferencesOfRobotAccounts(registries []*api.Registry, projects []*api.Project, robotAccounts []*api.RobotAccount) error {
+	var err error
+	registryNames := map[string]bool{}
+	for _, registry := range registries {
+		registryNames[registry.GetName()] = true
+	}
+	projectNames := map[string]bool{}
+	for _, project := range projects {
+		projectNames[project.GetName()] = true
+	}
+	for _, robotAccount := range robotAccounts {
+		if !registryNames[robotAccount.Spec.Registry] {
+			logger.V(-1).Info("RobotAccount refers to non-existing registry",
+				"robot_account_name", robotAccount.GetName(),
+				"registry_name", robotAccount.Spec.Registry,
+			)
+			err = ErrValidationRobotAccountRegistryReference
+		}
+		for _, project := range robotAccount.Spec.Projects {
+			if project.Name != "*" && !projectNames[project.Name] {
+				logger.V(-1).Info("RobotAccount refers to non-existing project",
+					"robot_account_name", robotAccount.GetName(),
+					"project_name", project.Name,
+				)
+				err = ErrValidationRobotAccountProjectReference
+			}
+		}
+	}
+	return err
+}
+
+// checkRobotAccountNameUniqueness checks that there are no 2 robot accounts
+// with the same name in a registry.
+func checkRobotAccountNameUniqueness(robotAccounts []*api.RobotAccount) error {
+	var err error
+	robotAccountNames := map[string]bool{}
+	for _, robotAccount := range robotAccounts {
+		key := robotAccount.Spec.Registry + "/" + robotAccount.GetName()
+		if robotAccountNames[key] {
+			logger.V(-1).Info("Multiple robot accounts configured with the same name",
+				"robot_account_name", robotAccount.GetName(),
+				"registry_name", robotAccount.Spec.Registry,
+			)
+			err = ErrValidationRobotAccountNameNotUnique
+		}
+		robotAccountNames[key] = true
+	}
+	return err
+}
@@ -17,7 +17,12 @@
 package config_test
 
 import (
+	"context"
 	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
 
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
@@ -26,16 +31,56 @@ import (
 )
 
 var _ = Describe("Validation", func() {
-	Context("when getting multiple global registries", func() {
+	Context("when getting multiple global registries without a default hub", func() {
 		It("should error", func() {
 			testDir := fmt.Sprintf("%s/test_multiple_global_registries", testdataDir)
 			manifests, err := config.ReadLocalManifests(testDir, nil)
 			Expect(manifests).NotTo(BeNil())
 			Expect(err).To(Succeed())
 			err = config.ValidateConsistency(manifests)
+			Expect(err).Should(MatchError(config.ErrValidationInvalidHubInProject))
+		})
+	})
+	Context("when getting multiple default global registries", func() {
+		It("should error", func() {
+			testDir := fmt.Sprintf("%s/test_multiple_global_registries/multiple_default_hubs", testdataDir)
+			manifests, err := config.ReadLocalManifests(testDir, nil)
+			Expect(manifests).NotTo(BeNil())
+			Expect(err).To(Succeed())
+			err = config.ValidateConsistency(manifests)
 			Expect(err).Should(MatchError(config.ErrValidationMultipleGlobalRegistries))
 		})
 	})
+	Context("when the global projects select their hubs", func() {
+		It("should not fail", func() {
+			testDir := fmt.Sprintf("%s/test_multiple_global_registries/hub_selection", testdataDir)
+			manifests, err := config.ReadLocalManifests(testDir, nil)
+			Expect(manifests).NotTo(BeNil())
+			Expect(err).To(Succeed())
+			err = config.ValidateConsistency(manifests)
+			Expect(err).Should(BeNil())
+		})
+	})
+	Context("when there is no global registry", func() {
+		It("should not fail", func() {
+			testDir := fmt.Sprintf("%s/test_multiple_global_registries/no_hub", testdataDir)
+			manifests, err := config.ReadLocalManifests(testDir, nil)
+			Expect(manifests).NotTo(BeNil())
+			Expect(err).To(Succeed())
+			err = config.ValidateConsistency(manifests)
+			Expect(err).Should(BeNil())
+		})
+	})
+	Context("when a global project selects a non-hub registry", func() {
+		It("should error", func() {
+			testDir := fmt.Sprintf("%s/test_multiple_global_registries/invalid_hub", testdataDir)
+			manifests, err := config.ReadLocalManifests(testDir, nil)
+			Expect(manifests).NotTo(BeNil())
+			Expect(err).To(Succeed())
+			err = config.ValidateConsistency(manifests)
+			Expect(err).Should(MatchError(config.ErrValidationInvalidHubInProject))
+		})
+	})
 	Context("when an artifactory registry has conflicting annotations", func() {
 		It("should error", func() {
 			testDir := fmt.Sprintf("%s/test_artifactory_annotations/annotation_conflict", testdataDir)
@@ -66,6 +111,296 @@ var _ = Describe("Validation", func() {
 			Expect(err).Should(BeNil())
 		})
 	})
+	Context("when an artifactory registry refers to a token secret", func() {
+		It("should not fail", func() {
+			testDir := fmt.Sprintf("%s/test_artifactory_annotations/token_secret_ref", testdataDir)
+			manifests, err := config.ReadLocalManifests(testDir, nil)
+			Expect(manifests).NotTo(BeNil())
+			Expect(err).To(Succeed())
+			err = config.ValidateConsistency(manifests)
+			Expect(err).Should(BeNil())
+		})
+	})
+	Context("when the projects have valid replication routes", func() {
+		It("should not fail", func() {
+			testDir := fmt.Sprintf("%s/test_replication_routes", testdataDir)
+			manifests, err := config.ReadLocalManifests(testDir, nil)
+			Expect(manifests).NotTo(BeNil())
+			Expect(err).To(Succeed())
+			err = config.ValidateConsistency(manifests)
+			Expect(err).Should(BeNil())
+		})
+	})
+	Context("when a replication route refers to a registry without the project", func() {
+		It("should error", func() {
+			testDir := fmt.Sprintf("%s/test_replication_routes/invalid_route", testdataDir)
+			manifests, err := config.ReadLocalManifests(testDir, nil)
+			Expect(manifests).NotTo(BeNil())
+			Expect(err).To(Succeed())
+			err = config.ValidateConsistency(manifests)
+			Expect(err).Should(MatchError(config.ErrValidationInvalidReplicationRoute))
+		})
+	})
+	Context("when the projects have valid cron schedules", func() {
+		It("should not fail", func() {
+			testDir := fmt.Sprintf("%s/test_cron_schedule", testdataDir)
+			manifests, err := config.ReadLocalManifests(testDir, nil)
+			Expect(manifests).NotTo(BeNil())
+			Expect(err).To(Succeed())
+			err = config.ValidateConsistency(manifests)
+			Expect(err).Should(BeNil())
+		})
+	})
+	Context("when a project has invalid cron schedule", func() {
+		It("should error", func() {
+			testDir := fmt.Sprintf("%s/test_cron_schedule/invalid_schedule", testdataDir)
+			manifests, err := config.ReadLocalManifests(testDir, nil)
+			Expect(manifests).NotTo(BeNil())
+			Expect(err).To(Succeed())
+			err = config.ValidateConsistency(manifests)
+			Expect(err).Should(MatchError(config.ErrValidationInvalidCronSchedule))
+		})
+	})
+	Context("when an LDAP group member has no DN", func() {
+		It("should error", func() {
+			testDir := fmt.Sprintf("%s/test_groupmember_has_dn", testdataDir)
+			manifests, err := config.ReadLocalManifests(testDir, nil)
+			Expect(manifests).NotTo(BeNil())
+			Expect(err).To(Succeed())
+			err = config.ValidateConsistency(manifests)
+			Expect(err).Should(MatchError(config.ErrValidationGroupWithoutDN))
+		})
+	})
+	Context("when the group members have valid group types", func() {
+		It("should not fail", func() {
+			testDir := fmt.Sprintf("%s/test_groupmember_has_dn/group_types", testdataDir)
+			manifests, err := config.ReadLocalManifests(testDir, nil)
+			Expect(manifests).NotTo(BeNil())
+			Expect(err).To(Succeed())
+			err = config.ValidateConsistency(manifests)
+			Expect(err).Should(BeNil())
+		})
+	})
+	Context("when an OIDC group member has DN", func() {
+		It("should error", func() {
+			testDir := fmt.Sprintf("%s/test_groupmember_has_dn/oidc_group_with_dn", testdataDir)
+			manifests, err := config.ReadLocalManifests(testDir, nil)
+			Expect(manifests).NotTo(BeNil())
+			Expect(err).To(Succeed())
+			err = config.ValidateConsistency(manifests)
+			Expect(err).Should(MatchError(config.ErrValidationOidcGroupWithDN))
+		})
+	})
+	Context("when the member roles are supported by the registries", func() {
+		It("should not error", func() {
+			testDir := fmt.Sprintf("%s/test_member_roles", testdataDir)
+			manifests, err := config.ReadLocalManifests(testDir, nil)
+			Expect(manifests).NotTo(BeNil())
+			Expect(err).To(Succeed())
+			err = config.ValidateConsistency(manifests)
+			Expect(err).Should(BeNil())
+		})
+	})
+	Context("when a member role is not supported by a registry", func() {
+		It("should error", func() {
+			testDir := fmt.Sprintf("%s/test_member_roles/unsupported_role", testdataDir)
+			manifests, err := config.ReadLocalManifests(testDir, nil)
+			Expect(manifests).NotTo(BeNil())
+			Expect(err).To(Succeed())
+			err = config.ValidateConsistency(manifests)
+			Expect(err).Should(MatchError(config.ErrValidationUnsupportedRole))
+		})
+	})
+	Context("when a member role is supported via role mapping", func() {
+		It("should not error", func() {
+			testDir := fmt.Sprintf("%s/test_member_roles/role_mapping", testdataDir)
+			manifests, err := config.ReadLocalManifests(testDir, nil)
+			Expect(manifests).NotTo(BeNil())
+			Expect(err).To(Succeed())
+			err = config.ValidateConsistency(manifests)
+			Expect(err).Should(BeNil())
+		})
+	})
+	Context("when a role is mapped multiple times", func() {
+		It("should error", func() {
+			testDir := fmt.Sprintf("%s/test_member_roles/invalid_role_mapping", testdataDir)
+			manifests, err := config.ReadLocalManifests(testDir, nil)
+			Expect(manifests).NotTo(BeNil())
+			Expect(err).To(Succeed())
+			err = config.ValidateConsistency(manifests)
+			Expect(err).Should(MatchError(config.ErrValidationInvalidRoleMapping))
+		})
+	})
+	Context("when the teams are valid", func() {
+		It("should not error", func() {
+			testDir := fmt.Sprintf("%s/test_teams", testdataDir)
+			manifests, err := config.ReadLocalManifests(testDir, nil)
+			Expect(manifests).NotTo(BeNil())
+			Expect(err).To(Succeed())
+			err = config.ValidateConsistency(manifests)
+			Expect(err).Should(BeNil())
+		})
+	})
+	Context("when a project refers to a non-existing team", func() {
+		It("should error", func() {
+			testDir := fmt.Sprintf("%s/test_teams/invalid_reference", testdataDir)
+			manifests, err := config.ReadLocalManifests(testDir, nil)
+			Expect(manifests).NotTo(BeNil())
+			Expect(err).To(Succeed())
+			err = config.ValidateConsistency(manifests)
+			Expect(err).Should(MatchError(config.ErrValidationTeamReference))
+		})
+	})
+	Context("when multiple teams have the same name", func() {
+		It("should error", func() {
+			testDir := fmt.Sprintf("%s/test_teams/name_not_unique", testdataDir)
+			manifests, err := config.ReadLocalManifests(testDir, nil)
+			Expect(manifests).NotTo(BeNil())
+			Expect(err).To(Succeed())
+			err = config.ValidateConsistency(manifests)
+			Expect(err).Should(MatchError(config.ErrValidationTeamNameNotUnique))
+		})
+	})
+	Context("when a team member role is not supported by a registry", func() {
+		It("should error", func() {
+			testDir := fmt.Sprintf("%s/test_teams/unsupported_role", testdataDir)
+			manifests, err := config.ReadLocalManifests(testDir, nil)
+			Expect(manifests).NotTo(BeNil())
+			Expect(err).To(Succeed())
+			err = config.ValidateConsistency(manifests)
+			Expect(err).Should(MatchError(config.ErrValidationUnsupportedRole))
+		})
+	})
+	Context("when the project classes are valid", func() {
+		It("should not error", func() {
+			testDir := fmt.Sprintf("%s/test_project_classes", testdataDir)
+			manifests, err := config.ReadLocalManifests(testDir, nil)
+			Expect(manifests).NotTo(BeNil())
+			Expect(err).To(Succeed())
+			err = config.ValidateConsistency(manifests)
+			Expect(err).Should(BeNil())
+		})
+	})
+	Context("when a project refers to a non-existing project class", func() {
+		It("should error", func() {
+			testDir := fmt.Sprintf("%s/test_project_classes/invalid_reference", testdataDir)
+			manifests, err := config.ReadLocalManifests(testDir, nil)
+			Expect(manifests).NotTo(BeNil())
+			Expect(err).To(Succeed())
+			err = config.ValidateConsistency(manifests)
+			Expect(err).Should(MatchError(config.ErrValidationProjectClassReference))
+		})
+	})
+	Context("when a project selects a non-existing project class by label", func() {
+		It("should error", func() {
+			testDir := fmt.Sprintf("%s/test_project_classes/invalid_label_reference", testdataDir)
+			manifests, err := config.ReadLocalManifests(testDir, nil)
+			Expect(manifests).NotTo(BeNil())
+			Expect(err).To(Succeed())
+			err = config.ValidateConsistency(manifests)
+			Expect(err).Should(MatchError(config.ErrValidationProjectClassReference))
+		})
+	})
+	Context("when multiple project classes have the same name", func() {
+		It("should error", func() {
+			testDir := fmt.Sprintf("%s/test_project_classes/name_not_unique", testdataDir)
+			manifests, err := config.ReadLocalManifests(testDir, nil)
+			Expect(manifests).NotTo(BeNil())
+			Expect(err).To(Succeed())
+			err = config.ValidateConsistency(manifests)
+			Expect(err).Should(MatchError(config.ErrValidationProjectClassNameNotUnique))
+		})
+	})
+	Context("when a project class refers to a non-existing scanner", func() {
+		It("should error", func() {
+			testDir := fmt.Sprintf("%s/test_project_classes/invalid_scanner", testdataDir)
+			manifests, err := config.ReadLocalManifests(testDir, nil)
+			Expect(manifests).NotTo(BeNil())
+			Expect(err).To(Succeed())
+			err = config.ValidateConsistency(manifests)
+			Expect(err).Should(MatchError(config.ErrValidationScannerNameReference))
+		})
+	})
+	Context("when the retention policies are supported by the registries", func() {
+		It("should not error", func() {
+			testDir := fmt.Sprintf("%s/test_retention", testdataDir)
+			manifests, err := config.ReadLocalManifests(testDir, nil)
+			Expect(manifests).NotTo(BeNil())
+			Expect(err).To(Succeed())
+			err = config.ValidateConsistency(manifests)
+			Expect(err).Should(BeNil())
+		})
+	})
+	Context("when a project with retention policy is provisioned in a registry without retention support", func() {
+		It("should error", func() {
+			testDir := fmt.Sprintf("%s/test_retention/unsupported_provider", testdataDir)
+			manifests, err := config.ReadLocalManifests(testDir, nil)
+			Expect(manifests).NotTo(BeNil())
+			Expect(err).To(Succeed())
+			err = config.ValidateConsistency(manifests)
+			Expect(err).Should(MatchError(config.ErrValidationUnsupportedRetention))
+		})
+	})
+	Context("when the referred secrets can be resolved", func() {
+		It("should not error", func() {
+			testDir := fmt.Sprintf("%s/test_secret_references", testdataDir)
+			manifests, err := config.ReadLocalManifests(testDir, nil)
+			Expect(manifests).NotTo(BeNil())
+			Expect(err).To(Succeed())
+			err = config.ValidateConsistency(manifests)
+			Expect(err).Should(BeNil())
+		})
+	})
+	Context("when a scanner refers to a missing secret key", func() {
+		It("should error", func() {
+			testDir := fmt.Sprintf("%s/test_secret_references/missing_secret", testdataDir)
+			manifests, err := config.ReadLocalManifests(testDir, nil)
+			Expect(manifests).NotTo(BeNil())
+			Expect(err).To(Succeed())
+			err = config.ValidateConsistency(manifests)
+			Expect(err).Should(MatchError(config.ErrValidationSecretReference))
+		})
+	})
+	Context("when the robot accounts are valid", func() {
+		It("should not error", func() {
+			testDir := fmt.Sprintf("%s/test_robot_accounts", testdataDir)
+			manifests, err := config.ReadLocalManifests(testDir, nil)
+			Expect(manifests).NotTo(BeNil())
+			Expect(err).To(Succeed())
+			err = config.ValidateConsistency(manifests)
+			Expect(err).Should(BeNil())
+		})
+	})
+	Context("when a robot account refers to a non-existing registry", func() {
+		It("should error", func() {
+			testDir := fmt.Sprintf("%s/test_robot_accounts/invalid_registry", testdataDir)
+			manifests, err := config.ReadLocalManifests(testDir, nil)
+			Expect(manifests).NotTo(BeNil())
+			Expect(err).To(Succeed())
+			err = config.ValidateConsistency(manifests)
+			Expect(err).Should(MatchError(config.ErrValidationRobotAccountRegistryReference))
+		})
+	})
+	Context("when a robot account refers to a non-existing project", func() {
+		It("should error", func() {
+			testDir := fmt.Sprintf("%s/test_robot_accounts/invalid_project", testdataDir)
+			manifests, err := config.ReadLocalManifests(testDir, nil)
+			Expect(manifests).NotTo(BeNil())
+			Expect(err).To(Succeed())
+			err = config.ValidateConsistency(manifests)
+			Expect(err).Should(MatchError(config.ErrValidationRobotAccountProjectReference))
+		})
+	})
+	Context("when there are multiple robot accounts with the same name", func() {
+		It("should error", func() {
+			testDir := fmt.Sprintf("%s/test_robot_accounts/name_not_unique", testdataDir)
+			manifests, err := config.ReadLocalManifests(testDir, nil)
+			Expect(manifests).NotTo(BeNil())
+			Expect(err).To(Succeed())
+			err = config.ValidateConsistency(manifests)
+			Expect(err).Should(MatchError(config.ErrValidationRobotAccountNameNotUnique))
+		})
+	})
 	Context("when a project has invalid local registries", func() {
 		It("should error", func() {
 			testDir := fmt.Sprintf("%s/test_invalid_local_projects", testdataDir)
@@ -76,6 +411,36 @@ var _ = Describe("Validation", func() {
 			Expect(err).Should(MatchError(config.ErrValidationInvalidLocalRegistryInProject))
 		})
 	})
+	Context("when a local project selects its registries by labels", func() {
+		It("should not error", func() {
+			testDir := fmt.Sprintf("%s/test_local_registry_selector", testdataDir)
+			manifests, err := config.ReadLocalManifests(testDir, nil)
+			Expect(manifests).NotTo(BeNil())
+			Expect(err).To(Succeed())
+			err = config.ValidateConsistency(manifests)
+			Expect(err).Should(BeNil())
+		})
+	})
+	Context("when the local registry selector is invalid", func() {
+		It("should error", func() {
+			testDir := fmt.Sprintf("%s/test_local_registry_selector/invalid_selector", testdataDir)
+			manifests, err := config.ReadLocalManifests(testDir, nil)
+			Expect(manifests).NotTo(BeNil())
+			Expect(err).To(Succeed())
+			err = config.ValidateConsistency(manifests)
+			Expect(err).Should(MatchError(config.ErrValidationInvalidLocalRegistrySelector))
+		})
+	})
+	Context("when a global project has a local registry selector", func() {
+		It("should error", func() {
+			testDir := fmt.Sprintf("%s/test_local_registry_selector/global_project", testdataDir)
+			manifests, err := config.ReadLocalManifests(testDir, nil)
+			Expect(manifests).NotTo(BeNil())
+			Expect(err).To(Succeed())
+			err = config.ValidateConsistency(manifests)
+			Expect(err).Should(MatchError(config.ErrValidationInvalidLocalRegistrySelector))
+		})
+	})
 	Context("when there are multiple scanners with the same name", func() {
 		It("should error", func() {
 			testDir := fmt.Sprintf("%s/test_scannername_unique", testdataDir)
@@ -117,3 +482,75 @@ var _ = Describe("Validation", func() {
 		})
 	})
 })
+
+var _ = Describe("LDAP group validation", func() {
+	var (
+		server    *httptest.Server
+		configDir string
+	)
+	BeforeEach(func() {
+		server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if r.URL.Path != "/api/v2.0/ldap/groups/search" {
+				w.WriteHeader(http.StatusNotFound)
+				return
+			}
+			switch r.URL.Query().Get("groupdn") {
+			case "cn=developers,ou=groups,dc=example,dc=com":
+				fmt.Fprint(w, `[{"group_name":"developers","ldap_group_dn":"cn=developers,ou=groups,dc=example,dc=com"}]`)
+			default:
+				w.WriteHeader(http.StatusNotFound)
+			}
+		}))
+		var err error
+		configDir, err = os.MkdirTemp("", "ldap-validation")
+		Expect(err).ToNot(HaveOccurred())
+		writeManifest(configDir, "registry.yaml", fmt.Sprintf(`apiVersion: registryman.kubermatic.com/v1alpha1
+kind: Registry
+metadata:
+  name: registry
+spec:
+  role: GlobalHub
+  provider: harbor
+  apiEndpoint: %s
+  username: admin
+  password: adminpassword
+`, server.URL))
+	})
+	AfterEach(func() {
+		server.Close()
+		Expect(os.RemoveAll(configDir)).To(Succeed())
+	})
+	projectWithGroup := func(dn string) string {
+		return fmt.Sprintf(`apiVersion: registryman.kubermatic.com/v1alpha1
+kind: Project
+metadata:
+  name: project
+spec:
+  type: Global
+  members:
+  - name: developers
+    type: Group
+    role: Developer
+    dn: %s
+`, dn)
+	}
+
+	It("accepts the resolvable LDAP groups", func() {
+		writeManifest(configDir, "project.yaml", projectWithGroup("cn=developers,ou=groups,dc=example,dc=com"))
+		manifests, err := config.ReadLocalManifests(configDir, nil)
+		Expect(err).To(Succeed())
+		Expect(config.ValidateLdapGroups(context.Background(), manifests)).To(Succeed())
+	})
+
+	It("reports the LDAP groups which cannot be resolved", func() {
+		writeManifest(configDir, "project.yaml", projectWithGroup("cn=missing,ou=groups,dc=example,dc=com"))
+		manifests, err := config.ReadLocalManifests(configDir, nil)
+		Expect(err).To(Succeed())
+		err = config.ValidateLdapGroups(context.Background(), manifests)
+		Expect(err).Should(MatchError(config.ErrValidationUnresolvableLdapGroup))
+	})
+})
+
+func writeManifest(dir, name, manifest string) {
+	ExpectWithOffset(1, os.WriteFile(filepath.Join(dir, name), []byte(manifest), 0600)).To(Succeed())
+}
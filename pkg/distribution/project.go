@@ -0,0 +1,87 @@
+/*
+   Copyright 2021 The Kubermatic Kubernetes Platform contributors.
+
+   Licensed under the Apache License, Version 2.0 (the "License");
+   you may not use this file except in compliance with the License.
+   You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+   Unless required by applicable law or agreed to in writing, software
+   distributed under the License is distributed on an "AS IS" BASIS,
+   WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+   See the License for the specific language governing permissions and
+   limitations under the License.
+*/
+
+package distribution
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/kubermatic-labs/registryman/pkg/globalregistry"
+)
+
+// project is a namespace of the registry, i.e. the first path component of the
+// repository names.
+type project struct {
+	name     string
+	registry *registry
+}
+
+// interface guard
+var _ globalregistry.Project = &project{}
+var _ globalregistry.ProjectWithRepositories = &project{}
+var _ globalregistry.DestructibleProject = &project{}
+
+func (p *project) GetName() string {
+	return p.name
+}
+
+// GetRepositories returns the names of the repositories of the project without
+// the project prefix.
+func (p *project) GetRepositories(ctx context.Context) ([]string, error) {
+	repoNames, err := p.registry.getRepositories(ctx)
+	if err != nil {
+		return nil, err
+	}
+	prefix := p.name + "/"
+	reposOfProject := []string{}
+	for _, repoName := range repoNames {
+		if strings.HasPrefix(repoName, prefix) {
+			reposOfProject = append(reposOfProject, strings.TrimPrefix(repoName, prefix))
+		}
+	}
+	return reposOfProject, nil
+}
+
+// Delete implements the globalregistry.DestructibleProject interface. As the
+// project exists only while it has repositories, the manifests of the
+// repositories are deleted. It is allowed only when the force delete option is
+// set.
+func (p *project) Delete(ctx context.Context) error {
+	repos, err := p.GetRepositories(ctx)
+	if err != nil {
+		return err
+	}
+	if len(repos) == 0 {
+		return nil
+	}
+	switch opt := p.registry.GetOptions().(type) {
+	case globalregistry.CanForceDelete:
+		if !opt.ForceDeleteProjects() {
+			return fmt.Errorf("%s: repositories are present, please delete them before deleting the project, %w", p.name, globalregistry.ErrRecoverableError)
+		}
+		for _, repo := range repos {
+			err = p.registry.deleteRepository(ctx, fmt.Sprintf("%s/%s", p.name, repo))
+			if err != nil {
+				return err
+			}
+		}
+	default:
+		return globalregistry.ErrNotImplemented
+	}
+	return nil
+}
@@ -0,0 +1,265 @@
+/*
+   Copyright 2021 The Kubermatic Kubernetes Platform contributors.
+
+   Licensed under the Apache License, Version 2.0 (the "License");
+   you may not use this file except in compliance with the License.
+   You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+   Unless required by applicable law or agreed to in writing, software
+   distributed under the License is distributed on an "AS IS" BASIS,
+   WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+   See the License for the specific language governing permissions and
+   limitations under the License.
+*/
+
+package distribution
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/kubermatic-labs/registryman/pkg/globalregistry"
+)
+
+const catalogPath = "/v2/_catalog"
+
+// manifestMediaTypes are accepted when the digest of a manifest is resolved.
+var manifestMediaTypes = []string{
+	"application/vnd.oci.image.index.v1+json",
+	"application/vnd.oci.image.manifest.v1+json",
+	"application/vnd.docker.distribution.manifest.list.v2+json",
+	"application/vnd.docker.distribution.manifest.v2+json",
+}
+
+type catalog struct {
+	Repositories []string `json:"repositories"`
+}
+
+type tagList struct {
+	Name string   `json:"name"`
+	Tags []string `json:"tags"`
+}
+
+// nextLink returns the URL of the next page of a paginated response based on
+// the Link header. If there is no next page, nil is returned.
+func (r *registry) nextLink(resp *http.Response) (*url.URL, error) {
+	link := resp.Header.Get("Link")
+	if link == "" || !strings.Contains(link, `rel="next"`) {
+		return nil, nil
+	}
+	start := strings.Index(link, "<")
+	end := strings.Index(link, ">")
+	if start < 0 || end < start {
+		return nil, fmt.Errorf("invalid Link header: %s", link)
+	}
+	next, err := url.Parse(link[start+1 : end])
+	if err != nil {
+		return nil, err
+	}
+	return r.parsedUrl.ResolveReference(next), nil
+}
+
+// getCatalog returns the names of all repositories of the registry.
+func (r *registry) getCatalog(ctx context.Context) ([]string, error) {
+	r.logger.V(1).Info("fetching catalog",
+		"registry", r.GetName(),
+	)
+	url := *r.parsedUrl
+	url.Path = catalogPath
+	next := &url
+	repoNames := []string{}
+	for next != nil {
+		req, err := http.NewRequest(http.MethodGet, next.String(), nil)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := r.do(ctx, req)
+		if err != nil {
+			return nil, err
+		}
+		page := &catalog{}
+		err = json.NewDecoder(resp.Body).Decode(page)
+		if err != nil {
+			r.logger.Error(err, "json decoding failed")
+			return nil, err
+		}
+		repoNames = append(repoNames, page.Repositories...)
+		next, err = r.nextLink(resp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return repoNames, nil
+}
+
+// getTags returns the tags of a repository.
+func (r *registry) getTags(ctx context.Context, repoName string) ([]string, error) {
+	url := *r.parsedUrl
+	url.Path = fmt.Sprintf("/v2/%s/tags/list", repoName)
+	next := &url
+	tags := []string{}
+	for next != nil {
+		req, err := http.NewRequest(http.MethodGet, next.String(), nil)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := r.do(ctx, req)
+		switch err {
+		case nil:
+		case globalregistry.ErrInvalidStatusCode(404):
+			// the repository has no manifests
+			return tags, nil
+		default:
+			return nil, err
+		}
+		page := &tagList{}
+		err = json.NewDecoder(resp.Body).Decode(page)
+		if err != nil {
+			r.logger.Error(err, "json decoding failed")
+			return nil, err
+		}
+		tags = append(tags, page.Tags...)
+		next, err = r.nextLink(resp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return tags, nil
+}
+
+// getRepositories returns the names of the repositories that have at least one
+// tag. Repositories without tags are ignored, because the registries keep them
+// in the catalog even after all of their manifests are deleted.
+func (r *registry) getRepositories(ctx context.Context) ([]string, error) {
+	repoNames, err := r.getCatalog(ctx)
+	if err != nil {
+		return nil, err
+	}
+	result := []string{}
+	for _, repoName := range repoNames {
+		tags, err := r.getTags(ctx, repoName)
+		if err != nil {
+			return nil, err
+		}
+		if len(tags) > 0 {
+			result = append(result, repoName)
+		}
+	}
+	return result, nil
+}
+
+// getManifestDigest resolves the digest of the manifest that a tag refers to.
+func (r *registry) getManifestDigest(ctx context.Context, repoName, tag string) (string, error) {
+	url := *r.parsedUrl
+	url.Path = fmt.Sprintf("/v2/%s/manifests/%s", repoName, tag)
+	req, err := http.NewRequest(http.MethodHead, url.String(), nil)
+	if err != nil {
+		return "", err
+	}
+	req.Header.Set("Accept", strings.Join(manifestMediaTypes, ", "))
+	resp, err := r.do(ctx, req)
+	if err != nil {
+		return "", err
+	}
+	digest := resp.Header.Get("Docker-Content-Digest")
+	if digest == "" {
+		return "", fmt.Errorf("no digest returned for %s:%s", repoName, tag)
+	}
+	return digest, nil
+}
+
+// deleteRepository deletes all manifests of the repository. The registry must
+// allow the deletion, e.g. REGISTRY_STORAGE_DELETE_ENABLED shall be set for
+// distribution/registry.
+func (r *registry) deleteRepository(ctx context.Context, repoName string) error {
+	r.logger.V(1).Info("deleting repository",
+		"repositoryName", repoName,
+	)
+	tags, err := r.getTags(ctx, repoName)
+	if err != nil {
+		return err
+	}
+	digests := make(map[string]struct{})
+	for _, tag := range tags {
+		digest, err := r.getManifestDigest(ctx, repoName, tag)
+		if err != nil {
+			return err
+		}
+		digests[digest] = struct{}{}
+	}
+	for digest := range digests {
+		url := *r.parsedUrl
+		url.Path = fmt.Sprintf("/v2/%s/manifests/%s", repoName, digest)
+		req, err := http.NewRequest(http.MethodDelete, url.String(), nil)
+		if err != nil {
+			return err
+		}
+		_, err = r.do(ctx, req)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func projectNameFromRepoName(repoName string) string {
+	return strings.Split(repoName, "/")[0]
+}
+
+// collectProjectNamesFromRepos returns the namespaces of the repositories.
+// Repositories without namespace are not part of any project.
+func (r *registry) collectProjectNamesFromRepos(repoNames []string) []globalregistry.Project {
+	projectNames := make(map[string]struct{})
+	projects := []globalregistry.Project{}
+	for _, repoName := range repoNames {
+		if !strings.Contains(repoName, "/") {
+			continue
+		}
+		projectName := projectNameFromRepoName(repoName)
+		if _, found := projectNames[projectName]; found {
+			continue
+		}
+		projectNames[projectName] = struct{}{}
+		projects = append(projects, &project{
+			name:     projectName,
+			registry: r,
+		})
+	}
+	return projects
+}
+
+// ListProjects implements the globalregistry.RegistryWithProjects interface.
+func (r *registry) ListProjects(ctx context.Context) ([]globalregistry.Project, error) {
+	repoNames, err := r.getRepositories(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return r.collectProjectNamesFromRepos(repoNames), nil
+}
+
+// GetProjectByName implements the globalregistry.RegistryWithProjects
+// interface.
+func (r *registry) GetProjectByName(ctx context.Context, name string) (globalregistry.Project, error) {
+	if name == "" {
+		return &project{
+			name:     "",
+			registry: r,
+		}, nil
+	}
+	projects, err := r.ListProjects(ctx)
+	if err != nil {
+		return nil, err
+	}
+	for _, project := range projects {
+		if project.GetName() == name {
+			return project, nil
+		}
+	}
+	return nil, nil
+}
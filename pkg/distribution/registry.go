@@ -0,0 +1,133 @@
+/*
+   Copyright 2021 The Kubermatic Kubernetes Platform contributors.
+
+   Licensed under the Apache License, Version 2.0 (the "License");
+   you may not use this file except in compliance with the License.
+   You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+   Unless required by applicable law or agreed to in writing, software
+   distributed under the License is distributed on an "AS IS" BASIS,
+   WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+   See the License for the specific language governing permissions and
+   limitations under the License.
+*/
+
+// distribution package implements the globalregistry.Registry interface for
+// the registries that implement the OCI distribution specification only, like
+// distribution/registry (Docker Registry v2) or Zot.
+package distribution
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"net/http"
+	"net/url"
+
+	"github.com/go-logr/logr"
+	"github.com/kubermatic-labs/registryman/pkg/globalregistry"
+)
+
+func init() {
+	// during init the distribution provider is registered
+	globalregistry.RegisterProviderImplementation(
+		"distribution",
+		newRegistry,
+		distributionRegistryCapabilities{},
+		globalregistry.RoleTable{},
+	)
+}
+
+type registry struct {
+	logger    logr.Logger
+	parsedUrl *url.URL
+	globalregistry.Registry
+	*http.Client
+}
+
+var _ globalregistry.Registry = &registry{}
+var _ globalregistry.RegistryWithProjects = &registry{}
+
+// newRegistry is the constructor of the registry type. It is a globalregistry
+// RegistryCreator.
+func newRegistry(logger logr.Logger, config globalregistry.Registry) (globalregistry.Registry, error) {
+	var err error
+	r := &registry{
+		logger:   logger,
+		Registry: config,
+		Client: &http.Client{
+			Transport: &http.Transport{
+				TLSClientConfig: &tls.Config{
+					InsecureSkipVerify: config.GetInsecureSkipTLSVerify(),
+				},
+			},
+		},
+	}
+	r.parsedUrl, err = url.Parse(config.GetAPIEndpoint())
+	if err != nil {
+		return nil, err
+	}
+	return r, nil
+}
+
+type bytesBody struct {
+	*bytes.Buffer
+}
+
+func (bb bytesBody) Close() error { return nil }
+
+// do method of Registry will perform a normal http.Registry do operation plus
+// it prints extra information in case of unexpected response codes. The
+// response body is replaced with a bytesBody which provides the bytes.Buffer
+// (e.g. String()) methods too.
+func (r *registry) do(ctx context.Context, req *http.Request) (*http.Response, error) {
+	req = req.WithContext(ctx)
+	req.SetBasicAuth(r.GetUsername(), r.GetPassword())
+	resp, err := r.Client.Do(req)
+	if err != nil {
+		r.logger.Error(err, "http.Client cannot Do",
+			"req-url", req.URL,
+		)
+		return nil, err
+	}
+
+	buf := bytesBody{
+		Buffer: new(bytes.Buffer),
+	}
+	n, err := buf.ReadFrom(resp.Body)
+	if err != nil {
+		r.logger.Error(err, "cannot read HTTP response body")
+		return nil, err
+	}
+	resp.Body = buf
+
+	switch {
+	case resp.StatusCode == 401:
+		// Unauthorized
+		return nil, globalregistry.ErrUnauthorized
+	case resp.StatusCode < 200 || resp.StatusCode >= 300:
+		// Any other error code
+		r.logger.V(-1).Info("HTTP response status code is not OK",
+			"status-code", resp.StatusCode,
+			"resp-body-size", n,
+			"req-url", req.URL,
+		)
+		r.logger.V(1).Info(buf.String())
+		return nil, globalregistry.ErrInvalidStatusCode(resp.StatusCode)
+	}
+	return resp, nil
+}
+
+type distributionRegistryCapabilities struct{}
+
+var _ globalregistry.ReplicationCapabilities = distributionRegistryCapabilities{}
+
+func (cap distributionRegistryCapabilities) CanPull() bool {
+	return false
+}
+
+func (cap distributionRegistryCapabilities) CanPush() bool {
+	return false
+}
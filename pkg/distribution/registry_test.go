@@ -0,0 +1,209 @@
+/*
+   Copyright 2021 The Kubermatic Kubernetes Platform contributors.
+
+   Licensed under the Apache License, Version 2.0 (the "License");
+   you may not use this file except in compliance with the License.
+   You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+   Unless required by applicable law or agreed to in writing, software
+   distributed under the License is distributed on an "AS IS" BASIS,
+   WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+   See the License for the specific language governing permissions and
+   limitations under the License.
+*/
+
+package distribution
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sort"
+	"strings"
+
+	"github.com/go-logr/logr"
+	"github.com/kubermatic-labs/registryman/pkg/globalregistry"
+	"github.com/kubermatic-labs/registryman/pkg/globalregistry/globalregistrytest"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+// fakeDistribution serves the catalog, tag list and manifest endpoints of the
+// distribution API. Every tag refers to a separate manifest whose digest is
+// derived from the repository and tag name.
+type fakeDistribution struct {
+	*httptest.Server
+	// repositories maps the repository names to their tags
+	repositories map[string][]string
+	deleted      []string
+	// malformedCatalog makes the catalog endpoint return invalid JSON
+	malformedCatalog bool
+}
+
+func newFakeDistribution() *fakeDistribution {
+	f := &fakeDistribution{
+		repositories: make(map[string][]string),
+	}
+	f.Server = httptest.NewServer(http.HandlerFunc(f.serveHTTP))
+	return f
+}
+
+func digestOf(repo, tag string) string {
+	return fmt.Sprintf("sha256:%s-%s", strings.ReplaceAll(repo, "/", "-"), tag)
+}
+
+func (f *fakeDistribution) serveHTTP(w http.ResponseWriter, req *http.Request) {
+	if user, pass, ok := req.BasicAuth(); !ok || user != "user" || pass != "pass" {
+		w.WriteHeader(http.StatusUnauthorized)
+		return
+	}
+	if req.URL.Path == catalogPath {
+		if f.malformedCatalog {
+			_, _ = w.Write([]byte(`{"repositories": [`))
+			return
+		}
+		names := []string{}
+		for name := range f.repositories {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+		// pages of 2 repositories
+		start := 0
+		if last := req.URL.Query().Get("last"); last != "" {
+			start = sort.SearchStrings(names, last) + 1
+		}
+		end := start + 2
+		if end < len(names) {
+			w.Header().Set("Link", fmt.Sprintf(`<%s?last=%s&n=2>; rel="next"`, catalogPath, names[end-1]))
+		} else {
+			end = len(names)
+		}
+		_ = json.NewEncoder(w).Encode(&catalog{Repositories: names[start:end]})
+		return
+	}
+	path := strings.TrimPrefix(req.URL.Path, "/v2/")
+	switch {
+	case strings.HasSuffix(path, "/tags/list") && req.Method == http.MethodGet:
+		repo := strings.TrimSuffix(path, "/tags/list")
+		tags, found := f.repositories[repo]
+		if !found {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		_ = json.NewEncoder(w).Encode(&tagList{Name: repo, Tags: tags})
+	case strings.Contains(path, "/manifests/") && req.Method == http.MethodHead:
+		i := strings.LastIndex(path, "/manifests/")
+		repo, tag := path[:i], path[i+len("/manifests/"):]
+		if !strings.Contains(req.Header.Get("Accept"), "application/vnd.oci.image.manifest.v1+json") {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		w.Header().Set("Docker-Content-Digest", digestOf(repo, tag))
+	case strings.Contains(path, "/manifests/") && req.Method == http.MethodDelete:
+		i := strings.LastIndex(path, "/manifests/")
+		repo, digest := path[:i], path[i+len("/manifests/"):]
+		tags := []string{}
+		for _, tag := range f.repositories[repo] {
+			if digestOf(repo, tag) != digest {
+				tags = append(tags, tag)
+			}
+		}
+		f.repositories[repo] = tags
+		f.deleted = append(f.deleted, repo+"@"+digest)
+		w.WriteHeader(http.StatusAccepted)
+	default:
+		w.WriteHeader(http.StatusNotFound)
+	}
+}
+
+var _ = Describe("Distribution provider", func() {
+	var (
+		ctx     context.Context
+		server  *fakeDistribution
+		options *globalregistrytest.Options
+		reg     globalregistry.RegistryWithProjects
+	)
+
+	BeforeEach(func() {
+		ctx = context.Background()
+		server = newFakeDistribution()
+		server.repositories["os-images/alpine"] = []string{"3.14", "latest"}
+		server.repositories["os-images/ubuntu"] = []string{"20.04"}
+		server.repositories["apps/backend/api"] = []string{"v1"}
+		server.repositories["apps/deleted"] = []string{}
+		server.repositories["busybox"] = []string{"latest"}
+		options = &globalregistrytest.Options{}
+		r, err := globalregistry.New(logr.Discard(), &globalregistrytest.Registry{
+			Provider:    "distribution",
+			Name:        "edge",
+			Username:    "user",
+			Password:    "pass",
+			APIEndpoint: server.URL,
+			Options:     options,
+		})
+		Expect(err).ToNot(HaveOccurred())
+		reg = r.(globalregistry.RegistryWithProjects)
+	})
+
+	AfterEach(func() {
+		server.Close()
+	})
+
+	It("cannot replicate", func() {
+		capabilities := globalregistry.GetReplicationCapability("distribution")
+		Expect(capabilities.CanPull()).To(BeFalse())
+		Expect(capabilities.CanPush()).To(BeFalse())
+	})
+
+	It("derives the projects from the namespaces of the catalog", func() {
+		projects, err := reg.ListProjects(ctx)
+		Expect(err).ToNot(HaveOccurred())
+		names := []string{}
+		for _, project := range projects {
+			names = append(names, project.GetName())
+		}
+		Expect(names).To(ConsistOf("apps", "os-images"))
+
+		project, err := reg.GetProjectByName(ctx, "apps")
+		Expect(err).ToNot(HaveOccurred())
+		repos, err := project.(globalregistry.ProjectWithRepositories).GetRepositories(ctx)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(repos).To(ConsistOf("backend/api"))
+
+		project, err = reg.GetProjectByName(ctx, "busybox")
+		Expect(err).ToNot(HaveOccurred())
+		Expect(project).To(BeNil())
+	})
+
+	It("returns an error for a malformed catalog", func() {
+		server.malformedCatalog = true
+		_, err := reg.ListProjects(ctx)
+		Expect(err).To(HaveOccurred())
+	})
+
+	It("deletes the manifests of the project only when forced", func() {
+		project, err := reg.GetProjectByName(ctx, "os-images")
+		Expect(err).ToNot(HaveOccurred())
+		destructible := project.(globalregistry.DestructibleProject)
+
+		err = destructible.Delete(ctx)
+		Expect(err).To(MatchError(globalregistry.ErrRecoverableError))
+		Expect(server.deleted).To(BeEmpty())
+
+		options.ForceDelete = true
+		Expect(destructible.Delete(ctx)).To(Succeed())
+		Expect(server.deleted).To(ConsistOf(
+			"os-images/alpine@"+digestOf("os-images/alpine", "3.14"),
+			"os-images/alpine@"+digestOf("os-images/alpine", "latest"),
+			"os-images/ubuntu@"+digestOf("os-images/ubuntu", "20.04"),
+		))
+
+		project, err = reg.GetProjectByName(ctx, "os-images")
+		Expect(err).ToNot(HaveOccurred())
+		Expect(project).To(BeNil())
+	})
+})
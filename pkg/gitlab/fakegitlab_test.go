@@ -0,0 +1,259 @@
+/*
+   Copyright 2021 The Kubermatic Kubernetes Platform contributors.
+
+   Licensed under the Apache License, Version 2.0 (the "License");
+   you may not use this file except in compliance with the License.
+   You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+   Unless required by applicable law or agreed to in writing, software
+   distributed under the License is distributed on an "AS IS" BASIS,
+   WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+   See the License for the specific language governing permissions and
+   limitations under the License.
+*/
+
+package gitlab
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// fakeGitlab is an in-memory implementation of the parts of the GitLab REST API
+// that the provider uses. The list of groups is served in pages of 1 item so
+// that the pagination is exercised too.
+type fakeGitlab struct {
+	*httptest.Server
+	token        string
+	nextID       int
+	users        map[string]int
+	groups       map[int]*group
+	members      map[int]map[int]accessLevel
+	deployTokens map[int][]*deployToken
+	repositories map[int][]*containerRepository
+}
+
+func newFakeGitlab(token string) *fakeGitlab {
+	f := &fakeGitlab{
+		token:        token,
+		nextID:       100,
+		users:        make(map[string]int),
+		groups:       make(map[int]*group),
+		members:      make(map[int]map[int]accessLevel),
+		deployTokens: make(map[int][]*deployToken),
+		repositories: make(map[int][]*containerRepository),
+	}
+	f.Server = httptest.NewServer(http.HandlerFunc(f.serveHTTP))
+	return f
+}
+
+func (f *fakeGitlab) newID() int {
+	f.nextID++
+	return f.nextID
+}
+
+func (f *fakeGitlab) addUser(username string) int {
+	id := f.newID()
+	f.users[username] = id
+	return id
+}
+
+func (f *fakeGitlab) addGroup(name string, owner string) *group {
+	g := &group{
+		ID:       f.newID(),
+		Name:     name,
+		Path:     name,
+		FullPath: name,
+	}
+	f.groups[g.ID] = g
+	f.members[g.ID] = map[int]accessLevel{
+		f.users[owner]: ownerAccess,
+	}
+	return g
+}
+
+func (f *fakeGitlab) usernameOf(id int) string {
+	for name, userID := range f.users {
+		if userID == id {
+			return name
+		}
+	}
+	return ""
+}
+
+func (f *fakeGitlab) groupByPath(path string) *group {
+	if id, err := strconv.Atoi(path); err == nil {
+		return f.groups[id]
+	}
+	for _, g := range f.groups {
+		if g.FullPath == path {
+			return g
+		}
+	}
+	return nil
+}
+
+func writeJSON(w http.ResponseWriter, status int, v interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(v)
+}
+
+func (f *fakeGitlab) serveHTTP(w http.ResponseWriter, req *http.Request) {
+	if req.Header.Get("PRIVATE-TOKEN") != f.token {
+		w.WriteHeader(http.StatusUnauthorized)
+		return
+	}
+	path := strings.TrimPrefix(req.URL.EscapedPath(), apiPrefix+"/")
+	segments := strings.Split(path, "/")
+	for i := range segments {
+		segments[i] = strings.ReplaceAll(segments[i], "%2F", "/")
+	}
+	switch {
+	case path == "users" && req.Method == http.MethodGet:
+		users := []*user{}
+		if id, found := f.users[req.URL.Query().Get("username")]; found {
+			users = append(users, &user{ID: id, Username: req.URL.Query().Get("username")})
+		}
+		writeJSON(w, http.StatusOK, users)
+	case path == "groups" && req.Method == http.MethodGet:
+		f.listGroups(w, req)
+	case path == "groups" && req.Method == http.MethodPost:
+		body := &groupCreateReqBody{}
+		_ = json.NewDecoder(req.Body).Decode(body)
+		if f.groupByPath(body.Path) != nil {
+			writeJSON(w, http.StatusBadRequest, map[string]string{"message": "Failed to save group"})
+			return
+		}
+		writeJSON(w, http.StatusCreated, f.addGroup(body.Path, "admin"))
+	case segments[0] == "groups" && len(segments) >= 2:
+		g := f.groupByPath(segments[1])
+		if g == nil {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		f.serveGroup(w, req, g, segments[2:])
+	case segments[0] == "projects" && len(segments) == 5 &&
+		segments[2] == "registry" && segments[3] == "repositories" &&
+		req.Method == http.MethodDelete:
+		repoID, _ := strconv.Atoi(segments[4])
+		for groupID, repos := range f.repositories {
+			for i, repo := range repos {
+				if repo.ID == repoID {
+					f.repositories[groupID] = append(repos[:i], repos[i+1:]...)
+					w.WriteHeader(http.StatusAccepted)
+					return
+				}
+			}
+		}
+		w.WriteHeader(http.StatusNotFound)
+	default:
+		w.WriteHeader(http.StatusNotFound)
+	}
+}
+
+func (f *fakeGitlab) listGroups(w http.ResponseWriter, req *http.Request) {
+	ids := []int{}
+	for id := range f.groups {
+		ids = append(ids, id)
+	}
+	sort.Ints(ids)
+	page, _ := strconv.Atoi(req.URL.Query().Get("page"))
+	if page < 1 {
+		page = 1
+	}
+	groups := []*group{}
+	if page <= len(ids) {
+		groups = append(groups, f.groups[ids[page-1]])
+	}
+	if page < len(ids) {
+		w.Header().Set("X-Next-Page", strconv.Itoa(page+1))
+	}
+	writeJSON(w, http.StatusOK, groups)
+}
+
+func (f *fakeGitlab) serveGroup(w http.ResponseWriter, req *http.Request, g *group, segments []string) {
+	resource := strings.Join(segments, "/")
+	switch {
+	case resource == "" && req.Method == http.MethodGet:
+		writeJSON(w, http.StatusOK, g)
+	case resource == "" && req.Method == http.MethodDelete:
+		delete(f.groups, g.ID)
+		w.WriteHeader(http.StatusAccepted)
+	case resource == "registry/repositories" && req.Method == http.MethodGet:
+		repos := f.repositories[g.ID]
+		if repos == nil {
+			repos = []*containerRepository{}
+		}
+		writeJSON(w, http.StatusOK, repos)
+	case resource == "members" && req.Method == http.MethodGet:
+		members := []*groupMember{}
+		for id, level := range f.members[g.ID] {
+			members = append(members, &groupMember{
+				user:        user{ID: id, Username: f.usernameOf(id)},
+				AccessLevel: level,
+			})
+		}
+		writeJSON(w, http.StatusOK, members)
+	case resource == "members" && req.Method == http.MethodPost:
+		body := &groupMemberCreateReqBody{}
+		_ = json.NewDecoder(req.Body).Decode(body)
+		if _, found := f.members[g.ID][body.UserID]; found {
+			writeJSON(w, http.StatusConflict, map[string]string{"message": "Member already exists"})
+			return
+		}
+		f.members[g.ID][body.UserID] = body.AccessLevel
+		writeJSON(w, http.StatusCreated, &groupMember{
+			user:        user{ID: body.UserID, Username: f.usernameOf(body.UserID)},
+			AccessLevel: body.AccessLevel,
+		})
+	case len(segments) == 2 && segments[0] == "members" && req.Method == http.MethodDelete:
+		userID, _ := strconv.Atoi(segments[1])
+		if _, found := f.members[g.ID][userID]; !found {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		delete(f.members[g.ID], userID)
+		w.WriteHeader(http.StatusNoContent)
+	case resource == "deploy_tokens" && req.Method == http.MethodGet:
+		tokens := []*deployToken{}
+		for _, token := range f.deployTokens[g.ID] {
+			t := *token
+			t.Token = ""
+			tokens = append(tokens, &t)
+		}
+		writeJSON(w, http.StatusOK, tokens)
+	case resource == "deploy_tokens" && req.Method == http.MethodPost:
+		body := &deployTokenCreateReqBody{}
+		_ = json.NewDecoder(req.Body).Decode(body)
+		id := f.newID()
+		token := &deployToken{
+			ID:       id,
+			Name:     body.Name,
+			Username: fmt.Sprintf("gitlab+deploy-token-%d", id),
+			Scopes:   body.Scopes,
+			Token:    fmt.Sprintf("secret-%d", id),
+		}
+		f.deployTokens[g.ID] = append(f.deployTokens[g.ID], token)
+		writeJSON(w, http.StatusCreated, token)
+	case len(segments) == 2 && segments[0] == "deploy_tokens" && req.Method == http.MethodDelete:
+		tokenID, _ := strconv.Atoi(segments[1])
+		for _, token := range f.deployTokens[g.ID] {
+			if token.ID == tokenID && !token.Revoked {
+				token.Revoked = true
+				w.WriteHeader(http.StatusNoContent)
+				return
+			}
+		}
+		w.WriteHeader(http.StatusNotFound)
+	default:
+		w.WriteHeader(http.StatusNotFound)
+	}
+}
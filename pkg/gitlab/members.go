@@ -0,0 +1,300 @@
+/*
+   Copyright 2021 The Kubermatic Kubernetes Platform contributors.
+
+   Licensed under the Apache License, Version 2.0 (the "License");
+   you may not use this file except in compliance with the License.
+   You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+   Unless required by applicable law or agreed to in writing, software
+   distributed under the License is distributed on an "AS IS" BASIS,
+   WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+   See the License for the specific language governing permissions and
+   limitations under the License.
+*/
+
+package gitlab
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+
+	"github.com/kubermatic-labs/registryman/pkg/globalregistry"
+)
+
+const (
+	userType  = "User"
+	robotType = "Robot"
+)
+
+type projectMember struct {
+	name       string
+	memberType string
+	role       string
+}
+
+var _ globalregistry.ProjectMember = &projectMember{}
+
+func (m *projectMember) GetName() string {
+	return m.name
+}
+
+func (m *projectMember) GetType() string {
+	return m.memberType
+}
+
+func (m *projectMember) GetRole() string {
+	return m.role
+}
+
+type user struct {
+	ID       int    `json:"id"`
+	Username string `json:"username"`
+}
+
+type groupMember struct {
+	user
+	AccessLevel accessLevel `json:"access_level"`
+}
+
+type groupMemberCreateReqBody struct {
+	UserID      int         `json:"user_id"`
+	AccessLevel accessLevel `json:"access_level"`
+}
+
+type deployToken struct {
+	ID       int      `json:"id"`
+	Name     string   `json:"name"`
+	Username string   `json:"username"`
+	Scopes   []string `json:"scopes"`
+	Revoked  bool     `json:"revoked"`
+	Expired  bool     `json:"expired"`
+	Token    string   `json:"token,omitempty"`
+}
+
+type deployTokenCreateReqBody struct {
+	Name   string   `json:"name"`
+	Scopes []string `json:"scopes"`
+}
+
+// GetMembers implements the globalregistry.ProjectWithMembers interface. The
+// direct members of the group are returned as User members, except for the
+// owner of the access token. The active deploy tokens of the group are
+// returned as Robot members.
+func (p *project) GetMembers(ctx context.Context) ([]globalregistry.ProjectMember, error) {
+	groupMembers, err := p.getGroupMembers(ctx)
+	if err != nil {
+		return nil, err
+	}
+	deployTokens, err := p.getDeployTokens(ctx)
+	if err != nil {
+		return nil, err
+	}
+	roleTable, err := p.registry.roleTable()
+	if err != nil {
+		return nil, err
+	}
+	members := make([]globalregistry.ProjectMember, 0)
+	for _, member := range groupMembers {
+		if member.Username == p.registry.GetUsername() {
+			// the implicit owner of the group is not a managed member
+			continue
+		}
+		members = append(members, &projectMember{
+			name:       member.Username,
+			memberType: userType,
+			role:       roleTable.Role(userType, member.AccessLevel.String()),
+		})
+	}
+	for _, token := range deployTokens {
+		members = append(members, &projectMember{
+			name:       token.Name,
+			memberType: robotType,
+			role:       roleTable.Role(robotType, registryScopes(token.Scopes)),
+		})
+	}
+	return members, nil
+}
+
+// AssignMember implements the globalregistry.MemberManipulatorProject
+// interface. In case of robot members a deploy token is created and its
+// credentials are returned.
+func (p *project) AssignMember(ctx context.Context, member globalregistry.ProjectMember) (*globalregistry.ProjectMemberCredentials, error) {
+	memberType := member.GetType()
+	roleTable, err := p.registry.roleTable()
+	if err != nil {
+		return nil, err
+	}
+	providerRole, err := roleTable.ProviderRoleOf(memberType, member.GetRole())
+	if err != nil {
+		return nil, err
+	}
+	switch memberType {
+	default:
+		return nil, fmt.Errorf("unhandled member type: %s", memberType)
+	case userType:
+		level, err := accessLevelFromString(providerRole)
+		if err != nil {
+			return nil, err
+		}
+		u, err := p.registry.getUserByName(ctx, member.GetName())
+		if err != nil {
+			return nil, err
+		}
+		p.registry.logger.V(1).Info("adding group member",
+			"group", p.name,
+			"user", u.Username,
+			"access-level", level,
+		)
+		req, err := p.registry.newRequest(http.MethodPost,
+			fmt.Sprintf("/groups/%d/members", p.id), nil,
+			&groupMemberCreateReqBody{
+				UserID:      u.ID,
+				AccessLevel: level,
+			})
+		if err != nil {
+			return nil, err
+		}
+		return nil, p.registry.doJSON(ctx, req, nil)
+	case robotType:
+		scopes := globalregistry.SplitProviderRole(providerRole)
+		p.registry.logger.V(1).Info("creating deploy token",
+			"group", p.name,
+			"name", member.GetName(),
+			"scopes", scopes,
+		)
+		req, err := p.registry.newRequest(http.MethodPost,
+			fmt.Sprintf("/groups/%d/deploy_tokens", p.id), nil,
+			&deployTokenCreateReqBody{
+				Name:   member.GetName(),
+				Scopes: scopes,
+			})
+		if err != nil {
+			return nil, err
+		}
+		token := &deployToken{}
+		err = p.registry.doJSON(ctx, req, token)
+		if err != nil {
+			return nil, err
+		}
+		return &globalregistry.ProjectMemberCredentials{
+			Username: token.Username,
+			Password: token.Token,
+		}, nil
+	}
+}
+
+// UnassignMember implements the globalregistry.MemberManipulatorProject
+// interface.
+func (p *project) UnassignMember(ctx context.Context, member globalregistry.ProjectMember) error {
+	memberType := member.GetType()
+	switch memberType {
+	default:
+		return fmt.Errorf("unhandled member type: %s", memberType)
+	case userType:
+		u, err := p.registry.getUserByName(ctx, member.GetName())
+		if err != nil {
+			return err
+		}
+		p.registry.logger.V(1).Info("removing group member",
+			"group", p.name,
+			"user", u.Username,
+		)
+		req, err := p.registry.newRequest(http.MethodDelete,
+			fmt.Sprintf("/groups/%d/members/%d", p.id, u.ID), nil, nil)
+		if err != nil {
+			return err
+		}
+		return p.registry.doJSON(ctx, req, nil)
+	case robotType:
+		deployTokens, err := p.getDeployTokens(ctx)
+		if err != nil {
+			return err
+		}
+		found := false
+		for _, token := range deployTokens {
+			if token.Name != member.GetName() {
+				continue
+			}
+			found = true
+			p.registry.logger.V(1).Info("revoking deploy token",
+				"group", p.name,
+				"name", token.Name,
+				"id", token.ID,
+			)
+			req, err := p.registry.newRequest(http.MethodDelete,
+				fmt.Sprintf("/groups/%d/deploy_tokens/%d", p.id, token.ID), nil, nil)
+			if err != nil {
+				return err
+			}
+			err = p.registry.doJSON(ctx, req, nil)
+			if err != nil {
+				return err
+			}
+		}
+		if !found {
+			return fmt.Errorf("deploy token not found")
+		}
+		return nil
+	}
+}
+
+// getGroupMembers returns the direct members of the group.
+func (p *project) getGroupMembers(ctx context.Context) ([]*groupMember, error) {
+	members := []*groupMember{}
+	err := p.registry.getPages(ctx, fmt.Sprintf("/groups/%d/members", p.id), nil,
+		func(dec *json.Decoder) error {
+			page := []*groupMember{}
+			err := dec.Decode(&page)
+			members = append(members, page...)
+			return err
+		})
+	if err != nil {
+		return nil, err
+	}
+	return members, nil
+}
+
+// getDeployTokens returns the deploy tokens of the group which are neither
+// revoked nor expired.
+func (p *project) getDeployTokens(ctx context.Context) ([]*deployToken, error) {
+	tokens := []*deployToken{}
+	err := p.registry.getPages(ctx, fmt.Sprintf("/groups/%d/deploy_tokens", p.id), nil,
+		func(dec *json.Decoder) error {
+			page := []*deployToken{}
+			err := dec.Decode(&page)
+			for _, token := range page {
+				if !token.Revoked && !token.Expired {
+					tokens = append(tokens, token)
+				}
+			}
+			return err
+		})
+	if err != nil {
+		return nil, err
+	}
+	return tokens, nil
+}
+
+// getUserByName looks up the GitLab user by username.
+func (r *registry) getUserByName(ctx context.Context, username string) (*user, error) {
+	req, err := r.newRequest(http.MethodGet, "/users", url.Values{
+		"username": []string{username},
+	}, nil)
+	if err != nil {
+		return nil, err
+	}
+	users := []*user{}
+	err = r.doJSON(ctx, req, &users)
+	if err != nil {
+		return nil, err
+	}
+	if len(users) == 0 {
+		return nil, fmt.Errorf("user %s not found", username)
+	}
+	return users[0], nil
+}
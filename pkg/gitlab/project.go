@@ -0,0 +1,126 @@
+/*
+   Copyright 2021 The Kubermatic Kubernetes Platform contributors.
+
+   Licensed under the Apache License, Version 2.0 (the "License");
+   you may not use this file except in compliance with the License.
+   You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+   Unless required by applicable law or agreed to in writing, software
+   distributed under the License is distributed on an "AS IS" BASIS,
+   WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+   See the License for the specific language governing permissions and
+   limitations under the License.
+*/
+
+package gitlab
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/kubermatic-labs/registryman/pkg/globalregistry"
+)
+
+type project struct {
+	id       int
+	name     string
+	registry *registry
+}
+
+// interface guard
+var _ globalregistry.Project = &project{}
+var _ globalregistry.ProjectWithRepositories = &project{}
+var _ globalregistry.ProjectWithMembers = &project{}
+var _ globalregistry.MemberManipulatorProject = &project{}
+var _ globalregistry.DestructibleProject = &project{}
+
+func (p *project) GetName() string {
+	return p.name
+}
+
+// containerRepository is a repository of the container registry. Each
+// repository belongs to a GitLab project of the group.
+type containerRepository struct {
+	ID        int    `json:"id"`
+	Name      string `json:"name"`
+	Path      string `json:"path"`
+	ProjectID int    `json:"project_id"`
+}
+
+// Delete removes the group from the registry.
+func (p *project) Delete(ctx context.Context) error {
+	repos, err := p.getContainerRepositories(ctx)
+	if err != nil {
+		return err
+	}
+
+	if len(repos) > 0 {
+		switch opt := p.registry.GetOptions().(type) {
+		case globalregistry.CanForceDelete:
+			if f := opt.ForceDeleteProjects(); !f {
+				return fmt.Errorf("%s: repositories are present, please delete them before deleting the project, %w", p.name, globalregistry.ErrRecoverableError)
+			}
+			for _, repo := range repos {
+				p.registry.logger.V(1).Info("deleting repository",
+					"repositoryName", repo.Path,
+				)
+				err = p.deleteContainerRepository(ctx, repo)
+				if err != nil {
+					return err
+				}
+			}
+		default:
+			return globalregistry.ErrNotImplemented
+		}
+	}
+	p.registry.logger.V(1).Info("deleting group",
+		"name", p.name,
+	)
+	return p.registry.deleteGroup(ctx, p.id)
+}
+
+// GetRepositories returns the names of the repositories of the group without
+// the group prefix.
+func (p *project) GetRepositories(ctx context.Context) ([]string, error) {
+	repos, err := p.getContainerRepositories(ctx)
+	if err != nil {
+		return nil, err
+	}
+	repoNames := make([]string, len(repos))
+	for i, repo := range repos {
+		repoNames[i] = strings.TrimPrefix(repo.Path, p.name+"/")
+	}
+	return repoNames, nil
+}
+
+func (p *project) getContainerRepositories(ctx context.Context) ([]*containerRepository, error) {
+	repos := []*containerRepository{}
+	err := p.registry.getPages(ctx, fmt.Sprintf("/groups/%d/registry/repositories", p.id), nil,
+		func(dec *json.Decoder) error {
+			page := []*containerRepository{}
+			err := dec.Decode(&page)
+			repos = append(repos, page...)
+			return err
+		})
+	if err != nil {
+		return nil, err
+	}
+	return repos, nil
+}
+
+// deleteContainerRepository deletes the repository. GitLab deletes the
+// repositories asynchronously.
+func (p *project) deleteContainerRepository(ctx context.Context, repo *containerRepository) error {
+	req, err := p.registry.newRequest(http.MethodDelete,
+		fmt.Sprintf("/projects/%d/registry/repositories/%d", repo.ProjectID, repo.ID),
+		nil, nil)
+	if err != nil {
+		return err
+	}
+	return p.registry.doJSON(ctx, req, nil)
+}
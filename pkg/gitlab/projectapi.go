@@ -0,0 +1,138 @@
+/*
+   Copyright 2021 The Kubermatic Kubernetes Platform contributors.
+
+   Licensed under the Apache License, Version 2.0 (the "License");
+   you may not use this file except in compliance with the License.
+   You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+   Unless required by applicable law or agreed to in writing, software
+   distributed under the License is distributed on an "AS IS" BASIS,
+   WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+   See the License for the specific language governing permissions and
+   limitations under the License.
+*/
+
+package gitlab
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+
+	"github.com/kubermatic-labs/registryman/pkg/globalregistry"
+)
+
+type group struct {
+	ID       int    `json:"id"`
+	Name     string `json:"name"`
+	Path     string `json:"path"`
+	FullPath string `json:"full_path"`
+}
+
+type groupCreateReqBody struct {
+	Name       string `json:"name"`
+	Path       string `json:"path"`
+	Visibility string `json:"visibility"`
+}
+
+// GetProjectByName implements the globalregistry.RegistryWithProjects
+// interface. The top-level groups of GitLab are handled as projects.
+func (r *registry) GetProjectByName(ctx context.Context, name string) (globalregistry.Project, error) {
+	if name == "" {
+		return &project{
+			id:       -1,
+			name:     "",
+			registry: r,
+		}, nil
+	}
+	req, err := r.newRequest(http.MethodGet, fmt.Sprintf("/groups/%s", url.PathEscape(name)), nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	g := &group{}
+	err = r.doJSON(ctx, req, g)
+	switch err {
+	case nil:
+	case globalregistry.ErrInvalidStatusCode(http.StatusNotFound):
+		return nil, nil
+	default:
+		return nil, err
+	}
+	if g.FullPath != name {
+		// subgroups are not handled as projects
+		return nil, nil
+	}
+	return &project{
+		id:       g.ID,
+		name:     g.FullPath,
+		registry: r,
+	}, nil
+}
+
+// ListProjects implements the globalregistry.RegistryWithProjects interface. It
+// returns the top-level groups where the owner of the access token is a member.
+func (r *registry) ListProjects(ctx context.Context) ([]globalregistry.Project, error) {
+	r.logger.V(1).Info("listing groups",
+		"registry", r.GetName(),
+	)
+	groups := []*group{}
+	err := r.getPages(ctx, "/groups", url.Values{
+		"top_level_only": []string{"true"},
+	}, func(dec *json.Decoder) error {
+		page := []*group{}
+		err := dec.Decode(&page)
+		groups = append(groups, page...)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	pStatus := make([]globalregistry.Project, len(groups))
+	for i, g := range groups {
+		pStatus[i] = &project{
+			id:       g.ID,
+			name:     g.FullPath,
+			registry: r,
+		}
+	}
+	return pStatus, nil
+}
+
+// CreateProject implements the globalregistry.ProjectCreator interface. It
+// creates a new private top-level group. The owner of the access token becomes
+// the owner of the group.
+func (r *registry) CreateProject(ctx context.Context, name string) (globalregistry.Project, error) {
+	r.logger.V(1).Info("creating group",
+		"name", name,
+	)
+	req, err := r.newRequest(http.MethodPost, "/groups", nil, &groupCreateReqBody{
+		Name:       name,
+		Path:       name,
+		Visibility: "private",
+	})
+	if err != nil {
+		return nil, err
+	}
+	g := &group{}
+	err = r.doJSON(ctx, req, g)
+	if err != nil {
+		return nil, err
+	}
+	return &project{
+		id:       g.ID,
+		name:     g.FullPath,
+		registry: r,
+	}, nil
+}
+
+func (r *registry) deleteGroup(ctx context.Context, id int) error {
+	req, err := r.newRequest(http.MethodDelete, fmt.Sprintf("/groups/%d", id), nil, nil)
+	if err != nil {
+		return err
+	}
+	return r.doJSON(ctx, req, nil)
+}
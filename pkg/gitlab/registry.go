@@ -0,0 +1,219 @@
+/*
+   Copyright 2021 The Kubermatic Kubernetes Platform contributors.
+
+   Licensed under the Apache License, Version 2.0 (the "License");
+   you may not use this file except in compliance with the License.
+   You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+   Unless required by applicable law or agreed to in writing, software
+   distributed under the License is distributed on an "AS IS" BASIS,
+   WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+   See the License for the specific language governing permissions and
+   limitations under the License.
+*/
+
+// gitlab package implements the globalregistry.Registry interface for the
+// container registry of GitLab. The groups of GitLab are handled as projects.
+package gitlab
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/go-logr/logr"
+	"github.com/kubermatic-labs/registryman/pkg/globalregistry"
+)
+
+func init() {
+	// during init the gitlab provider is registered
+	globalregistry.RegisterProviderImplementation(
+		"gitlab",
+		newRegistry,
+		gitlabRegistryCapabilities{},
+		gitlabRoles,
+	)
+}
+
+const apiPrefix = "/api/v4"
+
+// perPage is the page size requested from the paginated API endpoints.
+const perPage = "100"
+
+type registry struct {
+	logger    logr.Logger
+	parsedUrl *url.URL
+	globalregistry.Registry
+	*http.Client
+}
+
+var _ globalregistry.Registry = &registry{}
+var _ globalregistry.RegistryWithProjects = &registry{}
+var _ globalregistry.ProjectCreator = &registry{}
+
+// newRegistry is the constructor of the registry type. It is a globalregistry
+// RegistryCreator.
+//
+// GitLab API expects a personal access token, so the password of the registry
+// configuration is used as the token. The username of the registry
+// configuration shall be the owner of the token.
+func newRegistry(logger logr.Logger, config globalregistry.Registry) (globalregistry.Registry, error) {
+	var err error
+	r := &registry{
+		logger:   logger,
+		Registry: config,
+		Client: &http.Client{
+			Transport: &http.Transport{
+				TLSClientConfig: &tls.Config{
+					InsecureSkipVerify: config.GetInsecureSkipTLSVerify(),
+				},
+			},
+		},
+	}
+	r.parsedUrl, err = url.Parse(config.GetAPIEndpoint())
+	if err != nil {
+		return nil, err
+	}
+	return r, nil
+}
+
+type bytesBody struct {
+	*bytes.Buffer
+}
+
+func (bb bytesBody) Close() error { return nil }
+
+// newRequest creates an HTTP request for the given path of the GitLab REST API.
+// If body is not nil, it is sent JSON encoded.
+func (r *registry) newRequest(method, apiPath string, query url.Values, body interface{}) (*http.Request, error) {
+	u, err := url.Parse(strings.TrimSuffix(r.parsedUrl.String(), "/") + apiPrefix + apiPath)
+	if err != nil {
+		return nil, err
+	}
+	if query != nil {
+		u.RawQuery = query.Encode()
+	}
+	var reqBody io.Reader
+	if body != nil {
+		reqBodyBuf := bytes.NewBuffer(nil)
+		err = json.NewEncoder(reqBodyBuf).Encode(body)
+		if err != nil {
+			return nil, err
+		}
+		reqBody = reqBodyBuf
+	}
+	req, err := http.NewRequest(method, u.String(), reqBody)
+	if err != nil {
+		return nil, err
+	}
+	if body != nil {
+		req.Header["Content-Type"] = []string{"application/json"}
+	}
+	return req, nil
+}
+
+// do method of Registry will perform a normal http.Registry do operation plus
+// it prints extra information in case of unexpected response codes. The
+// response body is replaced with a bytesBody which provides the bytes.Buffer
+// (e.g. String()) methods too.
+func (r *registry) do(ctx context.Context, req *http.Request) (*http.Response, error) {
+	req = req.WithContext(ctx)
+	req.Header.Set("PRIVATE-TOKEN", r.GetPassword())
+	resp, err := r.Client.Do(req)
+	if err != nil {
+		r.logger.Error(err, "http.Client cannot Do",
+			"req-url", req.URL,
+		)
+		return nil, err
+	}
+
+	buf := bytesBody{
+		Buffer: new(bytes.Buffer),
+	}
+	n, err := buf.ReadFrom(resp.Body)
+	if err != nil {
+		r.logger.Error(err, "cannot read HTTP response body")
+		return nil, err
+	}
+	resp.Body = buf
+
+	switch {
+	case resp.StatusCode == 401:
+		// Unauthorized
+		return nil, globalregistry.ErrUnauthorized
+	case resp.StatusCode < 200 || resp.StatusCode >= 300:
+		// Any other error code
+		r.logger.V(-1).Info("HTTP response status code is not OK",
+			"status-code", resp.StatusCode,
+			"resp-body-size", n,
+			"req-url", req.URL,
+		)
+		r.logger.V(1).Info(buf.String())
+		return nil, globalregistry.ErrInvalidStatusCode(resp.StatusCode)
+	}
+	return resp, nil
+}
+
+// doJSON performs the request and decodes the JSON response into result.
+func (r *registry) doJSON(ctx context.Context, req *http.Request, result interface{}) error {
+	resp, err := r.do(ctx, req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if result == nil {
+		return nil
+	}
+	err = json.NewDecoder(resp.Body).Decode(result)
+	if err != nil {
+		r.logger.Error(err, "json decoding failed")
+	}
+	return err
+}
+
+// getPages fetches all pages of a paginated list endpoint. The decode function
+// is invoked with the response body of each page.
+func (r *registry) getPages(ctx context.Context, apiPath string, query url.Values, decode func(*json.Decoder) error) error {
+	if query == nil {
+		query = url.Values{}
+	}
+	query.Set("per_page", perPage)
+	for page := "1"; page != ""; {
+		query.Set("page", page)
+		req, err := r.newRequest(http.MethodGet, apiPath, query, nil)
+		if err != nil {
+			return err
+		}
+		resp, err := r.do(ctx, req)
+		if err != nil {
+			return err
+		}
+		err = decode(json.NewDecoder(resp.Body))
+		resp.Body.Close()
+		if err != nil {
+			r.logger.Error(err, "json decoding failed")
+			return err
+		}
+		page = resp.Header.Get("X-Next-Page")
+	}
+	return nil
+}
+
+type gitlabRegistryCapabilities struct{}
+
+var _ globalregistry.ReplicationCapabilities = gitlabRegistryCapabilities{}
+
+func (cap gitlabRegistryCapabilities) CanPull() bool {
+	return false
+}
+
+func (cap gitlabRegistryCapabilities) CanPush() bool {
+	return false
+}
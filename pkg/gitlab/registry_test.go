@@ -0,0 +1,200 @@
+/*
+   Copyright 2021 The Kubermatic Kubernetes Platform contributors.
+
+   Licensed under the Apache License, Version 2.0 (the "License");
+   you may not use this file except in compliance with the License.
+   You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+   Unless required by applicable law or agreed to in writing, software
+   distributed under the License is distributed on an "AS IS" BASIS,
+   WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+   See the License for the specific language governing permissions and
+   limitations under the License.
+*/
+
+package gitlab
+
+import (
+	"context"
+
+	"github.com/go-logr/logr"
+	"github.com/kubermatic-labs/registryman/pkg/globalregistry"
+	"github.com/kubermatic-labs/registryman/pkg/globalregistry/globalregistrytest"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+type memberSummary struct {
+	name       string
+	memberType string
+	role       string
+}
+
+func summarizeMembers(members []globalregistry.ProjectMember) []memberSummary {
+	summary := make([]memberSummary, len(members))
+	for i, member := range members {
+		summary[i] = memberSummary{
+			name:       member.GetName(),
+			memberType: member.GetType(),
+			role:       member.GetRole(),
+		}
+	}
+	return summary
+}
+
+var _ = Describe("GitLab provider", func() {
+	var (
+		ctx     context.Context
+		server  *fakeGitlab
+		options *globalregistrytest.Options
+		reg     globalregistry.Registry
+	)
+
+	BeforeEach(func() {
+		ctx = context.Background()
+		server = newFakeGitlab("token")
+		server.addUser("admin")
+		server.addUser("alice")
+		server.addUser("bob")
+		options = &globalregistrytest.Options{}
+		var err error
+		reg, err = globalregistry.New(logr.Discard(), &globalregistrytest.Registry{
+			Provider:    "gitlab",
+			Name:        "gitlab",
+			Username:    "admin",
+			Password:    "token",
+			APIEndpoint: server.URL,
+			Options:     options,
+		})
+		Expect(err).ToNot(HaveOccurred())
+	})
+
+	AfterEach(func() {
+		server.Close()
+	})
+
+	It("cannot replicate", func() {
+		capabilities := globalregistry.GetReplicationCapability("gitlab")
+		Expect(capabilities.CanPull()).To(BeFalse())
+		Expect(capabilities.CanPush()).To(BeFalse())
+	})
+
+	It("rejects invalid token", func() {
+		r, err := globalregistry.New(logr.Discard(), &globalregistrytest.Registry{
+			Provider:    "gitlab",
+			Name:        "gitlab",
+			Username:    "admin",
+			Password:    "invalid",
+			APIEndpoint: server.URL,
+			Options:     options,
+		})
+		Expect(err).ToNot(HaveOccurred())
+		_, err = r.(globalregistry.RegistryWithProjects).ListProjects(ctx)
+		Expect(err).To(Equal(globalregistry.ErrUnauthorized))
+	})
+
+	It("handles groups as projects", func() {
+		server.addGroup("os-images", "admin")
+		server.addGroup("apps", "admin")
+
+		projects, err := reg.(globalregistry.RegistryWithProjects).ListProjects(ctx)
+		Expect(err).ToNot(HaveOccurred())
+		names := []string{}
+		for _, project := range projects {
+			names = append(names, project.GetName())
+		}
+		Expect(names).To(ConsistOf("os-images", "apps"))
+
+		_, err = reg.(globalregistry.ProjectCreator).CreateProject(ctx, "new")
+		Expect(err).ToNot(HaveOccurred())
+		project, err := reg.(globalregistry.RegistryWithProjects).GetProjectByName(ctx, "new")
+		Expect(err).ToNot(HaveOccurred())
+		Expect(project).ToNot(BeNil())
+		Expect(project.GetName()).To(Equal("new"))
+
+		project, err = reg.(globalregistry.RegistryWithProjects).GetProjectByName(ctx, "missing")
+		Expect(err).ToNot(HaveOccurred())
+		Expect(project).To(BeNil())
+	})
+
+	It("returns the repositories relative to the group", func() {
+		g := server.addGroup("apps", "admin")
+		server.repositories[g.ID] = []*containerRepository{
+			{ID: 1, Name: "", Path: "apps/backend", ProjectID: 10},
+			{ID: 2, Name: "worker", Path: "apps/backend/worker", ProjectID: 10},
+		}
+		project, err := reg.(globalregistry.RegistryWithProjects).GetProjectByName(ctx, "apps")
+		Expect(err).ToNot(HaveOccurred())
+		repos, err := project.(globalregistry.ProjectWithRepositories).GetRepositories(ctx)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(repos).To(ConsistOf("backend", "backend/worker"))
+	})
+
+	It("deletes the repositories of the group only when forced", func() {
+		g := server.addGroup("apps", "admin")
+		server.repositories[g.ID] = []*containerRepository{
+			{ID: 1, Name: "", Path: "apps/backend", ProjectID: 10},
+		}
+		project, err := reg.(globalregistry.RegistryWithProjects).GetProjectByName(ctx, "apps")
+		Expect(err).ToNot(HaveOccurred())
+		destructible := project.(globalregistry.DestructibleProject)
+
+		err = destructible.Delete(ctx)
+		Expect(err).To(MatchError(globalregistry.ErrRecoverableError))
+		Expect(server.groups).To(HaveKey(g.ID))
+
+		options.ForceDelete = true
+		Expect(destructible.Delete(ctx)).To(Succeed())
+		Expect(server.repositories[g.ID]).To(BeEmpty())
+		Expect(server.groups).ToNot(HaveKey(g.ID))
+	})
+
+	It("manages users with access levels and robots with deploy tokens", func() {
+		g := server.addGroup("apps", "admin")
+		project, err := reg.(globalregistry.RegistryWithProjects).GetProjectByName(ctx, "apps")
+		Expect(err).ToNot(HaveOccurred())
+		mProject := project.(globalregistry.MemberManipulatorProject)
+
+		members, err := project.(globalregistry.ProjectWithMembers).GetMembers(ctx)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(members).To(BeEmpty())
+
+		creds, err := mProject.AssignMember(ctx, &globalregistrytest.Member{Name: "alice", Type: "User", Role: "Guest"})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(creds).To(BeNil())
+		Expect(server.members[g.ID][server.users["alice"]]).To(Equal(reporterAccess))
+		_, err = mProject.AssignMember(ctx, &globalregistrytest.Member{Name: "bob", Type: "User", Role: "ProjectAdmin"})
+		Expect(err).ToNot(HaveOccurred())
+		_, err = mProject.AssignMember(ctx, &globalregistrytest.Member{Name: "nobody", Type: "User", Role: "Developer"})
+		Expect(err).To(HaveOccurred())
+		_, err = mProject.AssignMember(ctx, &globalregistrytest.Member{Name: "devs", Type: "Group", Role: "Developer"})
+		Expect(err).To(HaveOccurred())
+
+		creds, err = mProject.AssignMember(ctx, &globalregistrytest.Member{Name: "ci", Type: "Robot", Role: "PullAndPush"})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(creds).ToNot(BeNil())
+		Expect(creds.Username).To(HavePrefix("gitlab+deploy-token-"))
+		Expect(creds.Password).To(HavePrefix("secret-"))
+		Expect(server.deployTokens[g.ID][0].Scopes).To(ConsistOf(readRegistryScope, writeRegistryScope))
+
+		members, err = project.(globalregistry.ProjectWithMembers).GetMembers(ctx)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(summarizeMembers(members)).To(ConsistOf(
+			memberSummary{"alice", "User", "Guest"},
+			memberSummary{"bob", "User", "ProjectAdmin"},
+			memberSummary{"ci", "Robot", "PullAndPush"},
+		))
+
+		Expect(mProject.UnassignMember(ctx, &globalregistrytest.Member{Name: "alice", Type: "User", Role: "Guest"})).To(Succeed())
+		Expect(mProject.UnassignMember(ctx, &globalregistrytest.Member{Name: "ci", Type: "Robot", Role: "PullAndPush"})).To(Succeed())
+		Expect(mProject.UnassignMember(ctx, &globalregistrytest.Member{Name: "ci", Type: "Robot", Role: "PullAndPush"})).ToNot(Succeed())
+
+		members, err = project.(globalregistry.ProjectWithMembers).GetMembers(ctx)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(summarizeMembers(members)).To(ConsistOf(
+			memberSummary{"bob", "User", "ProjectAdmin"},
+		))
+	})
+})
@@ -0,0 +1,116 @@
+/*
+   Copyright 2021 The Kubermatic Kubernetes Platform contributors.
+
+   Licensed under the Apache License, Version 2.0 (the "License");
+   you may not use this file except in compliance with the License.
+   You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+   Unless required by applicable law or agreed to in writing, software
+   distributed under the License is distributed on an "AS IS" BASIS,
+   WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+   See the License for the specific language governing permissions and
+   limitations under the License.
+*/
+
+package gitlab
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/kubermatic-labs/registryman/pkg/globalregistry"
+)
+
+// accessLevel is the permission level of a GitLab group member.
+type accessLevel int
+
+const (
+	guestAccess      accessLevel = 10
+	reporterAccess   accessLevel = 20
+	developerAccess  accessLevel = 30
+	maintainerAccess accessLevel = 40
+	ownerAccess      accessLevel = 50
+)
+
+var allAccessLevels = []accessLevel{
+	guestAccess,
+	reporterAccess,
+	developerAccess,
+	maintainerAccess,
+	ownerAccess,
+}
+
+// String method implements the Stringer interface for accessLevel.
+func (level accessLevel) String() string {
+	switch level {
+	case guestAccess:
+		return "guest"
+	case reporterAccess:
+		return "reporter"
+	case developerAccess:
+		return "developer"
+	case maintainerAccess:
+		return "maintainer"
+	case ownerAccess:
+		return "owner"
+	default:
+		return fmt.Sprintf("access-level-%d", int(level))
+	}
+}
+
+// accessLevelFromString is the inverse of accessLevel.String.
+func accessLevelFromString(s string) (accessLevel, error) {
+	for _, level := range allAccessLevels {
+		if level.String() == s {
+			return level, nil
+		}
+	}
+	return accessLevel(-1), fmt.Errorf("unknown access level: %s", s)
+}
+
+// The scopes of the deploy tokens which grant access to the container
+// registry.
+const (
+	readRegistryScope  = "read_registry"
+	writeRegistryScope = "write_registry"
+)
+
+// gitlabRoles is the role table of GitLab. The roles of the users are the
+// access levels of the group members, the roles of the robots are comma
+// separated lists of the scopes of the deploy tokens. Guests of GitLab cannot
+// pull images from private groups, so the Guest role is mapped to the Reporter
+// access level.
+var gitlabRoles = globalregistry.RoleTable{
+	userType: {
+		"LimitedGuest": guestAccess.String(),
+		"Guest":        reporterAccess.String(),
+		"Developer":    developerAccess.String(),
+		"Maintainer":   maintainerAccess.String(),
+		"ProjectAdmin": ownerAccess.String(),
+	},
+	robotType: {
+		"PullOnly":    readRegistryScope,
+		"PushOnly":    writeRegistryScope,
+		"PullAndPush": readRegistryScope + "," + writeRegistryScope,
+	},
+}
+
+// roleTable returns the role table of the registry, i.e. the GitLab role table
+// with the role mapping of the registry applied.
+func (r *registry) roleTable() (globalregistry.RoleTable, error) {
+	return globalregistry.GetRoleTable(r.Registry)
+}
+
+// registryScopes returns the provider specific robot role of the deploy token
+// scopes. The scopes not related to the container registry are ignored.
+func registryScopes(scopes []string) string {
+	result := []string{}
+	for _, scope := range scopes {
+		if scope == readRegistryScope || scope == writeRegistryScope {
+			result = append(result, scope)
+		}
+	}
+	return strings.Join(result, ",")
+}
@@ -0,0 +1,90 @@
+/*
+   Copyright 2021 The Kubermatic Kubernetes Platform contributors.
+
+   Licensed under the Apache License, Version 2.0 (the "License");
+   you may not use this file except in compliance with the License.
+   You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+   Unless required by applicable law or agreed to in writing, software
+   distributed under the License is distributed on an "AS IS" BASIS,
+   WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+   See the License for the specific language governing permissions and
+   limitations under the License.
+*/
+
+// Package globalregistrytest provides the implementations of the
+// globalregistry interfaces which are shared by the tests of the registry
+// providers.
+package globalregistrytest
+
+import (
+	"github.com/kubermatic-labs/registryman/pkg/globalregistry"
+)
+
+// Options is the RegistryOptions of a test registry. The options can be
+// changed during the test through the pointer passed to the Registry.
+type Options struct {
+	ForceDelete bool
+}
+
+var _ globalregistry.RegistryOptions = &Options{}
+
+func (o *Options) ForceDeleteProjects() bool {
+	return o.ForceDelete
+}
+
+// Registry is the configuration of a test registry. The default options are
+// used if Options is not set.
+type Registry struct {
+	Provider    string
+	Name        string
+	Username    string
+	Password    string
+	APIEndpoint string
+	Options     *Options
+	Annotations map[string]string
+}
+
+var _ globalregistry.Registry = &Registry{}
+
+func (r *Registry) GetProvider() string    { return r.Provider }
+func (r *Registry) GetUsername() string    { return r.Username }
+func (r *Registry) GetPassword() string    { return r.Password }
+func (r *Registry) GetAPIEndpoint() string { return r.APIEndpoint }
+func (r *Registry) GetName() string        { return r.Name }
+
+func (r *Registry) GetOptions() globalregistry.RegistryOptions {
+	if r.Options == nil {
+		return &Options{}
+	}
+	return r.Options
+}
+
+func (r *Registry) GetAnnotations() map[string]string { return r.Annotations }
+func (r *Registry) GetInsecureSkipTLSVerify() bool    { return false }
+
+// Member is a project member of the tests.
+type Member struct {
+	Name string
+	Type string
+	Role string
+}
+
+var _ globalregistry.ProjectMember = &Member{}
+
+func (m *Member) GetName() string { return m.Name }
+func (m *Member) GetType() string { return m.Type }
+func (m *Member) GetRole() string { return m.Role }
+
+// LdapMember is a project member of the tests which is resolved by its LDAP
+// distinguished name.
+type LdapMember struct {
+	Member
+	DN string
+}
+
+var _ globalregistry.LdapMember = &LdapMember{}
+
+func (m *LdapMember) GetDN() string { return m.DN }
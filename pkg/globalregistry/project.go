@@ -16,7 +16,13 @@
 
 package globalregistry
 
-import "context"
+import (
+	"context"
+	"strings"
+
+	api "github.com/kubermatic-labs/registryman/pkg/apis/registryman/v1alpha1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
 
 // ProjectMember interface defines the methods that are common for all types of
 // project members.
@@ -41,6 +47,70 @@ type LdapMember interface {
 	GetDN() string
 }
 
+// GroupMember is a ProjectMember of type group. The group is resolved by an
+// identity provider, like LDAP or OIDC.
+type GroupMember interface {
+	ProjectMember
+
+	// GetGroupType returns the type of the group, e.g. LDAP or OIDC.
+	GetGroupType() string
+}
+
+// MemberWithRotationPolicy is a ProjectMember (typically of type robot) whose
+// credentials expire or shall be rotated periodically.
+type MemberWithRotationPolicy interface {
+	ProjectMember
+
+	// GetRotationPolicy returns the credential rotation policy of the
+	// member. nil is returned if the credentials are not rotated.
+	GetRotationPolicy() *api.CredentialRotation
+}
+
+// MemberWithCredentialStatus is a ProjectMember (typically of type robot)
+// whose credentials are created by the registry.
+type MemberWithCredentialStatus interface {
+	ProjectMember
+
+	// GetExpiresAt returns when the credentials of the member expire. nil
+	// is returned if the credentials never expire.
+	GetExpiresAt() *metav1.Time
+}
+
+// MemberWithTargetNamespaces is a ProjectMember (typically of type robot)
+// whose pull secret is copied to other namespaces.
+type MemberWithTargetNamespaces interface {
+	ProjectMember
+
+	// GetTargetNamespaces returns the namespaces where the pull secret of
+	// the member shall be copied to.
+	GetTargetNamespaces() []string
+}
+
+// TargetNamespacesAnnotation is the annotation of the credential Secrets which
+// contains the comma separated list of the namespaces where the Secret is
+// copied to.
+const TargetNamespacesAnnotation = "globalregistry.org/target-namespaces"
+
+// ParseTargetNamespaces returns the namespaces listed in the value of the
+// TargetNamespacesAnnotation.
+func ParseTargetNamespaces(annotation string) []string {
+	if annotation == "" {
+		return nil
+	}
+	namespaces := []string{}
+	for _, ns := range strings.Split(annotation, ",") {
+		if ns = strings.TrimSpace(ns); ns != "" {
+			namespaces = append(namespaces, ns)
+		}
+	}
+	return namespaces
+}
+
+// RotationTimeAnnotation is the annotation of the credential Secrets which
+// contains when the credentials were created or last rotated, in RFC 3339
+// format.
+const RotationTimeAnnotation = "globalregistry.org/rotation-time"
+
 // ProjectMemberCredentials contains the username and password of a member
 // (typically of type robot) that is created during the AssignMember operation
 // of a Project.
@@ -89,6 +159,14 @@ type MemberManipulatorProject interface {
 	UnassignMember(context.Context, ProjectMember) error
 }
 
+// CredentialRotatorProject interface contains the methods that we use for
+// rotating the credentials of the project members.
+type CredentialRotatorProject interface {
+	// RotateMemberCredentials generates new credentials for a project
+	// member (typically of type robot) and returns them.
+	RotateMemberCredentials(context.Context, ProjectMember) (*ProjectMemberCredentials, error)
+}
+
 // ProjectWithScanner interface contains the methods that we use for
 // project-level scanner related read-only operations.
 type ProjectWithScanner interface {
@@ -118,7 +196,7 @@ type ProjectWithReplication interface {
 // for project-level replication related read-write manipulations.
 type ReplicationRuleManipulatorProject interface {
 	// AssignReplicationRule assigns a replication rule to the project.
-	AssignReplicationRule(ctx context.Context, remote Registry, trigger ReplicationTrigger, direction string) (ReplicationRule, error)
+	AssignReplicationRule(ctx context.Context, remote Registry, trigger ReplicationTrigger, direction string, options api.ReplicationOptions) (ReplicationRule, error)
 }
 
 // ProjectWithStorage interface contains the methods that we use for
@@ -128,6 +206,106 @@ type ProjectWithStorage interface {
 	GetUsedStorage(context.Context) (int, error)
 }
 
+// ProjectWithStorageQuota interface contains the methods that we use for
+// project-level storage quota related read-only operations.
+type ProjectWithStorageQuota interface {
+	// GetStorageQuota returns the storage quota of the project in bytes.
+	// -1 is returned if the storage of the project is not limited.
+	GetStorageQuota(context.Context) (int, error)
+}
+
+// StorageQuotaManipulatorProject interface contains the methods that we use
+// for project-level storage quota related read-write operations.
+type StorageQuotaManipulatorProject interface {
+	// SetStorageQuota sets the storage quota of the project in bytes. -1
+	// removes the limit.
+	SetStorageQuota(context.Context, int) error
+}
+
+// ProjectWithSettings interface contains the methods that we use for
+// project-level settings related read-only operations.
+type ProjectWithSettings interface {
+	// GetSettings returns the settings of the project. nil is returned if
+	// the settings of the project are not managed.
+	GetSettings(context.Context) (*api.ProjectSettings, error)
+}
+
+// SettingsManipulatorProject interface contains the methods that we use for
+// project-level settings related read-write operations.
+type SettingsManipulatorProject interface {
+	// UpdateSettings updates the settings of the project.
+	UpdateSettings(context.Context, *api.ProjectSettings) error
+}
+
+// ProjectWithRetention interface contains the methods that we use for
+// project-level tag retention related read-only operations.
+type ProjectWithRetention interface {
+	// GetRetentionPolicy returns the tag retention policy of the project.
+	// nil is returned if the tag retention of the project is not managed.
+	GetRetentionPolicy(context.Context) (*api.RetentionPolicy, error)
+}
+
+// RetentionManipulatorProject interface contains the methods that we use for
+// project-level tag retention related read-write operations. The rules are
+// identified by their repository and tag patterns.
+type RetentionManipulatorProject interface {
+	// AddRetentionRule adds a tag retention rule to the project.
+	AddRetentionRule(context.Context, api.RetentionRule) error
+
+	// UpdateRetentionRule updates the tag retention rule of the project
+	// which has the same repository and tag patterns.
+	UpdateRetentionRule(context.Context, api.RetentionRule) error
+
+	// RemoveRetentionRule removes the tag retention rule of the project
+	// which has the same repository and tag patterns.
+	RemoveRetentionRule(context.Context, api.RetentionRule) error
+}
+
+// ProjectWithImmutableTags interface contains the methods that we use for
+// project-level immutable tag rule related read-only operations.
+type ProjectWithImmutableTags interface {
+	// GetImmutableTagPolicy returns the immutable tag rules of the
+	// project. nil is returned if the immutable tag rules of the project
+	// are not managed.
+	GetImmutableTagPolicy(context.Context) (*api.ImmutableTagPolicy, error)
+}
+
+// ImmutableTagManipulatorProject interface contains the methods that we use
+// for project-level immutable tag rule related read-write operations. The
+// rules are identified by their repository and tag patterns.
+type ImmutableTagManipulatorProject interface {
+	// AddImmutableTagRule adds an immutable tag rule to the project.
+	AddImmutableTagRule(context.Context, api.ImmutableTagRule) error
+
+	// RemoveImmutableTagRule removes the immutable tag rule of the project
+	// which has the same repository and tag patterns.
+	RemoveImmutableTagRule(context.Context, api.ImmutableTagRule) error
+}
+
+// ProjectWithWebhooks interface contains the methods that we use for
+// project-level webhook notification related read-only operations.
+type ProjectWithWebhooks interface {
+	// GetWebhooks returns the webhooks of the project. nil is returned if
+	// the webhooks of the project are not managed.
+	GetWebhooks(context.Context) ([]api.WebhookStatus, error)
+}
+
+// WebhookManipulatorProject interface contains the methods that we use for
+// project-level webhook notification related read-write operations. The
+// webhooks are identified by their names.
+type WebhookManipulatorProject interface {
+	// AddWebhook adds a webhook to the project.
+	AddWebhook(context.Context, api.WebhookStatus) error
+
+	// UpdateWebhook updates the webhook of the project which has the same
+	// name.
+	UpdateWebhook(context.Context, api.WebhookStatus) error
+
+	// RemoveWebhook removes the webhook of the project which has the same
+	// name.
+	RemoveWebhook(context.Context, api.WebhookStatus) error
+}
+
 // RegistryWithProjects interface defines the methods of a registry which are
 // related to the management of the projects.
 type RegistryWithProjects interface {
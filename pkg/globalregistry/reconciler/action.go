@@ -20,6 +20,7 @@ import (
 	"context"
 
 	"github.com/kubermatic-labs/registryman/pkg/globalregistry"
+	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 )
 
@@ -32,10 +33,11 @@ func (se nilSideEffect) Perform(context.Context, SideEffectPerformer) error {
 var nilEffect SideEffect = nilSideEffect{}
 
 // SideEffectPerformer interface declares the methods that a SideEffect wants to
-// use.
+// use. GetSecret returns nil if the Secret does not exist.
 type SideEffectPerformer interface {
 	WriteResource(ctx context.Context, obj runtime.Object) error
 	RemoveResource(ctx context.Context, obj runtime.Object) error
+	GetSecret(ctx context.Context, name string) (*corev1.Secret, error)
 }
 
 // SideEffect interface contains the methods that a sideeffect needs to
@@ -0,0 +1,258 @@
+/*
+   Copyright 2021 The Kubermatic Kubernetes Platform contributors.
+
+   Licensed under the Apache License, Version 2.0 (the "License");
+   you may not use this file except in compliance with the License.
+   You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+   Unless required by applicable law or agreed to in writing, software
+   distributed under the License is distributed on an "AS IS" BASIS,
+   WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+   See the License for the specific language governing permissions and
+   limitations under the License.
+*/
+
+package reconciler
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"strings"
+	"time"
+
+	api "github.com/kubermatic-labs/registryman/pkg/apis/registryman/v1alpha1"
+	"github.com/kubermatic-labs/registryman/pkg/globalregistry"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// persistCredentials side effect stores the credentials of a robot account in
+// a Secret of type kubernetes.io/dockerconfigjson. The Secret can be used as
+// an image pull secret. If targetNamespaces is set, the Secret is copied to
+// the given namespaces, too.
+type persistCredentials struct {
+	globalregistry.ProjectMemberCredentials
+	registry         globalregistry.Registry
+	secretName       string
+	annotations      map[string]string
+	targetNamespaces []string
+}
+
+var _ SideEffect = &persistCredentials{}
+
+// dockerConfigJson is the content of a kubernetes.io/dockerconfigjson Secret.
+type dockerConfigJson struct {
+	Auths map[string]dockerConfigAuth `json:"auths"`
+}
+
+type dockerConfigAuth struct {
+	Username string `json:"username"`
+	Password string `json:"password"`
+	Auth     string `json:"auth"`
+}
+
+// registryHost returns the host (and port) part of the API endpoint of the
+// registry. This is the key which the container runtimes look up in the
+// dockerconfigjson Secrets.
+func registryHost(reg globalregistry.Registry) string {
+	apiEndpoint := reg.GetAPIEndpoint()
+	u, err := url.Parse(apiEndpoint)
+	if err != nil || u.Host == "" {
+		return strings.TrimSuffix(apiEndpoint, "/")
+	}
+	return u.Host
+}
+
+func (pc *persistCredentials) Perform(ctx context.Context, performer SideEffectPerformer) error {
+	dockerConfig, err := json.Marshal(&dockerConfigJson{
+		Auths: map[string]dockerConfigAuth{
+			registryHost(pc.registry): {
+				Username: pc.Username,
+				Password: pc.Password,
+				Auth: base64.StdEncoding.EncodeToString(
+					[]byte(fmt.Sprintf("%s:%s", pc.Username, pc.Password))),
+			},
+		},
+	})
+	if err != nil {
+		return err
+	}
+	annotations := make(map[string]string, len(pc.annotations)+1)
+	for k, v := range pc.annotations {
+		annotations[k] = v
+	}
+	if len(pc.targetNamespaces) > 0 {
+		annotations[globalregistry.TargetNamespacesAnnotation] = strings.Join(pc.targetNamespaces, ",")
+	}
+	secret := &corev1.Secret{
+		TypeMeta: metav1.TypeMeta{
+			Kind:       "Secret",
+			APIVersion: "v1",
+		},
+		Immutable: nil,
+		StringData: map[string]string{
+			corev1.DockerConfigJsonKey: string(dockerConfig),
+		},
+		Type: corev1.SecretTypeDockerConfigJson,
+	}
+	secret.SetName(pc.secretName)
+	secret.SetAnnotations(annotations)
+
+	return performer.WriteResource(ctx, secret)
+}
+
+// retargetCredentials side effect copies the stored credentials Secret of a
+// robot member to its new target namespaces. The credentials are not changed,
+// so the registry is not involved.
+type retargetCredentials struct {
+	secretName       string
+	targetNamespaces []string
+}
+
+var _ SideEffect = &retargetCredentials{}
+
+func (rc *retargetCredentials) Perform(ctx context.Context, performer SideEffectPerformer) error {
+	stored, err := performer.GetSecret(ctx, rc.secretName)
+	if err != nil {
+		return err
+	}
+	if stored == nil {
+		return fmt.Errorf("secret %s not found", rc.secretName)
+	}
+	annotations := make(map[string]string, len(stored.GetAnnotations())+1)
+	for k, v := range stored.GetAnnotations() {
+		if k != globalregistry.TargetNamespacesAnnotation {
+			annotations[k] = v
+		}
+	}
+	if len(rc.targetNamespaces) > 0 {
+		annotations[globalregistry.TargetNamespacesAnnotation] = strings.Join(rc.targetNamespaces, ",")
+	}
+	secret := &corev1.Secret{
+		TypeMeta: metav1.TypeMeta{
+			Kind:       "Secret",
+			APIVersion: "v1",
+		},
+		Data:       stored.Data,
+		StringData: stored.StringData,
+		Type:       stored.Type,
+	}
+	secret.SetName(rc.secretName)
+	secret.SetAnnotations(annotations)
+
+	return performer.WriteResource(ctx, secret)
+}
+
+// removeCredentials side effect removes the Secret containing the credentials
+// of a robot account. The copies of the Secret in the target namespaces are
+// removed by the SideEffectPerformer.
+type removeCredentials struct {
+	secretName string
+}
+
+var _ SideEffect = &removeCredentials{}
+
+func (rc *removeCredentials) Perform(ctx context.Context, performer SideEffectPerformer) error {
+	secret := &corev1.Secret{
+		TypeMeta: metav1.TypeMeta{
+			Kind:       "Secret",
+			APIVersion: "v1",
+		},
+	}
+	secret.SetName(rc.secretName)
+	return performer.RemoveResource(ctx, secret)
+}
+
+// RecordedStatusReader interface describes how the status recorded by
+// registryman outside of the registries is read. The annotations of the Secrets
+// containing the credentials and the data of the ConfigMaps are read. Missing
+// Secrets and ConfigMaps are reported as nil maps.
+type RecordedStatusReader interface {
+	GetSecretAnnotations(ctx context.Context, name string) (map[string]string, error)
+	GetConfigMapData(ctx context.Context, name string) (map[string]string, error)
+}
+
+// AddRecordedStatus completes the actual status of a registry with the status
+// recorded by registryman, i.e. the credential status of the robot members and
+// the ownership of the user groups.
+func AddRecordedStatus(ctx context.Context, rsr RecordedStatusReader, reg globalregistry.Registry, status *api.RegistryStatus) error {
+	err := addCredentialSecretStatus(ctx, rsr, reg, status)
+	if err != nil {
+		return err
+	}
+	return addUserGroupOwnership(ctx, rsr, reg, status)
+}
+
+// addCredentialSecretStatus completes the actual status of the robot members of
+// a registry with the data recorded in the annotations of their credential
+// Secrets, i.e. the time of the last credential rotation and the namespaces
+// where the Secret is copied to.
+func addCredentialSecretStatus(ctx context.Context, rsr RecordedStatusReader, reg globalregistry.Registry, status *api.RegistryStatus) error {
+	for i := range status.Projects {
+		project := &status.Projects[i]
+		for n := range project.Members {
+			member := &project.Members[n]
+			if member.Type != "Robot" {
+				continue
+			}
+			annotations, err := rsr.GetSecretAnnotations(ctx,
+				memberCredentialsSecretName(reg, project.Name, member.Name))
+			if err != nil {
+				return err
+			}
+			member.LastRotationTime = nil
+			if rotationTime, err := time.Parse(time.RFC3339,
+				annotations[globalregistry.RotationTimeAnnotation]); err == nil {
+				member.LastRotationTime = &metav1.Time{Time: rotationTime}
+			}
+			member.TargetNamespaces = globalregistry.ParseTargetNamespaces(
+				annotations[globalregistry.TargetNamespacesAnnotation])
+		}
+	}
+	return nil
+}
+
+// defaultRotateBefore is the number of days before the expiration when the
+// credentials are renewed, unless configured otherwise.
+const defaultRotateBefore = 7
+
+// rotationWindow returns how long before the expiration the credentials are
+// renewed. The window is at most the half of the lifetime, so that short-lived
+// credentials are not renewed continuously.
+func rotationWindow(rotateBefore, lifetimeDays int) time.Duration {
+	if rotateBefore == 0 {
+		rotateBefore = defaultRotateBefore
+	}
+	window := time.Duration(rotateBefore) * 24 * time.Hour
+	if lifetimeDays > 0 {
+		halfLifetime := time.Duration(lifetimeDays) * 12 * time.Hour
+		if window > halfLifetime {
+			window = halfLifetime
+		}
+	}
+	return window
+}
+
+// memberCredentialsSecretName returns the name of the Secret containing the
+// credentials of a robot project member.
+func memberCredentialsSecretName(reg globalregistry.Registry, projectName, memberName string) string {
+	return fmt.Sprintf("%s---%s---%s---creds",
+		reg.GetName(),
+		projectName,
+		memberName,
+	)
+}
+
+// robotAccountCredentialsSecretName returns the name of the Secret containing
+// the credentials of a registry level robot account.
+func robotAccountCredentialsSecretName(reg globalregistry.Registry, robotAccountName string) string {
+	return fmt.Sprintf("%s---%s---creds",
+		reg.GetName(),
+		robotAccountName,
+	)
+}
@@ -0,0 +1,132 @@
+/*
+   Copyright 2021 The Kubermatic Kubernetes Platform contributors.
+
+   Licensed under the Apache License, Version 2.0 (the "License");
+   you may not use this file except in compliance with the License.
+   You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+   Unless required by applicable law or agreed to in writing, software
+   distributed under the License is distributed on an "AS IS" BASIS,
+   WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+   See the License for the specific language governing permissions and
+   limitations under the License.
+*/
+
+package reconciler
+
+import (
+	"context"
+	"fmt"
+
+	api "github.com/kubermatic-labs/registryman/pkg/apis/registryman/v1alpha1"
+	"github.com/kubermatic-labs/registryman/pkg/globalregistry"
+)
+
+type immutableTagRuleAddAction struct {
+	projectName string
+	api.ImmutableTagRule
+}
+
+var _ Action = &immutableTagRuleAddAction{}
+
+func (a *immutableTagRuleAddAction) String() string {
+	return fmt.Sprintf("adding immutable tag rule to project %s: %s:%s",
+		a.projectName, a.RepositoriesPattern(), a.Tags)
+}
+
+func (a *immutableTagRuleAddAction) Perform(ctx context.Context, reg globalregistry.Registry) (SideEffect, error) {
+	project, err := reg.(globalregistry.RegistryWithProjects).GetProjectByName(ctx, a.projectName)
+	if err != nil {
+		return nilEffect, err
+	}
+	immutableTagManipulatorProject, ok := project.(globalregistry.ImmutableTagManipulatorProject)
+	if !ok {
+		return nilEffect, nil
+	}
+	return nilEffect, immutableTagManipulatorProject.AddImmutableTagRule(ctx, a.ImmutableTagRule)
+}
+
+type immutableTagRuleRemoveAction struct {
+	projectName string
+	api.ImmutableTagRule
+}
+
+var _ Action = &immutableTagRuleRemoveAction{}
+
+func (a *immutableTagRuleRemoveAction) String() string {
+	return fmt.Sprintf("removing immutable tag rule from project %s: %s:%s",
+		a.projectName, a.RepositoriesPattern(), a.Tags)
+}
+
+func (a *immutableTagRuleRemoveAction) Perform(ctx context.Context, reg globalregistry.Registry) (SideEffect, error) {
+	project, err := reg.(globalregistry.RegistryWithProjects).GetProjectByName(ctx, a.projectName)
+	if err != nil {
+		return nilEffect, err
+	}
+	immutableTagManipulatorProject, ok := project.(globalregistry.ImmutableTagManipulatorProject)
+	if !ok {
+		return nilEffect, nil
+	}
+	return nilEffect, immutableTagManipulatorProject.RemoveImmutableTagRule(ctx, a.ImmutableTagRule)
+}
+
+// immutableTagRuleKey identifies an immutable tag rule by its patterns.
+type immutableTagRuleKey struct {
+	repositories string
+	tags         string
+}
+
+func keyOfImmutableTagRule(rule api.ImmutableTagRule) immutableTagRuleKey {
+	return immutableTagRuleKey{
+		repositories: rule.RepositoriesPattern(),
+		tags:         rule.Tags,
+	}
+}
+
+// CompareImmutableTagStatuses compares the actual and expected immutable tag
+// rules of a project. The function returns the actions that are needed to
+// synchronize the actual state to the expected state. If the expected policy
+// is nil, the immutable tag rules of the project are not managed and no action
+// is returned.
+func CompareImmutableTagStatuses(projectName string, actual, expected *api.ImmutableTagPolicy, regCapabilities api.RegistryCapabilities) []Action {
+	actions := make([]Action, 0)
+
+	if !regCapabilities.CanManipulateProjectImmutableTags || expected == nil {
+		return actions
+	}
+	actualRules := make(map[immutableTagRuleKey]bool)
+	if actual != nil {
+		for _, rule := range actual.Rules {
+			actualRules[keyOfImmutableTagRule(rule)] = true
+		}
+	}
+	expectedRules := make(map[immutableTagRuleKey]bool)
+	for _, rule := range expected.Rules {
+		expectedRules[keyOfImmutableTagRule(rule)] = true
+	}
+
+	// rules which are there but are not needed
+	if actual != nil {
+		for _, rule := range actual.Rules {
+			if !expectedRules[keyOfImmutableTagRule(rule)] {
+				actions = append(actions, &immutableTagRuleRemoveAction{
+					projectName:      projectName,
+					ImmutableTagRule: rule,
+				})
+			}
+		}
+	}
+
+	// rules which are missing
+	for _, rule := range expected.Rules {
+		if !actualRules[keyOfImmutableTagRule(rule)] {
+			actions = append(actions, &immutableTagRuleAddAction{
+				projectName:      projectName,
+				ImmutableTagRule: rule,
+			})
+		}
+	}
+	return actions
+}
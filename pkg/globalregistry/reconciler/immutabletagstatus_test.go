@@ -0,0 +1,97 @@
+/*
+   Copyright 2021 The Kubermatic Kubernetes Platform contributors.
+
+   Licensed under the Apache License, Version 2.0 (the "License");
+   you may not use this file except in compliance with the License.
+   You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+   Unless required by applicable law or agreed to in writing, software
+   distributed under the License is distributed on an "AS IS" BASIS,
+   WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+   See the License for the specific language governing permissions and
+   limitations under the License.
+*/
+
+package reconciler_test
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	api "github.com/kubermatic-labs/registryman/pkg/apis/registryman/v1alpha1"
+	"github.com/kubermatic-labs/registryman/pkg/globalregistry/reconciler"
+)
+
+var _ = Describe("ImmutableTagStatus", func() {
+	capabilities := api.RegistryCapabilities{
+		CanManipulateProjectImmutableTags: true,
+	}
+
+	It("returns no action when the immutable tag rules are not managed", func() {
+		act := &api.ImmutableTagPolicy{
+			Rules: []api.ImmutableTagRule{
+				{Tags: "v*"},
+			},
+		}
+		actions := reconciler.CompareImmutableTagStatuses("proj", act, nil, capabilities)
+		Expect(actions).ToNot(BeNil())
+		Expect(len(actions)).To(Equal(0))
+	})
+
+	It("returns no action for the same rules", func() {
+		act := &api.ImmutableTagPolicy{
+			Rules: []api.ImmutableTagRule{
+				{Repositories: "**", Tags: "v*"},
+			},
+		}
+		exp := &api.ImmutableTagPolicy{
+			Rules: []api.ImmutableTagRule{
+				{Tags: "v*"},
+			},
+		}
+		actions := reconciler.CompareImmutableTagStatuses("proj", act, exp, capabilities)
+		Expect(actions).ToNot(BeNil())
+		Expect(len(actions)).To(Equal(0))
+	})
+
+	It("adds the missing and removes the surplus rules", func() {
+		act := &api.ImmutableTagPolicy{
+			Rules: []api.ImmutableTagRule{
+				{Repositories: "**", Tags: "release-*"},
+			},
+		}
+		exp := &api.ImmutableTagPolicy{
+			Rules: []api.ImmutableTagRule{
+				{Tags: "v*"},
+			},
+		}
+		actions := reconciler.CompareImmutableTagStatuses("proj", act, exp, capabilities)
+		Expect(actionsToStrings(actions)).To(Equal([]string{
+			"removing immutable tag rule from project proj: **:release-*",
+			"adding immutable tag rule to project proj: **:v*",
+		}))
+	})
+
+	It("adds the rules of the new projects", func() {
+		exp := []api.ProjectStatus{
+			{
+				Name: "proj",
+				ImmutableTags: &api.ImmutableTagPolicy{
+					Rules: []api.ImmutableTagRule{
+						{Repositories: "apps/**", Tags: "v*"},
+					},
+				},
+			},
+		}
+		actions := reconciler.CompareProjectStatuses(nil, []api.ProjectStatus{}, exp, api.RegistryCapabilities{
+			CanCreateProject:                  true,
+			CanManipulateProjectImmutableTags: true,
+		})
+		Expect(actionsToStrings(actions)).To(Equal([]string{
+			"adding project proj",
+			"adding immutable tag rule to project proj: apps/**:v*",
+		}))
+	})
+})
@@ -17,23 +17,22 @@
 package reconciler
 
 import (
-	"bytes"
 	"context"
 	"fmt"
-
-	"encoding/base64"
+	"time"
 
 	api "github.com/kubermatic-labs/registryman/pkg/apis/registryman/v1alpha1"
 	"github.com/kubermatic-labs/registryman/pkg/globalregistry"
-	corev1 "k8s.io/api/core/v1"
-	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
 func toProjectMember(ms *api.MemberStatus) globalregistry.ProjectMember {
-	if ms.DN == "" {
-		return (*projectMemberStatus)(ms)
-	} else {
+	switch {
+	case ms.DN != "":
 		return (*ldapStatus)(ms)
+	case ms.GroupType != "":
+		return (*groupStatus)(ms)
+	default:
+		return (*projectMemberStatus)(ms)
 	}
 }
 
@@ -53,6 +52,12 @@ func (m *projectMemberStatus) GetType() string {
 	return m.Type
 }
 
+var _ globalregistry.MemberWithRotationPolicy = &projectMemberStatus{}
+
+func (m *projectMemberStatus) GetRotationPolicy() *api.CredentialRotation {
+	return m.Rotation
+}
+
 type ldapStatus api.MemberStatus
 
 var _ globalregistry.LdapMember = &ldapStatus{}
@@ -73,6 +78,32 @@ func (m *ldapStatus) GetDN() string {
 	return m.DN
 }
 
+var _ globalregistry.GroupMember = &ldapStatus{}
+
+func (m *ldapStatus) GetGroupType() string {
+	return string(api.LdapGroupType)
+}
+
+type groupStatus api.MemberStatus
+
+var _ globalregistry.GroupMember = &groupStatus{}
+
+func (m *groupStatus) GetName() string {
+	return m.Name
+}
+
+func (m *groupStatus) GetRole() string {
+	return m.Role
+}
+
+func (m *groupStatus) GetType() string {
+	return m.Type
+}
+
+func (m *groupStatus) GetGroupType() string {
+	return m.GroupType
+}
+
 type memberAddAction struct {
 	api.MemberStatus
 	projectName string
@@ -85,51 +116,6 @@ func (ma *memberAddAction) String() string {
 		ma.Name, ma.projectName)
 }
 
-type persistMemberCredentials struct {
-	globalregistry.ProjectMemberCredentials
-	action   *memberAddAction
-	registry globalregistry.Registry
-}
-
-var _ SideEffect = &persistMemberCredentials{}
-
-func (pmc *persistMemberCredentials) Perform(ctx context.Context, performer SideEffectPerformer) error {
-	buf := bytes.NewBuffer(nil)
-	encoder := base64.NewEncoder(base64.StdEncoding, buf)
-	_, err := fmt.Fprintf(encoder, "%s:%s",
-		pmc.Username, pmc.Password,
-	)
-	if err != nil {
-		return err
-	}
-	dockerConfigJson := fmt.Sprintf("{\"auths\": {\"%s\": {\"auth\": \"%s\"}}}",
-		pmc.registry.GetAPIEndpoint(), buf.String(),
-	)
-	secret := &corev1.Secret{
-		TypeMeta: metav1.TypeMeta{
-			Kind:       "Secret",
-			APIVersion: "v1",
-		},
-		Immutable: nil,
-		StringData: map[string]string{
-			".dockerconfigjson": dockerConfigJson,
-		},
-		Type: "kubernetes.io/dockerconfigjson",
-	}
-	name := fmt.Sprintf("%s---%s---%s---creds",
-		pmc.registry.GetName(),
-		pmc.action.projectName,
-		pmc.action.Name,
-	)
-	secret.SetName(name)
-	secret.SetAnnotations(map[string]string{
-		"globalregistry.org/project-name":  pmc.action.projectName,
-		"globalregistry.org/registry-name": pmc.registry.GetName(),
-	})
-
-	return performer.WriteResource(ctx, secret)
-}
-
 func (ma *memberAddAction) Perform(ctx context.Context, reg globalregistry.Registry) (SideEffect, error) {
 	project, err := reg.(globalregistry.RegistryWithProjects).GetProjectByName(ctx, ma.projectName)
 	if err != nil {
@@ -148,37 +134,105 @@ func (ma *memberAddAction) Perform(ctx context.Context, reg globalregistry.Regis
 	if err != nil {
 		return nilEffect, err
 	}
-	if creds != nil {
-		return &persistMemberCredentials{
-			ProjectMemberCredentials: *creds,
-			action:                   ma,
-			registry:                 reg,
-		}, nil
+	return persistMemberCredentials(reg, ma.projectName, &ma.MemberStatus, creds), nil
+}
+
+// persistMemberCredentials returns the side effect which stores the
+// credentials of a project member. If a Secret with the credentials of the
+// member exists, it is updated in place. The Secret records the time of the
+// rotation and it is copied to the target namespaces of the member.
+func persistMemberCredentials(reg globalregistry.Registry, projectName string, member *api.MemberStatus, creds *globalregistry.ProjectMemberCredentials) SideEffect {
+	if creds == nil {
+		return nilEffect
+	}
+	return &persistCredentials{
+		ProjectMemberCredentials: *creds,
+		registry:                 reg,
+		secretName:               memberCredentialsSecretName(reg, projectName, member.Name),
+		annotations: map[string]string{
+			"globalregistry.org/project-name":     projectName,
+			"globalregistry.org/registry-name":    reg.GetName(),
+			globalregistry.RotationTimeAnnotation: time.Now().UTC().Format(time.RFC3339),
+		},
+		targetNamespaces: member.TargetNamespaces,
 	}
-	return nilEffect, nil
 }
 
-type removeMemberCredentials struct {
-	action   *memberRemoveAction
-	registry globalregistry.Registry
+// memberRotateAction rotates the credentials of a project member. If renew is
+// set, the member is recreated, so that its expiration is renewed as well.
+// Otherwise, only its secret is refreshed.
+type memberRotateAction struct {
+	api.MemberStatus
+	projectName string
+	renew       bool
 }
 
-var _ SideEffect = &removeMemberCredentials{}
+var _ Action = &memberRotateAction{}
 
-func (rmc *removeMemberCredentials) Perform(ctx context.Context, performer SideEffectPerformer) error {
-	secret := &corev1.Secret{
-		TypeMeta: metav1.TypeMeta{
-			Kind:       "Secret",
-			APIVersion: "v1",
-		},
+func (ma *memberRotateAction) String() string {
+	if ma.renew {
+		return fmt.Sprintf("renewing expiring member %s of %s",
+			ma.Name, ma.projectName)
 	}
-	name := fmt.Sprintf("%s---%s---%s---creds",
-		rmc.registry.GetName(),
-		rmc.action.projectName,
-		rmc.action.Name,
-	)
-	secret.SetName(name)
-	return performer.RemoveResource(ctx, secret)
+	return fmt.Sprintf("rotating credentials of member %s of %s",
+		ma.Name, ma.projectName)
+}
+
+func (ma *memberRotateAction) Perform(ctx context.Context, reg globalregistry.Registry) (SideEffect, error) {
+	project, err := reg.(globalregistry.RegistryWithProjects).GetProjectByName(ctx, ma.projectName)
+	if err != nil {
+		return nilEffect, err
+	}
+	if project == nil {
+		// project not found
+		return nilEffect, fmt.Errorf("project %s not found", ma.projectName)
+	}
+	var creds *globalregistry.ProjectMemberCredentials
+	if ma.renew {
+		memberManipulatorProject, ok := project.(globalregistry.MemberManipulatorProject)
+		if !ok {
+			// registry does not support projects with members
+			return nilEffect, nil
+		}
+		err = memberManipulatorProject.UnassignMember(ctx, toProjectMember(&ma.MemberStatus))
+		if err != nil {
+			return nilEffect, err
+		}
+		creds, err = memberManipulatorProject.AssignMember(ctx, toProjectMember(&ma.MemberStatus))
+	} else {
+		credentialRotatorProject, ok := project.(globalregistry.CredentialRotatorProject)
+		if !ok {
+			// registry does not support credential rotation
+			return nilEffect, nil
+		}
+		creds, err = credentialRotatorProject.RotateMemberCredentials(ctx, toProjectMember(&ma.MemberStatus))
+	}
+	if err != nil {
+		return nilEffect, err
+	}
+	return persistMemberCredentials(reg, ma.projectName, &ma.MemberStatus, creds), nil
+}
+
+// memberRetargetAction copies the credentials Secret of a robot member to its
+// new target namespaces. The member and its credentials are kept as they are
+// in the registry.
+type memberRetargetAction struct {
+	api.MemberStatus
+	projectName string
+}
+
+var _ Action = &memberRetargetAction{}
+
+func (ma *memberRetargetAction) String() string {
+	return fmt.Sprintf("updating target namespaces of member %s of %s",
+		ma.Name, ma.projectName)
+}
+
+func (ma *memberRetargetAction) Perform(ctx context.Context, reg globalregistry.Registry) (SideEffect, error) {
+	return &retargetCredentials{
+		secretName:       memberCredentialsSecretName(reg, ma.projectName, ma.Name),
+		targetNamespaces: ma.TargetNamespaces,
+	}, nil
 }
 
 type memberRemoveAction struct {
@@ -208,24 +262,85 @@ func (ma *memberRemoveAction) Perform(ctx context.Context, reg globalregistry.Re
 		return nilEffect, err
 	}
 	if ma.Type == "Robot" {
-		return &removeMemberCredentials{
-			action:   ma,
-			registry: reg,
+		return &removeCredentials{
+			secretName: memberCredentialsSecretName(reg, ma.projectName, ma.Name),
 		}, nil
 	}
 	return nilEffect, nil
 }
 
+// memberEquals checks whether the members have the same identity and role. The
+// credential related fields are ignored.
+func memberEquals(a, b api.MemberStatus) bool {
+	return a.Name == b.Name &&
+		a.Type == b.Type &&
+		a.Role == b.Role &&
+		a.DN == b.DN &&
+		a.GroupType == b.GroupType
+}
+
+// memberNeedsRotation checks whether the credentials of the actual member shall
+// be rotated according to the rotation policy of the expected member. The
+// member shall be renewed if it expires within the rotation window or if its
+// lifetime differs from the expected one. Its secret shall be refreshed if it
+// was last rotated more than RotateEvery days ago or if the time of the last
+// rotation is not known.
+func memberNeedsRotation(actual, expected api.MemberStatus) (rotate, renew bool) {
+	policy := expected.Rotation
+	if policy == nil {
+		return false, false
+	}
+	if actual.Rotation != nil && actual.Rotation.ExpiresIn != policy.ExpiresIn {
+		return true, true
+	}
+	if actual.ExpiresAt != nil &&
+		time.Until(actual.ExpiresAt.Time) < rotationWindow(policy.RotateBefore, policy.ExpiresIn) {
+		return true, true
+	}
+	if policy.RotateEvery > 0 && (actual.LastRotationTime == nil ||
+		time.Since(actual.LastRotationTime.Time) >= time.Duration(policy.RotateEvery)*24*time.Hour) {
+		return true, false
+	}
+	return false, false
+}
+
+// targetNamespacesDiffer checks whether the credentials Secret of the actual
+// robot member is copied to other namespaces than the expected ones. The order
+// of the namespaces is ignored.
+func targetNamespacesDiffer(actual, expected api.MemberStatus) bool {
+	if expected.Type != "Robot" {
+		return false
+	}
+	if len(actual.TargetNamespaces) != len(expected.TargetNamespaces) {
+		return true
+	}
+	actualNamespaces := make(map[string]bool, len(actual.TargetNamespaces))
+	for _, ns := range actual.TargetNamespaces {
+		actualNamespaces[ns] = true
+	}
+	for _, ns := range expected.TargetNamespaces {
+		if !actualNamespaces[ns] {
+			return true
+		}
+	}
+	return false
+}
+
 // CompareMemberStatuses compares the actual and expected status of the members
 // of a project. The function returns the actions that are needed to synchronize
-// the actual state to the expected state.
+// the actual state to the expected state, including the rotation of the
+// credentials which are about to expire or which are too old. When only the
+// target namespaces of the credentials Secret have changed, the stored Secret
+// is copied to the expected namespaces without touching the registry.
 func CompareMemberStatuses(projectName string, actual, expected []api.MemberStatus, regCapabilities api.RegistryCapabilities) []Action {
 	actualDiff := []api.MemberStatus{}
 	expectedDiff := []api.MemberStatus{}
+	rotations := []*memberRotateAction{}
+	retargets := []*memberRetargetAction{}
 ActLoop:
 	for _, act := range actual {
 		for _, exp := range expected {
-			if act == exp {
+			if memberEquals(act, exp) {
 				continue ActLoop
 			}
 		}
@@ -235,7 +350,19 @@ ActLoop:
 ExpLoop:
 	for _, exp := range expected {
 		for _, act := range actual {
-			if act == exp {
+			if memberEquals(act, exp) {
+				if rotate, renew := memberNeedsRotation(act, exp); rotate {
+					rotations = append(rotations, &memberRotateAction{
+						MemberStatus: exp,
+						projectName:  projectName,
+						renew:        renew,
+					})
+				} else if targetNamespacesDiffer(act, exp) {
+					retargets = append(retargets, &memberRetargetAction{
+						MemberStatus: exp,
+						projectName:  projectName,
+					})
+				}
 				continue ExpLoop
 			}
 		}
@@ -262,5 +389,20 @@ ExpLoop:
 		}
 	}
 
+	for _, rotation := range rotations {
+		if (rotation.renew && regCapabilities.CanManipulateProjectMembers) ||
+			(!rotation.renew && regCapabilities.CanRotateProjectMemberCredentials) {
+			actions = append(actions, rotation)
+		}
+	}
+
+	// the credentials Secrets exist only for the members managed by
+	// registryman
+	if regCapabilities.CanManipulateProjectMembers {
+		for _, retarget := range retargets {
+			actions = append(actions, retarget)
+		}
+	}
+
 	return actions
 }
@@ -17,10 +17,18 @@
 package reconciler_test
 
 import (
+	"context"
+	"time"
+
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
 
 	api "github.com/kubermatic-labs/registryman/pkg/apis/registryman/v1alpha1"
+	"github.com/kubermatic-labs/registryman/pkg/config/registry"
+	"github.com/kubermatic-labs/registryman/pkg/globalregistry"
 	"github.com/kubermatic-labs/registryman/pkg/globalregistry/reconciler"
 )
 
@@ -164,4 +172,308 @@ var _ = Describe("Memberstatus", func() {
 			"adding member alpha to proj",
 		}))
 	})
+
+	It("can detect group members with different group type", func() {
+		ldapGroup := api.MemberStatus{
+			Name:      "developers",
+			Type:      "Group",
+			Role:      "Developer",
+			GroupType: "LDAP",
+			DN:        "cn=developers,ou=groups,dc=example,dc=com",
+		}
+		oidcGroup := api.MemberStatus{
+			Name:      "developers",
+			Type:      "Group",
+			Role:      "Developer",
+			GroupType: "OIDC",
+		}
+		actions := reconciler.CompareMemberStatuses("proj",
+			[]api.MemberStatus{ldapGroup}, []api.MemberStatus{ldapGroup}, api.RegistryCapabilities{
+				CanManipulateProjectMembers: true,
+			})
+		Expect(actions).ToNot(BeNil())
+		Expect(len(actions)).To(Equal(0))
+
+		actions = reconciler.CompareMemberStatuses("proj",
+			[]api.MemberStatus{ldapGroup}, []api.MemberStatus{oidcGroup}, api.RegistryCapabilities{
+				CanManipulateProjectMembers: true,
+			})
+		Expect(actionsToStrings(actions)).To(Equal([]string{
+			"removing member developers from proj",
+			"adding member developers to proj",
+		}))
+	})
+
+	It("updates the target namespaces of an existing robot member", func() {
+		expected := api.MemberStatus{
+			Name:             "robot",
+			Type:             "Robot",
+			Role:             "Developer",
+			TargetNamespaces: []string{"app", "ci"},
+		}
+		actual := expected
+		actual.TargetNamespaces = []string{"ci", "app"}
+		allCaps := api.RegistryCapabilities{
+			CanManipulateProjectMembers:       true,
+			CanRotateProjectMemberCredentials: true,
+		}
+		actions := reconciler.CompareMemberStatuses("proj",
+			[]api.MemberStatus{actual}, []api.MemberStatus{expected}, allCaps)
+		Expect(actions).ToNot(BeNil())
+		Expect(len(actions)).To(Equal(0))
+
+		actual.TargetNamespaces = []string{"app"}
+		actions = reconciler.CompareMemberStatuses("proj",
+			[]api.MemberStatus{actual}, []api.MemberStatus{expected}, allCaps)
+		Expect(actionsToStrings(actions)).To(Equal([]string{
+			"updating target namespaces of member robot of proj",
+		}))
+
+		actions = reconciler.CompareMemberStatuses("proj",
+			[]api.MemberStatus{actual}, []api.MemberStatus{expected}, api.RegistryCapabilities{
+				CanManipulateProjectMembers: true,
+			})
+		Expect(actionsToStrings(actions)).To(Equal([]string{
+			"updating target namespaces of member robot of proj",
+		}))
+
+		actions = reconciler.CompareMemberStatuses("proj",
+			[]api.MemberStatus{actual}, []api.MemberStatus{expected}, api.RegistryCapabilities{})
+		Expect(actions).ToNot(BeNil())
+		Expect(len(actions)).To(Equal(0))
+	})
+
+	It("copies the stored Secret to the new target namespaces without calling the registry", func() {
+		expected := api.MemberStatus{
+			Name:             "robot",
+			Type:             "Robot",
+			Role:             "Developer",
+			TargetNamespaces: []string{"app", "ci"},
+		}
+		actual := expected
+		actual.TargetNamespaces = []string{"app"}
+		actions := reconciler.CompareMemberStatuses("proj",
+			[]api.MemberStatus{actual}, []api.MemberStatus{expected}, api.RegistryCapabilities{
+				CanManipulateProjectMembers: true,
+			})
+		Expect(len(actions)).To(Equal(1))
+
+		stored := &corev1.Secret{
+			Data: map[string][]byte{
+				corev1.DockerConfigJsonKey: []byte("{}"),
+			},
+			Type: corev1.SecretTypeDockerConfigJson,
+		}
+		stored.SetName("reg---proj---robot---creds")
+		stored.SetAnnotations(map[string]string{
+			globalregistry.RotationTimeAnnotation:     "2022-01-01T00:00:00Z",
+			globalregistry.TargetNamespacesAnnotation: "app",
+		})
+		store := &secretStore{secrets: map[string]*corev1.Secret{stored.GetName(): stored}}
+		// the registry panics if any of its methods other than GetName is called
+		sideEffect, err := actions[0].Perform(context.Background(), nameOnlyRegistry{name: "reg"})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(sideEffect.Perform(context.Background(), store)).To(Succeed())
+
+		written := store.secrets[stored.GetName()]
+		Expect(written.Data).To(Equal(stored.Data))
+		Expect(written.Type).To(Equal(stored.Type))
+		Expect(written.GetAnnotations()).To(Equal(map[string]string{
+			globalregistry.RotationTimeAnnotation:     "2022-01-01T00:00:00Z",
+			globalregistry.TargetNamespacesAnnotation: "app,ci",
+		}))
+	})
+
+	Context("with credential rotation policy", func() {
+		var (
+			robot   api.MemberStatus
+			actual  api.MemberStatus
+			allCaps api.RegistryCapabilities
+		)
+		BeforeEach(func() {
+			robot = api.MemberStatus{
+				Name: "robot",
+				Type: "Robot",
+				Role: "Developer",
+				Rotation: &api.CredentialRotation{
+					ExpiresIn:   30,
+					RotateEvery: 10,
+				},
+			}
+			actual = robot
+			actual.Rotation = nil
+			actual.ExpiresAt = &metav1.Time{Time: time.Now().Add(20 * 24 * time.Hour)}
+			actual.LastRotationTime = &metav1.Time{Time: time.Now().Add(-24 * time.Hour)}
+			allCaps = api.RegistryCapabilities{
+				CanManipulateProjectMembers:       true,
+				CanRotateProjectMemberCredentials: true,
+			}
+		})
+
+		It("returns no action for fresh credentials", func() {
+			actions := reconciler.CompareMemberStatuses("proj",
+				[]api.MemberStatus{actual}, []api.MemberStatus{robot}, allCaps)
+			Expect(actions).ToNot(BeNil())
+			Expect(len(actions)).To(Equal(0))
+		})
+
+		It("returns no action without rotation policy", func() {
+			robot.Rotation = nil
+			actual.LastRotationTime = &metav1.Time{Time: time.Now().Add(-100 * 24 * time.Hour)}
+			actual.ExpiresAt = &metav1.Time{Time: time.Now().Add(time.Hour)}
+			actions := reconciler.CompareMemberStatuses("proj",
+				[]api.MemberStatus{actual}, []api.MemberStatus{robot}, allCaps)
+			Expect(actions).ToNot(BeNil())
+			Expect(len(actions)).To(Equal(0))
+		})
+
+		It("rotates old credentials", func() {
+			actual.LastRotationTime = &metav1.Time{Time: time.Now().Add(-11 * 24 * time.Hour)}
+			actions := reconciler.CompareMemberStatuses("proj",
+				[]api.MemberStatus{actual}, []api.MemberStatus{robot}, allCaps)
+			Expect(actionsToStrings(actions)).To(Equal([]string{
+				"rotating credentials of member robot of proj",
+			}))
+
+			actions = reconciler.CompareMemberStatuses("proj",
+				[]api.MemberStatus{actual}, []api.MemberStatus{robot}, api.RegistryCapabilities{
+					CanManipulateProjectMembers: true,
+				})
+			Expect(actions).ToNot(BeNil())
+			Expect(len(actions)).To(Equal(0))
+		})
+
+		It("renews expiring credentials", func() {
+			actual.ExpiresAt = &metav1.Time{Time: time.Now().Add(6 * 24 * time.Hour)}
+			actions := reconciler.CompareMemberStatuses("proj",
+				[]api.MemberStatus{actual}, []api.MemberStatus{robot}, allCaps)
+			Expect(actionsToStrings(actions)).To(Equal([]string{
+				"renewing expiring member robot of proj",
+			}))
+		})
+
+		It("rotates credentials without recorded rotation time", func() {
+			actual.LastRotationTime = nil
+			actions := reconciler.CompareMemberStatuses("proj",
+				[]api.MemberStatus{actual}, []api.MemberStatus{robot}, allCaps)
+			Expect(actionsToStrings(actions)).To(Equal([]string{
+				"rotating credentials of member robot of proj",
+			}))
+		})
+
+		It("renews the member when its lifetime changes", func() {
+			actual.Rotation = &api.CredentialRotation{ExpiresIn: 30}
+			actions := reconciler.CompareMemberStatuses("proj",
+				[]api.MemberStatus{actual}, []api.MemberStatus{robot}, allCaps)
+			Expect(actions).ToNot(BeNil())
+			Expect(len(actions)).To(Equal(0))
+
+			robot.Rotation.ExpiresIn = 60
+			actions = reconciler.CompareMemberStatuses("proj",
+				[]api.MemberStatus{actual}, []api.MemberStatus{robot}, allCaps)
+			Expect(actionsToStrings(actions)).To(Equal([]string{
+				"renewing expiring member robot of proj",
+			}))
+		})
+
+		It("caps the rotation window at half of the lifetime", func() {
+			robot.Rotation.ExpiresIn = 4
+			robot.Rotation.RotateEvery = 0
+			actual.ExpiresAt = &metav1.Time{Time: time.Now().Add(3 * 24 * time.Hour)}
+			actions := reconciler.CompareMemberStatuses("proj",
+				[]api.MemberStatus{actual}, []api.MemberStatus{robot}, allCaps)
+			Expect(actions).ToNot(BeNil())
+			Expect(len(actions)).To(Equal(0))
+
+			actual.ExpiresAt = &metav1.Time{Time: time.Now().Add(time.Hour)}
+			actions = reconciler.CompareMemberStatuses("proj",
+				[]api.MemberStatus{actual}, []api.MemberStatus{robot}, allCaps)
+			Expect(actionsToStrings(actions)).To(Equal([]string{
+				"renewing expiring member robot of proj",
+			}))
+		})
+	})
+})
+
+// nameOnlyRegistry is a registry of the tests which knows only its name. Any
+// other method call panics.
+type nameOnlyRegistry struct {
+	globalregistry.Registry
+	name string
+}
+
+func (r nameOnlyRegistry) GetName() string {
+	return r.name
+}
+
+// secretStore is the SideEffectPerformer of the tests. It keeps the Secrets in
+// memory.
+type secretStore struct {
+	secrets map[string]*corev1.Secret
+}
+
+func (ss *secretStore) WriteResource(_ context.Context, obj runtime.Object) error {
+	secret := obj.(*corev1.Secret)
+	ss.secrets[secret.GetName()] = secret
+	return nil
+}
+
+func (ss *secretStore) RemoveResource(_ context.Context, obj runtime.Object) error {
+	delete(ss.secrets, obj.(*corev1.Secret).GetName())
+	return nil
+}
+
+func (ss *secretStore) GetSecret(_ context.Context, name string) (*corev1.Secret, error) {
+	return ss.secrets[name], nil
+}
+
+// recordedStatus is the RecordedStatusReader of the tests. The maps are keyed
+// by the names of the Secrets and ConfigMaps.
+type recordedStatus struct {
+	secretAnnotations map[string]map[string]string
+	configMaps        map[string]map[string]string
+}
+
+func (rs recordedStatus) GetSecretAnnotations(_ context.Context, name string) (map[string]string, error) {
+	return rs.secretAnnotations[name], nil
+}
+
+func (rs recordedStatus) GetConfigMapData(_ context.Context, name string) (map[string]string, error) {
+	return rs.configMaps[name], nil
+}
+
+var _ = Describe("AddRecordedStatus", func() {
+	It("reads the credential status of the robot members from their Secrets", func() {
+		rotationTime := time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC)
+		reg := registry.New(&api.Registry{
+			ObjectMeta: metav1.ObjectMeta{Name: "reg"},
+		}, nil)
+		status := &api.RegistryStatus{
+			Projects: []api.ProjectStatus{
+				{
+					Name: "proj",
+					Members: []api.MemberStatus{
+						{Name: "robot", Type: "Robot"},
+						{Name: "new-robot", Type: "Robot"},
+						{Name: "alpha", Type: "User"},
+					},
+				},
+			},
+		}
+		err := reconciler.AddRecordedStatus(context.Background(), recordedStatus{
+			secretAnnotations: map[string]map[string]string{
+				"reg---proj---robot---creds": {
+					globalregistry.RotationTimeAnnotation:     rotationTime.Format(time.RFC3339),
+					globalregistry.TargetNamespacesAnnotation: "app,ci",
+				},
+			},
+		}, reg, status)
+		Expect(err).ToNot(HaveOccurred())
+		members := status.Projects[0].Members
+		Expect(members[0].LastRotationTime.Time.Equal(rotationTime)).To(BeTrue())
+		Expect(members[0].TargetNamespaces).To(Equal([]string{"app", "ci"}))
+		Expect(members[1].LastRotationTime).To(BeNil())
+		Expect(members[1].TargetNamespaces).To(BeNil())
+		Expect(members[2].LastRotationTime).To(BeNil())
+	})
 })
@@ -141,6 +141,22 @@ ExpLoop:
 				regCapabilities,
 			)...,
 		)
+		actions = append(actions,
+			CompareImmutableTagStatuses(
+				projectName,
+				projectPair[0].ImmutableTags,
+				projectPair[1].ImmutableTags,
+				regCapabilities,
+			)...,
+		)
+		actions = append(actions,
+			CompareWebhookStatuses(
+				projectName,
+				projectPair[0].Webhooks,
+				projectPair[1].Webhooks,
+				regCapabilities,
+			)...,
+		)
 		actions = append(actions,
 			CompareScannerStatuses(
 				projectName,
@@ -149,6 +165,30 @@ ExpLoop:
 				regCapabilities,
 			)...,
 		)
+		actions = append(actions,
+			CompareStorageQuotaStatuses(
+				projectName,
+				projectPair[0].StorageQuota,
+				projectPair[1].StorageQuota,
+				regCapabilities,
+			)...,
+		)
+		actions = append(actions,
+			CompareProjectSettingsStatuses(
+				projectName,
+				projectPair[0].Settings,
+				projectPair[1].Settings,
+				regCapabilities,
+			)...,
+		)
+		actions = append(actions,
+			CompareRetentionStatuses(
+				projectName,
+				projectPair[0].Retention,
+				projectPair[1].Retention,
+				regCapabilities,
+			)...,
+		)
 	}
 	// expectedDiff contains the projects which are missing and thus they
 	// shall be created
@@ -175,6 +215,24 @@ ExpLoop:
 				})
 			}
 		}
+		if regCapabilities.CanManipulateProjectImmutableTags {
+			if exp.ImmutableTags != nil {
+				for _, rule := range exp.ImmutableTags.Rules {
+					actions = append(actions, &immutableTagRuleAddAction{
+						projectName:      exp.Name,
+						ImmutableTagRule: rule,
+					})
+				}
+			}
+		}
+		if regCapabilities.CanManipulateProjectWebhooks {
+			for _, webhook := range exp.Webhooks {
+				actions = append(actions, &webhookAddAction{
+					projectName:   exp.Name,
+					WebhookStatus: webhook,
+				})
+			}
+		}
 		if regCapabilities.CanManipulateProjectScanners {
 			if exp.ScannerStatus.Name != "" {
 				actions = append(actions, &scannerAssignAction{
@@ -183,6 +241,32 @@ ExpLoop:
 				})
 			}
 		}
+		if regCapabilities.CanManipulateProjectStorageQuota {
+			if exp.StorageQuota >= 0 {
+				actions = append(actions, &storageQuotaAddAction{
+					projectName:  exp.Name,
+					storageQuota: exp.StorageQuota,
+				})
+			}
+		}
+		if regCapabilities.CanManipulateProjectSettings {
+			if exp.Settings != nil {
+				actions = append(actions, &settingsUpdateAction{
+					projectName: exp.Name,
+					settings:    exp.Settings,
+				})
+			}
+		}
+		if regCapabilities.CanManipulateProjectRetention {
+			if exp.Retention != nil {
+				for _, rule := range exp.Retention.Rules {
+					actions = append(actions, &retentionRuleAddAction{
+						projectName:   exp.Name,
+						RetentionRule: rule,
+					})
+				}
+			}
+		}
 	}
 
 	return actions
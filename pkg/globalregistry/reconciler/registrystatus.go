@@ -28,7 +28,11 @@ import (
 // returns the actions that are needed to synchronize the actual state to the
 // expected state.
 func Compare(store *config.ExpectedProvider, actual, expected *api.RegistryStatus) []Action {
-	return CompareProjectStatuses(store, actual.Projects, expected.Projects, actual.Capabilities)
+	actions := CompareProjectStatuses(store, actual.Projects, expected.Projects, actual.Capabilities)
+	actions = append(actions,
+		CompareRobotAccountStatuses(actual.RobotAccounts, expected.RobotAccounts, actual.Capabilities)...)
+	return append(actions,
+		CompareUserGroupStatuses(actual.UserGroups, expected.UserGroups, actual.Capabilities)...)
 }
 
 func getRegistryCapabilities(ctx context.Context, reg globalregistry.Registry) (api.RegistryCapabilities, error) {
@@ -38,6 +42,18 @@ func getRegistryCapabilities(ctx context.Context, reg globalregistry.Registry) (
 		CanPullReplicate: replCap.CanPull(),
 		CanPushReplicate: replCap.CanPush(),
 	}
+	if _, ok := reg.(globalregistry.RegistryWithRobotAccounts); ok {
+		registryCapabilities.HasRobotAccounts = true
+	}
+	if _, ok := reg.(globalregistry.RobotAccountManipulatorRegistry); ok {
+		registryCapabilities.CanManipulateRobotAccounts = true
+	}
+	if _, ok := reg.(globalregistry.RegistryWithUserGroups); ok {
+		registryCapabilities.HasUserGroups = true
+	}
+	if _, ok := reg.(globalregistry.UserGroupManipulatorRegistry); ok {
+		registryCapabilities.CanManipulateUserGroups = true
+	}
 	dummyProject, err := regWithProjects.GetProjectByName(ctx, "")
 	if err != nil {
 		return registryCapabilities, err
@@ -55,6 +71,9 @@ func getRegistryCapabilities(ctx context.Context, reg globalregistry.Registry) (
 	if _, ok := dummyProject.(globalregistry.MemberManipulatorProject); ok {
 		registryCapabilities.CanManipulateProjectMembers = true
 	}
+	if _, ok := dummyProject.(globalregistry.CredentialRotatorProject); ok {
+		registryCapabilities.CanRotateProjectMemberCredentials = true
+	}
 	if _, ok := dummyProject.(globalregistry.ProjectWithScanner); ok {
 		registryCapabilities.HasProjectScanners = true
 	}
@@ -70,6 +89,36 @@ func getRegistryCapabilities(ctx context.Context, reg globalregistry.Registry) (
 	if _, ok := dummyProject.(globalregistry.ProjectWithStorage); ok {
 		registryCapabilities.HasProjectStorageReport = true
 	}
+	if _, ok := dummyProject.(globalregistry.ProjectWithStorageQuota); ok {
+		registryCapabilities.HasProjectStorageQuota = true
+	}
+	if _, ok := dummyProject.(globalregistry.StorageQuotaManipulatorProject); ok {
+		registryCapabilities.CanManipulateProjectStorageQuota = true
+	}
+	if _, ok := dummyProject.(globalregistry.ProjectWithSettings); ok {
+		registryCapabilities.HasProjectSettings = true
+	}
+	if _, ok := dummyProject.(globalregistry.SettingsManipulatorProject); ok {
+		registryCapabilities.CanManipulateProjectSettings = true
+	}
+	if _, ok := dummyProject.(globalregistry.ProjectWithRetention); ok {
+		registryCapabilities.HasProjectRetention = true
+	}
+	if _, ok := dummyProject.(globalregistry.RetentionManipulatorProject); ok {
+		registryCapabilities.CanManipulateProjectRetention = true
+	}
+	if _, ok := dummyProject.(globalregistry.ProjectWithImmutableTags); ok {
+		registryCapabilities.HasProjectImmutableTags = true
+	}
+	if _, ok := dummyProject.(globalregistry.ImmutableTagManipulatorProject); ok {
+		registryCapabilities.CanManipulateProjectImmutableTags = true
+	}
+	if _, ok := dummyProject.(globalregistry.ProjectWithWebhooks); ok {
+		registryCapabilities.HasProjectWebhooks = true
+	}
+	if _, ok := dummyProject.(globalregistry.WebhookManipulatorProject); ok {
+		registryCapabilities.CanManipulateProjectWebhooks = true
+	}
 	return registryCapabilities, nil
 }
 
@@ -107,6 +156,18 @@ func GetRegistryStatus(ctx context.Context, reg globalregistry.Registry) (*api.R
 				case globalregistry.LdapMember:
 					projectStatuses[i].Members[n].DN = m.GetDN()
 				}
+				if m, ok := member.(globalregistry.GroupMember); ok {
+					projectStatuses[i].Members[n].GroupType = m.GetGroupType()
+				}
+				if m, ok := member.(globalregistry.MemberWithRotationPolicy); ok {
+					projectStatuses[i].Members[n].Rotation = m.GetRotationPolicy()
+				}
+				if m, ok := member.(globalregistry.MemberWithTargetNamespaces); ok {
+					projectStatuses[i].Members[n].TargetNamespaces = m.GetTargetNamespaces()
+				}
+				if m, ok := member.(globalregistry.MemberWithCredentialStatus); ok {
+					projectStatuses[i].Members[n].ExpiresAt = m.GetExpiresAt()
+				}
 			}
 		} else {
 			projectStatuses[i].Members = make([]api.MemberStatus, 0)
@@ -133,6 +194,24 @@ func GetRegistryStatus(ctx context.Context, reg globalregistry.Registry) (*api.R
 					Schedule: rule.Trigger().TriggerSchedule(),
 				}
 				projectStatuses[i].ReplicationRules[n].Direction = rule.Direction()
+				if ruleWithOptions, ok := rule.(globalregistry.ReplicationRuleWithOptions); ok {
+					projectStatuses[i].ReplicationRules[n].ReplicationOptions = ruleWithOptions.Options()
+				}
+				projectStatuses[i].ReplicationRules[n].Enabled = true
+				if ruleWithState, ok := rule.(globalregistry.ReplicationRuleWithState); ok {
+					projectStatuses[i].ReplicationRules[n].Enabled = ruleWithState.Enabled()
+				}
+				if executableRule, ok := rule.(globalregistry.ExecutableReplicationRule); ok {
+					// the execution monitoring shall not block the
+					// provisioning
+					lastExecution, err := executableRule.GetLastExecution(ctx)
+					if err != nil {
+						logger.Error(err, "cannot get the last execution of replication rule",
+							"project", project.GetName(),
+							"remote_registry", rule.RemoteRegistry().GetName())
+					}
+					projectStatuses[i].ReplicationRules[n].LastExecution = lastExecution
+				}
 			}
 		} else {
 			projectStatuses[i].ReplicationRules = make([]api.ReplicationRuleStatus, 0)
@@ -147,6 +226,53 @@ func GetRegistryStatus(ctx context.Context, reg globalregistry.Registry) (*api.R
 			projectStatuses[i].StorageUsed = storageUsed
 		}
 
+		projectWithStorageQuota, ok := project.(globalregistry.ProjectWithStorageQuota)
+		if ok {
+			storageQuota, err := projectWithStorageQuota.GetStorageQuota(ctx)
+			if err != nil {
+				return nil, err
+			}
+			projectStatuses[i].StorageQuota = storageQuota
+		} else {
+			projectStatuses[i].StorageQuota = -1
+		}
+
+		projectWithSettings, ok := project.(globalregistry.ProjectWithSettings)
+		if ok {
+			settings, err := projectWithSettings.GetSettings(ctx)
+			if err != nil {
+				return nil, err
+			}
+			projectStatuses[i].Settings = settings
+		}
+
+		projectWithRetention, ok := project.(globalregistry.ProjectWithRetention)
+		if ok {
+			retention, err := projectWithRetention.GetRetentionPolicy(ctx)
+			if err != nil {
+				return nil, err
+			}
+			projectStatuses[i].Retention = retention
+		}
+
+		projectWithImmutableTags, ok := project.(globalregistry.ProjectWithImmutableTags)
+		if ok {
+			immutableTags, err := projectWithImmutableTags.GetImmutableTagPolicy(ctx)
+			if err != nil {
+				return nil, err
+			}
+			projectStatuses[i].ImmutableTags = immutableTags
+		}
+
+		projectWithWebhooks, ok := project.(globalregistry.ProjectWithWebhooks)
+		if ok {
+			webhooks, err := projectWithWebhooks.GetWebhooks(ctx)
+			if err != nil {
+				return nil, err
+			}
+			projectStatuses[i].Webhooks = webhooks
+		}
+
 		projectWithScanner, ok := project.(globalregistry.ProjectWithScanner)
 		if ok {
 			projectScanner, err := projectWithScanner.GetScanner(ctx)
@@ -161,8 +287,41 @@ func GetRegistryStatus(ctx context.Context, reg globalregistry.Registry) (*api.R
 			}
 		}
 	}
+	var robotAccountStatuses []api.RobotAccountStatus
+	if regWithRobotAccounts, ok := reg.(globalregistry.RegistryWithRobotAccounts); ok {
+		robotAccounts, err := regWithRobotAccounts.ListRobotAccounts(ctx)
+		if err != nil {
+			return nil, err
+		}
+		// the registries list only the robot accounts owned by
+		// registryman, so the robot accounts are reconciled even if no
+		// robot account is expected
+		robotAccountStatuses = make([]api.RobotAccountStatus, len(robotAccounts))
+		for i, robotAccount := range robotAccounts {
+			robotAccountStatuses[i] = robotAccount.GetStatus()
+		}
+	}
+	var userGroupStatuses []api.UserGroupStatus
+	if regWithUserGroups, ok := reg.(globalregistry.RegistryWithUserGroups); ok {
+		userGroups, err := regWithUserGroups.ListUserGroups(ctx)
+		if err != nil {
+			return nil, err
+		}
+		if len(userGroups) > 0 {
+			userGroupStatuses = make([]api.UserGroupStatus, len(userGroups))
+			for i, userGroup := range userGroups {
+				userGroupStatuses[i] = api.UserGroupStatus{
+					Name:      userGroup.GetName(),
+					GroupType: userGroup.GetGroupType(),
+					DN:        userGroup.GetDN(),
+				}
+			}
+		}
+	}
 	return &api.RegistryStatus{
-		Projects:     projectStatuses,
-		Capabilities: registryCapabilities,
+		Projects:      projectStatuses,
+		Capabilities:  registryCapabilities,
+		RobotAccounts: robotAccountStatuses,
+		UserGroups:    userGroupStatuses,
 	}, nil
 }
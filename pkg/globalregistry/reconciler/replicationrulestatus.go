@@ -56,7 +56,7 @@ func (ra *rRuleAddAction) Perform(ctx context.Context, reg globalregistry.Regist
 		// registry does not support project level replication
 		return nilEffect, nil
 	}
-	_, err = replicationRuleManipulatorProject.AssignReplicationRule(ctx, remoteRegistry, ra.Trigger, ra.Direction)
+	_, err = replicationRuleManipulatorProject.AssignReplicationRule(ctx, remoteRegistry, ra.Trigger, ra.Direction, ra.ReplicationOptions)
 	return nilEffect, err
 }
 
@@ -82,12 +82,7 @@ func (ra *rRuleRemoveAction) Perform(ctx context.Context, reg globalregistry.Reg
 	if err != nil {
 		return nilEffect, err
 	}
-	projectWithReplication, ok := project.(globalregistry.ProjectWithReplication)
-	if !ok {
-		// registry does not support project level replication
-		return nilEffect, nil
-	}
-	rRules, err := projectWithReplication.GetReplicationRules(ctx, ra.Trigger, ra.Direction)
+	rRules, err := findReplicationRules(ctx, project, ra.ReplicationRuleStatus)
 	if err != nil {
 		return nilEffect, err
 	}
@@ -104,6 +99,107 @@ func (ra *rRuleRemoveAction) Perform(ctx context.Context, reg globalregistry.Reg
 	return nilEffect, nil
 }
 
+type rRuleUpdateAction struct {
+	actual      api.ReplicationRuleStatus
+	expected    api.ReplicationRuleStatus
+	store       *config.ExpectedProvider
+	projectName string
+}
+
+var _ Action = &rRuleUpdateAction{}
+
+func (ra *rRuleUpdateAction) String() string {
+	return fmt.Sprintf("updating replication rule for %s: %s [%s] on %s",
+		ra.projectName,
+		ra.expected.RemoteRegistry.Name,
+		ra.expected.Direction,
+		ra.expected.Trigger.TriggerType(),
+	)
+}
+
+// Perform updates the replication rules in place. A rule which cannot be
+// updated is removed and the expected rule is created instead.
+func (ra *rRuleUpdateAction) Perform(ctx context.Context, reg globalregistry.Registry) (SideEffect, error) {
+	project, err := reg.(globalregistry.RegistryWithProjects).GetProjectByName(ctx, ra.projectName)
+	if err != nil {
+		return nilEffect, err
+	}
+	rRules, err := findReplicationRules(ctx, project, ra.actual)
+	if err != nil {
+		return nilEffect, err
+	}
+	for _, rRule := range rRules {
+		updatableReplicationRule, ok := rRule.(globalregistry.UpdatableReplicationRule)
+		if !ok {
+			err = ra.replace(ctx, reg, rRule)
+		} else {
+			err = updatableReplicationRule.Update(ctx, ra.expected.Trigger, ra.expected.ReplicationOptions, ra.expected.Enabled)
+		}
+		if err != nil {
+			return nilEffect, err
+		}
+	}
+	return nilEffect, nil
+}
+
+// replace removes the replication rule which cannot be updated and creates
+// the expected rule instead.
+func (ra *rRuleUpdateAction) replace(ctx context.Context, reg globalregistry.Registry, rRule globalregistry.ReplicationRule) error {
+	destructibleReplicationRule, ok := rRule.(globalregistry.DestructibleReplicationRule)
+	if !ok {
+		return fmt.Errorf("replication rule cannot be updated nor deleted")
+	}
+	err := destructibleReplicationRule.Delete(ctx)
+	if err != nil {
+		return err
+	}
+	_, err = (&rRuleAddAction{ra.expected, ra.store, ra.projectName}).Perform(ctx, reg)
+	return err
+}
+
+// findReplicationRules returns the replication rules of the project that match
+// the replication rule status.
+func findReplicationRules(ctx context.Context, project globalregistry.Project, status api.ReplicationRuleStatus) ([]globalregistry.ReplicationRule, error) {
+	projectWithReplication, ok := project.(globalregistry.ProjectWithReplication)
+	if !ok {
+		// registry does not support project level replication
+		return nil, nil
+	}
+	rRules, err := projectWithReplication.GetReplicationRules(ctx, status.Trigger, status.Direction)
+	if err != nil {
+		return nil, err
+	}
+	matchingRules := make([]globalregistry.ReplicationRule, 0)
+	for _, rRule := range rRules {
+		if rRule.RemoteRegistry().GetName() != status.RemoteRegistry.Name {
+			continue
+		}
+		if ruleWithOptions, ok := rRule.(globalregistry.ReplicationRuleWithOptions); ok &&
+			ruleWithOptions.Options() != status.ReplicationOptions {
+			// the rule replicates other artifacts
+			continue
+		}
+		matchingRules = append(matchingRules, rRule)
+	}
+	return matchingRules, nil
+}
+
+// replicationRuleEquals returns whether the replication rule statuses describe
+// the same rule with the same attributes. The executions of the rules are not
+// compared.
+func replicationRuleEquals(a, b api.ReplicationRuleStatus) bool {
+	a.LastExecution = nil
+	b.LastExecution = nil
+	return a == b
+}
+
+// isSameReplicationRule returns whether the replication rule statuses describe
+// the same rule, i.e. they may differ only in the mutable attributes of the
+// rule.
+func isSameReplicationRule(a, b api.ReplicationRuleStatus) bool {
+	return a.RemoteRegistry == b.RemoteRegistry && a.Direction == b.Direction
+}
+
 // CompareReplicationRuleStatus compares the actual and expected status of the
 // replication rules of a project. The function returns the actions that are
 // needed to synchronize the actual state to the expected state.
@@ -113,7 +209,7 @@ func CompareReplicationRuleStatus(store *config.ExpectedProvider, projectName st
 ActLoop:
 	for _, act := range actual {
 		for _, exp := range expected {
-			if act == exp {
+			if replicationRuleEquals(act, exp) {
 				continue ActLoop
 			}
 		}
@@ -123,7 +219,7 @@ ActLoop:
 ExpLoop:
 	for _, exp := range expected {
 		for _, act := range actual {
-			if act == exp {
+			if replicationRuleEquals(act, exp) {
 				continue ExpLoop
 			}
 		}
@@ -133,8 +229,30 @@ ExpLoop:
 	actions := make([]Action, 0)
 
 	if regCapabilities.CanManipulateProjectReplicationRules {
-		// actualDiff contains the members which are there but are not needed
+		// the rules that differ only in their mutable attributes are
+		// updated
+		updateActions := make([]Action, 0)
+		remainingActualDiff := []api.ReplicationRuleStatus{}
+	UpdateLoop:
 		for _, act := range actualDiff {
+			for i, exp := range expectedDiff {
+				if isSameReplicationRule(act, exp) {
+					updateActions = append(updateActions, &rRuleUpdateAction{
+						act,
+						exp,
+						store,
+						projectName,
+					})
+					expectedDiff = append(expectedDiff[:i], expectedDiff[i+1:]...)
+					continue UpdateLoop
+				}
+			}
+			remainingActualDiff = append(remainingActualDiff, act)
+		}
+
+		// remainingActualDiff contains the rules which are there but
+		// are not needed
+		for _, act := range remainingActualDiff {
 			actions = append(actions, &rRuleRemoveAction{
 				act,
 				store,
@@ -142,6 +260,8 @@ ExpLoop:
 			})
 		}
 
+		actions = append(actions, updateActions...)
+
 		// expectedClone contains the members which are missing and thus they
 		// shall be created
 		for _, exp := range expectedDiff {
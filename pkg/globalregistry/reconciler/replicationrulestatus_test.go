@@ -65,6 +65,21 @@ var (
 		},
 		Direction: "Pull",
 	}
+	rrule1Filtered = api.ReplicationRuleStatus{
+		RemoteRegistry: api.RemoteRegistryStatus{
+			Name: "reg1",
+		},
+		Trigger: api.ReplicationTrigger{
+			Type:     api.EventBasedReplicationTriggerType,
+			Schedule: "",
+		},
+		Direction: "Push",
+		ReplicationOptions: api.ReplicationOptions{
+			Filters: api.ReplicationFilters{
+				Tags: "v*",
+			},
+		},
+	}
 )
 
 var _ = Describe("Memberstatus", func() {
@@ -196,10 +211,9 @@ var _ = Describe("Memberstatus", func() {
 			CanManipulateProjectReplicationRules: true,
 		})
 		Expect(actions).ToNot(BeNil())
-		Expect(len(actions)).To(Equal(2))
+		Expect(len(actions)).To(Equal(1))
 		Expect(actionsToStrings(actions)).To(Equal([]string{
-			"removing replication rule for proj: reg1 [Push] on event_based",
-			"adding replication rule for proj: reg1 [Push] on manual",
+			"updating replication rule for proj: reg1 [Push] on manual",
 		}))
 		act = []api.ReplicationRuleStatus{
 			rrule1,
@@ -217,4 +231,62 @@ var _ = Describe("Memberstatus", func() {
 			"adding replication rule for proj: reg1 [Pull] on event_based",
 		}))
 	})
+
+	It("can detect filter changes", func() {
+		act := []api.ReplicationRuleStatus{
+			rrule1,
+		}
+		exp := []api.ReplicationRuleStatus{
+			rrule1Filtered,
+		}
+		actions := reconciler.CompareReplicationRuleStatus(nil, "proj", act, exp, api.RegistryCapabilities{
+			CanManipulateProjectReplicationRules: true,
+		})
+		Expect(actions).ToNot(BeNil())
+		Expect(len(actions)).To(Equal(1))
+		Expect(actionsToStrings(actions)).To(Equal([]string{
+			"updating replication rule for proj: reg1 [Push] on event_based",
+		}))
+	})
+
+	It("updates the disabled rules", func() {
+		rrule1Enabled := rrule1
+		rrule1Enabled.Enabled = true
+		act := []api.ReplicationRuleStatus{
+			rrule1,
+			rrule2,
+		}
+		exp := []api.ReplicationRuleStatus{
+			rrule1Enabled,
+			rrule2,
+		}
+		actions := reconciler.CompareReplicationRuleStatus(nil, "proj", act, exp, api.RegistryCapabilities{
+			CanManipulateProjectReplicationRules: true,
+		})
+		Expect(actions).ToNot(BeNil())
+		Expect(len(actions)).To(Equal(1))
+		Expect(actionsToStrings(actions)).To(Equal([]string{
+			"updating replication rule for proj: reg1 [Push] on event_based",
+		}))
+	})
+
+	It("ignores the executions of the rules", func() {
+		rrule1Executed := rrule1
+		rrule1Executed.LastExecution = &api.ReplicationExecutionStatus{
+			ID:          1,
+			State:       api.FailedReplicationExecutionState,
+			FailedTasks: 1,
+		}
+		act := []api.ReplicationRuleStatus{
+			rrule1Executed,
+		}
+		exp := []api.ReplicationRuleStatus{
+			rrule1,
+		}
+		actions := reconciler.CompareReplicationRuleStatus(nil, "proj", act, exp, api.RegistryCapabilities{
+			CanManipulateProjectReplicationRules: true,
+		})
+		Expect(actions).ToNot(BeNil())
+		Expect(len(actions)).To(Equal(0))
+	})
 })
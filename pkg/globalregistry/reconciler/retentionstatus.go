@@ -0,0 +1,170 @@
+/*
+   Copyright 2021 The Kubermatic Kubernetes Platform contributors.
+
+   Licensed under the Apache License, Version 2.0 (the "License");
+   you may not use this file except in compliance with the License.
+   You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+   Unless required by applicable law or agreed to in writing, software
+   distributed under the License is distributed on an "AS IS" BASIS,
+   WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+   See the License for the specific language governing permissions and
+   limitations under the License.
+*/
+
+package reconciler
+
+import (
+	"context"
+	"fmt"
+
+	api "github.com/kubermatic-labs/registryman/pkg/apis/registryman/v1alpha1"
+	"github.com/kubermatic-labs/registryman/pkg/globalregistry"
+)
+
+// getRetentionManipulatorProject returns the project if the registry can
+// manipulate its tag retention rules. Otherwise, nil is returned.
+func getRetentionManipulatorProject(ctx context.Context, reg globalregistry.Registry, projectName string) (globalregistry.RetentionManipulatorProject, error) {
+	project, err := reg.(globalregistry.RegistryWithProjects).GetProjectByName(ctx, projectName)
+	if err != nil {
+		return nil, err
+	}
+	retentionManipulatorProject, ok := project.(globalregistry.RetentionManipulatorProject)
+	if !ok {
+		return nil, nil
+	}
+	return retentionManipulatorProject, nil
+}
+
+func retentionRuleString(rule api.RetentionRule) string {
+	return fmt.Sprintf("%s %d of %s:%s",
+		rule.Retain, rule.Count, rule.RepositoriesPattern(), rule.TagsPattern())
+}
+
+type retentionRuleAddAction struct {
+	projectName string
+	api.RetentionRule
+}
+
+var _ Action = &retentionRuleAddAction{}
+
+func (a *retentionRuleAddAction) String() string {
+	return fmt.Sprintf("adding retention rule to project %s: %s",
+		a.projectName, retentionRuleString(a.RetentionRule))
+}
+
+func (a *retentionRuleAddAction) Perform(ctx context.Context, reg globalregistry.Registry) (SideEffect, error) {
+	project, err := getRetentionManipulatorProject(ctx, reg, a.projectName)
+	if err != nil || project == nil {
+		return nilEffect, err
+	}
+	return nilEffect, project.AddRetentionRule(ctx, a.RetentionRule)
+}
+
+type retentionRuleUpdateAction struct {
+	projectName string
+	api.RetentionRule
+}
+
+var _ Action = &retentionRuleUpdateAction{}
+
+func (a *retentionRuleUpdateAction) String() string {
+	return fmt.Sprintf("updating retention rule of project %s: %s",
+		a.projectName, retentionRuleString(a.RetentionRule))
+}
+
+func (a *retentionRuleUpdateAction) Perform(ctx context.Context, reg globalregistry.Registry) (SideEffect, error) {
+	project, err := getRetentionManipulatorProject(ctx, reg, a.projectName)
+	if err != nil || project == nil {
+		return nilEffect, err
+	}
+	return nilEffect, project.UpdateRetentionRule(ctx, a.RetentionRule)
+}
+
+type retentionRuleRemoveAction struct {
+	projectName string
+	api.RetentionRule
+}
+
+var _ Action = &retentionRuleRemoveAction{}
+
+func (a *retentionRuleRemoveAction) String() string {
+	return fmt.Sprintf("removing retention rule from project %s: %s",
+		a.projectName, retentionRuleString(a.RetentionRule))
+}
+
+func (a *retentionRuleRemoveAction) Perform(ctx context.Context, reg globalregistry.Registry) (SideEffect, error) {
+	project, err := getRetentionManipulatorProject(ctx, reg, a.projectName)
+	if err != nil || project == nil {
+		return nilEffect, err
+	}
+	return nilEffect, project.RemoveRetentionRule(ctx, a.RetentionRule)
+}
+
+// retentionRuleKey identifies a retention rule by its patterns.
+type retentionRuleKey struct {
+	repositories string
+	tags         string
+}
+
+func keyOfRetentionRule(rule api.RetentionRule) retentionRuleKey {
+	return retentionRuleKey{
+		repositories: rule.RepositoriesPattern(),
+		tags:         rule.TagsPattern(),
+	}
+}
+
+// CompareRetentionStatuses compares the actual and expected tag retention
+// policy of a project. The function returns the actions that are needed to
+// synchronize the actual state to the expected state. The rules are matched by
+// their repository and tag patterns. If the expected policy is nil, the tag
+// retention of the project is not managed and no action is returned.
+func CompareRetentionStatuses(projectName string, actual, expected *api.RetentionPolicy, regCapabilities api.RegistryCapabilities) []Action {
+	actions := make([]Action, 0)
+
+	if !regCapabilities.CanManipulateProjectRetention || expected == nil {
+		return actions
+	}
+	actualRules := make(map[retentionRuleKey]api.RetentionRule)
+	if actual != nil {
+		for _, rule := range actual.Rules {
+			actualRules[keyOfRetentionRule(rule)] = rule
+		}
+	}
+	expectedRules := make(map[retentionRuleKey]bool)
+	for _, rule := range expected.Rules {
+		expectedRules[keyOfRetentionRule(rule)] = true
+	}
+
+	// rules which are there but are not needed
+	if actual != nil {
+		for _, rule := range actual.Rules {
+			if !expectedRules[keyOfRetentionRule(rule)] {
+				actions = append(actions, &retentionRuleRemoveAction{
+					projectName:   projectName,
+					RetentionRule: rule,
+				})
+			}
+		}
+	}
+
+	// rules which are missing or differ
+	for _, rule := range expected.Rules {
+		act, found := actualRules[keyOfRetentionRule(rule)]
+		switch {
+		case !found:
+			actions = append(actions, &retentionRuleAddAction{
+				projectName:   projectName,
+				RetentionRule: rule,
+			})
+		case act.Retain != rule.Retain || act.Count != rule.Count:
+			actions = append(actions, &retentionRuleUpdateAction{
+				projectName:   projectName,
+				RetentionRule: rule,
+			})
+		}
+	}
+	return actions
+}
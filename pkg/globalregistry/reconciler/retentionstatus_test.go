@@ -0,0 +1,92 @@
+/*
+   Copyright 2021 The Kubermatic Kubernetes Platform contributors.
+
+   Licensed under the Apache License, Version 2.0 (the "License");
+   you may not use this file except in compliance with the License.
+   You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+   Unless required by applicable law or agreed to in writing, software
+   distributed under the License is distributed on an "AS IS" BASIS,
+   WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+   See the License for the specific language governing permissions and
+   limitations under the License.
+*/
+
+package reconciler_test
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	api "github.com/kubermatic-labs/registryman/pkg/apis/registryman/v1alpha1"
+	"github.com/kubermatic-labs/registryman/pkg/globalregistry/reconciler"
+)
+
+var _ = Describe("RetentionStatus", func() {
+	capabilities := api.RegistryCapabilities{
+		CanManipulateProjectRetention: true,
+	}
+
+	It("returns no action when the retention is not managed", func() {
+		act := &api.RetentionPolicy{
+			Rules: []api.RetentionRule{
+				{Retain: "LatestPushed", Count: 10},
+			},
+		}
+		actions := reconciler.CompareRetentionStatuses("proj", act, nil, capabilities)
+		Expect(actions).ToNot(BeNil())
+		Expect(len(actions)).To(Equal(0))
+	})
+
+	It("returns no action for the same rules", func() {
+		act := &api.RetentionPolicy{
+			Rules: []api.RetentionRule{
+				{Repositories: "**", Tags: "**", Retain: "LatestPushed", Count: 10},
+				{Repositories: "**", Tags: "v*", Retain: "PushedWithinDays", Count: 30},
+			},
+		}
+		exp := &api.RetentionPolicy{
+			Rules: []api.RetentionRule{
+				{Tags: "v*", Retain: "PushedWithinDays", Count: 30},
+				{Retain: "LatestPushed", Count: 10},
+			},
+		}
+		actions := reconciler.CompareRetentionStatuses("proj", act, exp, capabilities)
+		Expect(actions).ToNot(BeNil())
+		Expect(len(actions)).To(Equal(0))
+	})
+
+	It("creates, updates and removes rules", func() {
+		act := &api.RetentionPolicy{
+			Rules: []api.RetentionRule{
+				{Repositories: "**", Tags: "**", Retain: "LatestPushed", Count: 10},
+				{Repositories: "legacy/**", Tags: "**", Retain: "LatestPushed", Count: 1},
+			},
+		}
+		exp := &api.RetentionPolicy{
+			Rules: []api.RetentionRule{
+				{Retain: "LatestPushed", Count: 5},
+				{Tags: "v*", Retain: "PushedWithinDays", Count: 30},
+			},
+		}
+		actions := reconciler.CompareRetentionStatuses("proj", act, exp, capabilities)
+		Expect(actionsToStrings(actions)).To(Equal([]string{
+			"removing retention rule from project proj: LatestPushed 1 of legacy/**:**",
+			"updating retention rule of project proj: LatestPushed 5 of **:**",
+			"adding retention rule to project proj: PushedWithinDays 30 of **:v*",
+		}))
+	})
+
+	It("returns no action when the registry cannot change the retention", func() {
+		exp := &api.RetentionPolicy{
+			Rules: []api.RetentionRule{
+				{Retain: "LatestPushed", Count: 5},
+			},
+		}
+		actions := reconciler.CompareRetentionStatuses("proj", nil, exp, api.RegistryCapabilities{})
+		Expect(actions).ToNot(BeNil())
+		Expect(len(actions)).To(Equal(0))
+	})
+})
@@ -0,0 +1,256 @@
+/*
+   Copyright 2021 The Kubermatic Kubernetes Platform contributors.
+
+   Licensed under the Apache License, Version 2.0 (the "License");
+   you may not use this file except in compliance with the License.
+   You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+   Unless required by applicable law or agreed to in writing, software
+   distributed under the License is distributed on an "AS IS" BASIS,
+   WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+   See the License for the specific language governing permissions and
+   limitations under the License.
+*/
+
+package reconciler
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	api "github.com/kubermatic-labs/registryman/pkg/apis/registryman/v1alpha1"
+	"github.com/kubermatic-labs/registryman/pkg/globalregistry"
+)
+
+func getRobotAccountManipulatorRegistry(reg globalregistry.Registry) (globalregistry.RobotAccountManipulatorRegistry, bool) {
+	robotAccountManipulator, ok := reg.(globalregistry.RobotAccountManipulatorRegistry)
+	return robotAccountManipulator, ok
+}
+
+// persistRobotAccountCredentials returns the side effect which stores the
+// credentials of a newly created robot account.
+func persistRobotAccountCredentials(reg globalregistry.Registry, name string, creds *globalregistry.ProjectMemberCredentials) SideEffect {
+	if creds == nil {
+		return nilEffect
+	}
+	return &persistCredentials{
+		ProjectMemberCredentials: *creds,
+		registry:                 reg,
+		secretName:               robotAccountCredentialsSecretName(reg, name),
+		annotations: map[string]string{
+			"globalregistry.org/registry-name":      reg.GetName(),
+			"globalregistry.org/robot-account-name": name,
+		},
+	}
+}
+
+type robotAccountAddAction struct {
+	api.RobotAccountStatus
+}
+
+var _ Action = &robotAccountAddAction{}
+
+func (a *robotAccountAddAction) String() string {
+	return fmt.Sprintf("adding robot account %s: %s",
+		a.Name, robotAccountProjectsString(a.Projects))
+}
+
+func (a *robotAccountAddAction) Perform(ctx context.Context, reg globalregistry.Registry) (SideEffect, error) {
+	robotAccountManipulator, ok := getRobotAccountManipulatorRegistry(reg)
+	if !ok {
+		return nilEffect, nil
+	}
+	creds, err := robotAccountManipulator.CreateRobotAccount(ctx, a.RobotAccountStatus)
+	if err != nil {
+		return nilEffect, err
+	}
+	return persistRobotAccountCredentials(reg, a.Name, creds), nil
+}
+
+type robotAccountUpdateAction struct {
+	api.RobotAccountStatus
+}
+
+var _ Action = &robotAccountUpdateAction{}
+
+func (a *robotAccountUpdateAction) String() string {
+	return fmt.Sprintf("updating robot account %s: %s",
+		a.Name, robotAccountProjectsString(a.Projects))
+}
+
+func (a *robotAccountUpdateAction) Perform(ctx context.Context, reg globalregistry.Registry) (SideEffect, error) {
+	robotAccountManipulator, ok := getRobotAccountManipulatorRegistry(reg)
+	if !ok {
+		return nilEffect, nil
+	}
+	return nilEffect, robotAccountManipulator.UpdateRobotAccount(ctx, a.RobotAccountStatus)
+}
+
+// robotAccountRotateAction recreates a robot account so that both its secret
+// and its expiration are renewed. The new credentials overwrite the Secret
+// of the old ones.
+type robotAccountRotateAction struct {
+	api.RobotAccountStatus
+}
+
+var _ Action = &robotAccountRotateAction{}
+
+func (a *robotAccountRotateAction) String() string {
+	return fmt.Sprintf("rotating secret of robot account %s", a.Name)
+}
+
+func (a *robotAccountRotateAction) Perform(ctx context.Context, reg globalregistry.Registry) (SideEffect, error) {
+	robotAccountManipulator, ok := getRobotAccountManipulatorRegistry(reg)
+	if !ok {
+		return nilEffect, nil
+	}
+	err := robotAccountManipulator.DeleteRobotAccount(ctx, a.Name)
+	if err != nil {
+		return nilEffect, err
+	}
+	creds, err := robotAccountManipulator.CreateRobotAccount(ctx, a.RobotAccountStatus)
+	if err != nil {
+		return nilEffect, err
+	}
+	return persistRobotAccountCredentials(reg, a.Name, creds), nil
+}
+
+type robotAccountRemoveAction struct {
+	api.RobotAccountStatus
+}
+
+var _ Action = &robotAccountRemoveAction{}
+
+func (a *robotAccountRemoveAction) String() string {
+	return fmt.Sprintf("removing robot account %s", a.Name)
+}
+
+func (a *robotAccountRemoveAction) Perform(ctx context.Context, reg globalregistry.Registry) (SideEffect, error) {
+	robotAccountManipulator, ok := getRobotAccountManipulatorRegistry(reg)
+	if !ok {
+		return nilEffect, nil
+	}
+	err := robotAccountManipulator.DeleteRobotAccount(ctx, a.Name)
+	if err != nil {
+		return nilEffect, err
+	}
+	return &removeCredentials{
+		secretName: robotAccountCredentialsSecretName(reg, a.Name),
+	}, nil
+}
+
+func robotAccountProjectsString(projects []api.RobotAccountProject) string {
+	s := ""
+	for i, project := range projects {
+		if i > 0 {
+			s += ", "
+		}
+		s += fmt.Sprintf("%s %v", project.Name, project.Permissions)
+	}
+	return s
+}
+
+// robotAccountProjectsEqual checks whether the robot accounts have the same
+// permissions in the same projects. The order of the projects and the
+// permissions is ignored.
+func robotAccountProjectsEqual(a, b []api.RobotAccountProject) bool {
+	permissions := func(projects []api.RobotAccountProject) map[string]map[api.RobotPermission]bool {
+		result := make(map[string]map[api.RobotPermission]bool)
+		for _, project := range projects {
+			if result[project.Name] == nil {
+				result[project.Name] = make(map[api.RobotPermission]bool)
+			}
+			for _, permission := range project.Permissions {
+				result[project.Name][permission] = true
+			}
+		}
+		return result
+	}
+	aPermissions := permissions(a)
+	bPermissions := permissions(b)
+	if len(aPermissions) != len(bPermissions) {
+		return false
+	}
+	for projectName, aProjectPermissions := range aPermissions {
+		bProjectPermissions, found := bPermissions[projectName]
+		if !found || len(aProjectPermissions) != len(bProjectPermissions) {
+			return false
+		}
+		for permission := range aProjectPermissions {
+			if !bProjectPermissions[permission] {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+// robotAccountNeedsRotation checks whether the actual robot account shall be
+// recreated. This is the case when the lifetime of the robot account has
+// changed or when it expires within the rotation window of the expected robot
+// account. The rotation window is at most the half of the lifetime.
+func robotAccountNeedsRotation(actual, expected api.RobotAccountStatus) bool {
+	if actual.Duration != expected.Duration {
+		return true
+	}
+	if actual.ExpiresAt == nil {
+		return false
+	}
+	return time.Until(actual.ExpiresAt.Time) < rotationWindow(expected.RotateBefore, expected.Duration)
+}
+
+// CompareRobotAccountStatuses compares the actual and expected registry level
+// robot accounts. The robot accounts are identified by their names. The
+// function returns the actions that are needed to synchronize the actual state
+// to the expected state. If the expected robot accounts are nil, the registry
+// has no robot accounts and no action is returned. The actual robot accounts
+// contain only the ones owned by registryman, so an empty expected list removes
+// all of them.
+func CompareRobotAccountStatuses(actual, expected []api.RobotAccountStatus, regCapabilities api.RegistryCapabilities) []Action {
+	actions := make([]Action, 0)
+
+	if !regCapabilities.CanManipulateRobotAccounts || expected == nil {
+		return actions
+	}
+	actualRobotAccounts := make(map[string]api.RobotAccountStatus)
+	for _, robotAccount := range actual {
+		actualRobotAccounts[robotAccount.Name] = robotAccount
+	}
+	expectedRobotAccounts := make(map[string]bool)
+	for _, robotAccount := range expected {
+		expectedRobotAccounts[robotAccount.Name] = true
+	}
+
+	// robot accounts which are there but are not needed
+	for _, robotAccount := range actual {
+		if !expectedRobotAccounts[robotAccount.Name] {
+			actions = append(actions, &robotAccountRemoveAction{
+				RobotAccountStatus: robotAccount,
+			})
+		}
+	}
+
+	// robot accounts which are missing, differ or expire soon
+	for _, robotAccount := range expected {
+		actualRobotAccount, found := actualRobotAccounts[robotAccount.Name]
+		switch {
+		case !found:
+			actions = append(actions, &robotAccountAddAction{
+				RobotAccountStatus: robotAccount,
+			})
+		case robotAccountNeedsRotation(actualRobotAccount, robotAccount):
+			actions = append(actions, &robotAccountRotateAction{
+				RobotAccountStatus: robotAccount,
+			})
+		case actualRobotAccount.Description != robotAccount.Description ||
+			!robotAccountProjectsEqual(actualRobotAccount.Projects, robotAccount.Projects):
+			actions = append(actions, &robotAccountUpdateAction{
+				RobotAccountStatus: robotAccount,
+			})
+		}
+	}
+	return actions
+}
@@ -0,0 +1,156 @@
+/*
+   Copyright 2021 The Kubermatic Kubernetes Platform contributors.
+
+   Licensed under the Apache License, Version 2.0 (the "License");
+   you may not use this file except in compliance with the License.
+   You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+   Unless required by applicable law or agreed to in writing, software
+   distributed under the License is distributed on an "AS IS" BASIS,
+   WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+   See the License for the specific language governing permissions and
+   limitations under the License.
+*/
+
+package reconciler_test
+
+import (
+	"time"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	api "github.com/kubermatic-labs/registryman/pkg/apis/registryman/v1alpha1"
+	"github.com/kubermatic-labs/registryman/pkg/globalregistry/reconciler"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+var _ = Describe("RobotAccountStatus", func() {
+	capabilities := api.RegistryCapabilities{
+		CanManipulateRobotAccounts: true,
+	}
+	expiresIn := func(d time.Duration) *metav1.Time {
+		t := metav1.NewTime(time.Now().Add(d))
+		return &t
+	}
+	ciRobot := func() api.RobotAccountStatus {
+		return api.RobotAccountStatus{
+			Name: "ci",
+			Projects: []api.RobotAccountProject{
+				{
+					Name:        "app",
+					Permissions: []api.RobotPermission{api.PullRobotPermission, api.PushRobotPermission},
+				},
+				{
+					Name:        "base",
+					Permissions: []api.RobotPermission{api.PullRobotPermission},
+				},
+			},
+			Duration:     30,
+			RotateBefore: 7,
+		}
+	}
+
+	It("returns no action when the robot accounts are not managed", func() {
+		act := []api.RobotAccountStatus{ciRobot()}
+		actions := reconciler.CompareRobotAccountStatuses(act, nil, capabilities)
+		Expect(actions).ToNot(BeNil())
+		Expect(len(actions)).To(Equal(0))
+	})
+
+	It("returns no action for the same robot accounts", func() {
+		act := ciRobot()
+		act.ExpiresAt = expiresIn(20 * 24 * time.Hour)
+		act.Projects = []api.RobotAccountProject{
+			{
+				Name:        "base",
+				Permissions: []api.RobotPermission{api.PullRobotPermission},
+			},
+			{
+				Name:        "app",
+				Permissions: []api.RobotPermission{api.PushRobotPermission, api.PullRobotPermission},
+			},
+		}
+		actions := reconciler.CompareRobotAccountStatuses([]api.RobotAccountStatus{act}, []api.RobotAccountStatus{ciRobot()}, capabilities)
+		Expect(actions).ToNot(BeNil())
+		Expect(len(actions)).To(Equal(0))
+	})
+
+	It("updates the robot account when the permissions change", func() {
+		act := ciRobot()
+		act.ExpiresAt = expiresIn(20 * 24 * time.Hour)
+		act.Projects = act.Projects[:1]
+		actions := reconciler.CompareRobotAccountStatuses([]api.RobotAccountStatus{act}, []api.RobotAccountStatus{ciRobot()}, capabilities)
+		Expect(actionsToStrings(actions)).To(Equal([]string{
+			"updating robot account ci: app [Pull Push], base [Pull]",
+		}))
+	})
+
+	It("rotates the robot account before it expires", func() {
+		act := ciRobot()
+		act.ExpiresAt = expiresIn(5 * 24 * time.Hour)
+		actions := reconciler.CompareRobotAccountStatuses([]api.RobotAccountStatus{act}, []api.RobotAccountStatus{ciRobot()}, capabilities)
+		Expect(actionsToStrings(actions)).To(Equal([]string{
+			"rotating secret of robot account ci",
+		}))
+	})
+
+	It("limits the rotation window to the half of the lifetime", func() {
+		exp := ciRobot()
+		exp.Duration = 2
+		act := exp
+		act.ExpiresAt = expiresIn(36 * time.Hour)
+		actions := reconciler.CompareRobotAccountStatuses([]api.RobotAccountStatus{act}, []api.RobotAccountStatus{exp}, capabilities)
+		Expect(actions).ToNot(BeNil())
+		Expect(len(actions)).To(Equal(0))
+
+		act.ExpiresAt = expiresIn(12 * time.Hour)
+		actions = reconciler.CompareRobotAccountStatuses([]api.RobotAccountStatus{act}, []api.RobotAccountStatus{exp}, capabilities)
+		Expect(actionsToStrings(actions)).To(Equal([]string{
+			"rotating secret of robot account ci",
+		}))
+	})
+
+	It("rotates the robot account when its lifetime changes", func() {
+		act := ciRobot()
+		act.Duration = -1
+		actions := reconciler.CompareRobotAccountStatuses([]api.RobotAccountStatus{act}, []api.RobotAccountStatus{ciRobot()}, capabilities)
+		Expect(actionsToStrings(actions)).To(Equal([]string{
+			"rotating secret of robot account ci",
+		}))
+	})
+
+	It("adds the missing and removes the surplus robot accounts", func() {
+		act := []api.RobotAccountStatus{
+			{
+				Name:     "old",
+				Duration: -1,
+				Projects: []api.RobotAccountProject{
+					{
+						Name:        "*",
+						Permissions: []api.RobotPermission{api.PullRobotPermission},
+					},
+				},
+			},
+		}
+		actions := reconciler.CompareRobotAccountStatuses(act, []api.RobotAccountStatus{ciRobot()}, capabilities)
+		Expect(actionsToStrings(actions)).To(Equal([]string{
+			"removing robot account old",
+			"adding robot account ci: app [Pull Push], base [Pull]",
+		}))
+	})
+
+	It("removes the robot accounts when none is expected", func() {
+		actions := reconciler.CompareRobotAccountStatuses([]api.RobotAccountStatus{ciRobot()}, []api.RobotAccountStatus{}, capabilities)
+		Expect(len(actions)).To(Equal(1))
+		Expect(actions[0].String()).To(Equal("removing robot account ci"))
+	})
+
+	It("returns no action when the registry cannot manipulate robot accounts", func() {
+		actions := reconciler.CompareRobotAccountStatuses(nil, []api.RobotAccountStatus{ciRobot()}, api.RegistryCapabilities{})
+		Expect(actions).ToNot(BeNil())
+		Expect(len(actions)).To(Equal(0))
+	})
+})
@@ -0,0 +1,94 @@
+/*
+   Copyright 2021 The Kubermatic Kubernetes Platform contributors.
+
+   Licensed under the Apache License, Version 2.0 (the "License");
+   you may not use this file except in compliance with the License.
+   You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+   Unless required by applicable law or agreed to in writing, software
+   distributed under the License is distributed on an "AS IS" BASIS,
+   WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+   See the License for the specific language governing permissions and
+   limitations under the License.
+*/
+
+package reconciler
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	api "github.com/kubermatic-labs/registryman/pkg/apis/registryman/v1alpha1"
+	"github.com/kubermatic-labs/registryman/pkg/globalregistry"
+)
+
+type settingsUpdateAction struct {
+	projectName string
+	settings    *api.ProjectSettings
+}
+
+var _ Action = &settingsUpdateAction{}
+
+func (a *settingsUpdateAction) String() string {
+	return fmt.Sprintf("updating settings of project %s to %s",
+		a.projectName, settingsString(a.settings))
+}
+
+func (a *settingsUpdateAction) Perform(ctx context.Context, reg globalregistry.Registry) (SideEffect, error) {
+	project, err := reg.(globalregistry.RegistryWithProjects).GetProjectByName(ctx, a.projectName)
+	if err != nil {
+		return nilEffect, err
+	}
+	settingsManipulatorProject, ok := project.(globalregistry.SettingsManipulatorProject)
+	if !ok {
+		return nilEffect, nil
+	}
+	return nilEffect, settingsManipulatorProject.UpdateSettings(ctx, a.settings)
+}
+
+// settingsString returns a human readable form of the project settings.
+func settingsString(settings *api.ProjectSettings) string {
+	fields := []string{
+		fmt.Sprintf("public=%t", settings.Public),
+		fmt.Sprintf("autoScan=%t", settings.AutoScan),
+		fmt.Sprintf("enableContentTrust=%t", settings.EnableContentTrust),
+		fmt.Sprintf("preventVulnerableImages=%t", settings.PreventVulnerableImages),
+	}
+	if settings.PreventVulnerableImages {
+		fields = append(fields, fmt.Sprintf("severity=%s", settings.Severity))
+	}
+	return strings.Join(fields, ", ")
+}
+
+// settingsEqual compares the project settings. The severity is compared only
+// when vulnerable images are prevented, because it has no effect otherwise.
+func settingsEqual(actual, expected *api.ProjectSettings) bool {
+	if actual.Public != expected.Public ||
+		actual.AutoScan != expected.AutoScan ||
+		actual.EnableContentTrust != expected.EnableContentTrust ||
+		actual.PreventVulnerableImages != expected.PreventVulnerableImages {
+		return false
+	}
+	return !expected.PreventVulnerableImages || actual.Severity == expected.Severity
+}
+
+// CompareProjectSettingsStatuses compares the actual and expected settings of a
+// project. The function returns the actions that are needed to synchronize the
+// actual state to the expected state. If the expected settings are nil, the
+// settings of the project are not managed and no action is returned.
+func CompareProjectSettingsStatuses(projectName string, actual, expected *api.ProjectSettings, regCapabilities api.RegistryCapabilities) []Action {
+	actions := make([]Action, 0)
+
+	if regCapabilities.CanManipulateProjectSettings && expected != nil {
+		if actual == nil || !settingsEqual(actual, expected) {
+			actions = append(actions, &settingsUpdateAction{
+				projectName: projectName,
+				settings:    expected,
+			})
+		}
+	}
+	return actions
+}
@@ -0,0 +1,90 @@
+/*
+   Copyright 2021 The Kubermatic Kubernetes Platform contributors.
+
+   Licensed under the Apache License, Version 2.0 (the "License");
+   you may not use this file except in compliance with the License.
+   You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+   Unless required by applicable law or agreed to in writing, software
+   distributed under the License is distributed on an "AS IS" BASIS,
+   WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+   See the License for the specific language governing permissions and
+   limitations under the License.
+*/
+
+package reconciler_test
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	api "github.com/kubermatic-labs/registryman/pkg/apis/registryman/v1alpha1"
+	"github.com/kubermatic-labs/registryman/pkg/globalregistry/reconciler"
+)
+
+var _ = Describe("SettingsStatus", func() {
+	capabilities := api.RegistryCapabilities{
+		CanManipulateProjectSettings: true,
+	}
+
+	It("returns no action when the settings are not managed", func() {
+		act := &api.ProjectSettings{
+			Public: true,
+		}
+		actions := reconciler.CompareProjectSettingsStatuses("proj", act, nil, capabilities)
+		Expect(actions).ToNot(BeNil())
+		Expect(len(actions)).To(Equal(0))
+	})
+
+	It("returns no action for the same settings", func() {
+		act := &api.ProjectSettings{
+			AutoScan: true,
+			Severity: "low",
+		}
+		exp := &api.ProjectSettings{
+			AutoScan: true,
+		}
+		actions := reconciler.CompareProjectSettingsStatuses("proj", act, exp, capabilities)
+		Expect(actions).ToNot(BeNil())
+		Expect(len(actions)).To(Equal(0))
+	})
+
+	It("returns no action when the registry cannot change the settings", func() {
+		act := &api.ProjectSettings{}
+		exp := &api.ProjectSettings{
+			AutoScan: true,
+		}
+		actions := reconciler.CompareProjectSettingsStatuses("proj", act, exp, api.RegistryCapabilities{})
+		Expect(actions).ToNot(BeNil())
+		Expect(len(actions)).To(Equal(0))
+	})
+
+	It("can detect settings drift", func() {
+		act := &api.ProjectSettings{
+			Public: true,
+		}
+		exp := &api.ProjectSettings{
+			AutoScan: true,
+		}
+		actions := reconciler.CompareProjectSettingsStatuses("proj", act, exp, capabilities)
+		Expect(actionsToStrings(actions)).To(Equal([]string{
+			"updating settings of project proj to public=false, autoScan=true, enableContentTrust=false, preventVulnerableImages=false",
+		}))
+
+		By("severity change")
+		act = &api.ProjectSettings{
+			PreventVulnerableImages: true,
+			Severity:                "low",
+		}
+		exp = &api.ProjectSettings{
+			PreventVulnerableImages: true,
+			Severity:                "critical",
+		}
+		actions = reconciler.CompareProjectSettingsStatuses("proj", act, exp, capabilities)
+		Expect(actionsToStrings(actions)).To(Equal([]string{
+			"updating settings of project proj to public=false, autoScan=false, enableContentTrust=false, preventVulnerableImages=true, severity=critical",
+		}))
+	})
+})
@@ -0,0 +1,104 @@
+/*
+   Copyright 2021 The Kubermatic Kubernetes Platform contributors.
+
+   Licensed under the Apache License, Version 2.0 (the "License");
+   you may not use this file except in compliance with the License.
+   You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+   Unless required by applicable law or agreed to in writing, software
+   distributed under the License is distributed on an "AS IS" BASIS,
+   WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+   See the License for the specific language governing permissions and
+   limitations under the License.
+*/
+
+package reconciler
+
+import (
+	"context"
+	"fmt"
+
+	api "github.com/kubermatic-labs/registryman/pkg/apis/registryman/v1alpha1"
+	"github.com/kubermatic-labs/registryman/pkg/globalregistry"
+)
+
+// setStorageQuota sets the storage quota of the project if the registry
+// supports it.
+func setStorageQuota(ctx context.Context, reg globalregistry.Registry, projectName string, storageQuota int) error {
+	project, err := reg.(globalregistry.RegistryWithProjects).GetProjectByName(ctx, projectName)
+	if err != nil {
+		return err
+	}
+	storageQuotaManipulatorProject, ok := project.(globalregistry.StorageQuotaManipulatorProject)
+	if !ok {
+		return nil
+	}
+	return storageQuotaManipulatorProject.SetStorageQuota(ctx, storageQuota)
+}
+
+func storageQuotaString(storageQuota int) string {
+	if storageQuota < 0 {
+		return "unlimited"
+	}
+	return fmt.Sprintf("%d bytes", storageQuota)
+}
+
+type storageQuotaAddAction struct {
+	projectName  string
+	storageQuota int
+}
+
+var _ Action = &storageQuotaAddAction{}
+
+func (a *storageQuotaAddAction) String() string {
+	return fmt.Sprintf("adding storage quota of %s to project %s",
+		storageQuotaString(a.storageQuota), a.projectName)
+}
+
+func (a *storageQuotaAddAction) Perform(ctx context.Context, reg globalregistry.Registry) (SideEffect, error) {
+	return nilEffect, setStorageQuota(ctx, reg, a.projectName, a.storageQuota)
+}
+
+type storageQuotaUpdateAction struct {
+	projectName string
+	actual      int
+	expected    int
+}
+
+var _ Action = &storageQuotaUpdateAction{}
+
+func (a *storageQuotaUpdateAction) String() string {
+	return fmt.Sprintf("updating storage quota of project %s from %s to %s",
+		a.projectName, storageQuotaString(a.actual), storageQuotaString(a.expected))
+}
+
+func (a *storageQuotaUpdateAction) Perform(ctx context.Context, reg globalregistry.Registry) (SideEffect, error) {
+	return nilEffect, setStorageQuota(ctx, reg, a.projectName, a.expected)
+}
+
+// CompareStorageQuotaStatuses compares the actual and expected storage quota of
+// a project. The function returns the actions that are needed to synchronize
+// the actual state to the expected state.
+func CompareStorageQuotaStatuses(projectName string, actual, expected int, regCapabilities api.RegistryCapabilities) []Action {
+	actions := make([]Action, 0)
+
+	if regCapabilities.CanManipulateProjectStorageQuota {
+		// negative values are all treated as unlimited
+		if actual < 0 {
+			actual = -1
+		}
+		if expected < 0 {
+			expected = -1
+		}
+		if actual != expected {
+			actions = append(actions, &storageQuotaUpdateAction{
+				projectName: projectName,
+				actual:      actual,
+				expected:    expected,
+			})
+		}
+	}
+	return actions
+}
@@ -0,0 +1,87 @@
+/*
+   Copyright 2021 The Kubermatic Kubernetes Platform contributors.
+
+   Licensed under the Apache License, Version 2.0 (the "License");
+   you may not use this file except in compliance with the License.
+   You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+   Unless required by applicable law or agreed to in writing, software
+   distributed under the License is distributed on an "AS IS" BASIS,
+   WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+   See the License for the specific language governing permissions and
+   limitations under the License.
+*/
+
+package reconciler_test
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	api "github.com/kubermatic-labs/registryman/pkg/apis/registryman/v1alpha1"
+	"github.com/kubermatic-labs/registryman/pkg/globalregistry/reconciler"
+)
+
+var _ = Describe("StorageQuotaStatus", func() {
+	It("returns no action for the same storage quota values", func() {
+		actions := reconciler.CompareStorageQuotaStatuses("proj", 1024, 1024, api.RegistryCapabilities{
+			CanManipulateProjectStorageQuota: true,
+		})
+		Expect(actions).ToNot(BeNil())
+		Expect(len(actions)).To(Equal(0))
+
+		By("unlimited storage")
+		actions = reconciler.CompareStorageQuotaStatuses("proj", -1, -1, api.RegistryCapabilities{
+			CanManipulateProjectStorageQuota: true,
+		})
+		Expect(actions).ToNot(BeNil())
+		Expect(len(actions)).To(Equal(0))
+	})
+
+	It("returns no action when the registry cannot change the storage quota", func() {
+		actions := reconciler.CompareStorageQuotaStatuses("proj", -1, 1024, api.RegistryCapabilities{})
+		Expect(actions).ToNot(BeNil())
+		Expect(len(actions)).To(Equal(0))
+	})
+
+	It("can detect storage quota drift", func() {
+		actions := reconciler.CompareStorageQuotaStatuses("proj", -1, 1024, api.RegistryCapabilities{
+			CanManipulateProjectStorageQuota: true,
+		})
+		Expect(actionsToStrings(actions)).To(Equal([]string{
+			"updating storage quota of project proj from unlimited to 1024 bytes",
+		}))
+
+		actions = reconciler.CompareStorageQuotaStatuses("proj", 2048, -1, api.RegistryCapabilities{
+			CanManipulateProjectStorageQuota: true,
+		})
+		Expect(actionsToStrings(actions)).To(Equal([]string{
+			"updating storage quota of project proj from 2048 bytes to unlimited",
+		}))
+	})
+
+	It("adds the storage quota of the new projects", func() {
+		act := []api.ProjectStatus{}
+		exp := []api.ProjectStatus{
+			{
+				Name:         "limited",
+				StorageQuota: 1024,
+			},
+			{
+				Name:         "unlimited",
+				StorageQuota: -1,
+			},
+		}
+		actions := reconciler.CompareProjectStatuses(nil, act, exp, api.RegistryCapabilities{
+			CanCreateProject:                 true,
+			CanManipulateProjectStorageQuota: true,
+		})
+		Expect(actionsToStrings(actions)).To(Equal([]string{
+			"adding project limited",
+			"adding storage quota of 1024 bytes to project limited",
+			"adding project unlimited",
+		}))
+	})
+})
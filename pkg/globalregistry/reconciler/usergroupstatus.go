@@ -0,0 +1,211 @@
+/*
+   Copyright 2021 The Kubermatic Kubernetes Platform contributors.
+
+   Licensed under the Apache License, Version 2.0 (the "License");
+   you may not use this file except in compliance with the License.
+   You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+   Unless required by applicable law or agreed to in writing, software
+   distributed under the License is distributed on an "AS IS" BASIS,
+   WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+   See the License for the specific language governing permissions and
+   limitations under the License.
+*/
+
+package reconciler
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	api "github.com/kubermatic-labs/registryman/pkg/apis/registryman/v1alpha1"
+	"github.com/kubermatic-labs/registryman/pkg/globalregistry"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// ownedUserGroupsKey is the key of the ConfigMap which lists the user groups
+// created by registryman.
+const ownedUserGroupsKey = "userGroups"
+
+// userGroupsConfigMapName returns the name of the ConfigMap which lists the
+// user groups of the registry created by registryman.
+func userGroupsConfigMapName(reg globalregistry.Registry) string {
+	return fmt.Sprintf("%s---usergroups", reg.GetName())
+}
+
+// userGroupEquals checks whether the user groups have the same name and type.
+func userGroupEquals(a, b api.UserGroupStatus) bool {
+	return a.Name == b.Name && a.GroupType == b.GroupType
+}
+
+// addUserGroupOwnership marks the user groups of the actual registry status
+// which are listed in the user groups ConfigMap of the registry as owned.
+func addUserGroupOwnership(ctx context.Context, rsr RecordedStatusReader, reg globalregistry.Registry, status *api.RegistryStatus) error {
+	if len(status.UserGroups) == 0 {
+		return nil
+	}
+	data, err := rsr.GetConfigMapData(ctx, userGroupsConfigMapName(reg))
+	if err != nil {
+		return err
+	}
+	owned := []api.UserGroupStatus{}
+	if ownedUserGroups, found := data[ownedUserGroupsKey]; found {
+		err = json.Unmarshal([]byte(ownedUserGroups), &owned)
+		if err != nil {
+			return fmt.Errorf("cannot parse the owned user groups of registry %s: %w",
+				reg.GetName(), err)
+		}
+	}
+	for i := range status.UserGroups {
+		status.UserGroups[i].Owned = false
+		for _, ownedUserGroup := range owned {
+			if userGroupEquals(status.UserGroups[i], ownedUserGroup) {
+				status.UserGroups[i].Owned = true
+				break
+			}
+		}
+	}
+	return nil
+}
+
+// userGroupRemoveAction removes an owned user group which is not used by any
+// project member.
+type userGroupRemoveAction struct {
+	api.UserGroupStatus
+}
+
+var _ Action = &userGroupRemoveAction{}
+
+func (a *userGroupRemoveAction) String() string {
+	return fmt.Sprintf("removing orphaned %s user group %s", a.GroupType, a.Name)
+}
+
+func (a *userGroupRemoveAction) Perform(ctx context.Context, reg globalregistry.Registry) (SideEffect, error) {
+	userGroupManipulator, ok := reg.(globalregistry.UserGroupManipulatorRegistry)
+	if !ok {
+		return nilEffect, nil
+	}
+	return nilEffect, userGroupManipulator.DeleteUserGroup(ctx, a.UserGroupStatus)
+}
+
+// userGroupOwnershipAction records the user groups which are owned by
+// registryman. The owned user groups are kept, the candidates are recorded only
+// if they have been created in the meantime, i.e. when the group members were
+// assigned to the projects.
+type userGroupOwnershipAction struct {
+	owned      []api.UserGroupStatus
+	candidates []api.UserGroupStatus
+}
+
+var _ Action = &userGroupOwnershipAction{}
+
+func (a *userGroupOwnershipAction) String() string {
+	return "recording the owned user groups"
+}
+
+func (a *userGroupOwnershipAction) Perform(ctx context.Context, reg globalregistry.Registry) (SideEffect, error) {
+	regWithUserGroups, ok := reg.(globalregistry.RegistryWithUserGroups)
+	if !ok {
+		return nilEffect, nil
+	}
+	userGroups, err := regWithUserGroups.ListUserGroups(ctx)
+	if err != nil {
+		return nilEffect, err
+	}
+	owned := make([]api.UserGroupStatus, 0, len(a.owned)+len(a.candidates))
+	for _, ug := range a.owned {
+		owned = append(owned, api.UserGroupStatus{Name: ug.Name, GroupType: ug.GroupType})
+	}
+	for _, candidate := range a.candidates {
+		for _, ug := range userGroups {
+			if ug.GetName() == candidate.Name && ug.GetGroupType() == candidate.GroupType {
+				owned = append(owned, api.UserGroupStatus{Name: ug.GetName(), GroupType: ug.GetGroupType()})
+				break
+			}
+		}
+	}
+	return &persistUserGroupOwnership{
+		configMapName: userGroupsConfigMapName(reg),
+		owned:         owned,
+	}, nil
+}
+
+// persistUserGroupOwnership side effect stores the list of the user groups
+// owned by registryman in a ConfigMap.
+type persistUserGroupOwnership struct {
+	configMapName string
+	owned         []api.UserGroupStatus
+}
+
+var _ SideEffect = &persistUserGroupOwnership{}
+
+func (p *persistUserGroupOwnership) Perform(ctx context.Context, performer SideEffectPerformer) error {
+	owned, err := json.Marshal(p.owned)
+	if err != nil {
+		return err
+	}
+	configMap := &corev1.ConfigMap{
+		TypeMeta: metav1.TypeMeta{
+			Kind:       "ConfigMap",
+			APIVersion: "v1",
+		},
+		Data: map[string]string{
+			ownedUserGroupsKey: string(owned),
+		},
+	}
+	configMap.SetName(p.configMapName)
+	return performer.WriteResource(ctx, configMap)
+}
+
+// CompareUserGroupStatuses compares the actual and expected user groups of a
+// registry. The user groups are created on demand when a group member is
+// assigned to a project, so the function returns only the actions which remove
+// the orphaned user groups, i.e. the actual user groups owned by registryman
+// which are not needed by any group member. The user groups which are not owned
+// by registryman, e.g. the ones created by an administrator, are kept. The
+// expected user groups which are missing are recorded as owned once they are
+// created.
+func CompareUserGroupStatuses(actual, expected []api.UserGroupStatus, regCapabilities api.RegistryCapabilities) []Action {
+	actions := []Action{}
+	if !regCapabilities.CanManipulateUserGroups {
+		return actions
+	}
+	owned := []api.UserGroupStatus{}
+	candidates := []api.UserGroupStatus{}
+	ownershipChanged := false
+ActLoop:
+	for _, act := range actual {
+		if !act.Owned {
+			continue
+		}
+		for _, exp := range expected {
+			if userGroupEquals(act, exp) {
+				owned = append(owned, act)
+				continue ActLoop
+			}
+		}
+		actions = append(actions, &userGroupRemoveAction{act})
+		ownershipChanged = true
+	}
+ExpLoop:
+	for _, exp := range expected {
+		for _, act := range actual {
+			if userGroupEquals(act, exp) {
+				continue ExpLoop
+			}
+		}
+		candidates = append(candidates, exp)
+		ownershipChanged = true
+	}
+	if ownershipChanged {
+		actions = append(actions, &userGroupOwnershipAction{
+			owned:      owned,
+			candidates: candidates,
+		})
+	}
+	return actions
+}
@@ -0,0 +1,134 @@
+/*
+   Copyright 2021 The Kubermatic Kubernetes Platform contributors.
+
+   Licensed under the Apache License, Version 2.0 (the "License");
+   you may not use this file except in compliance with the License.
+   You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+   Unless required by applicable law or agreed to in writing, software
+   distributed under the License is distributed on an "AS IS" BASIS,
+   WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+   See the License for the specific language governing permissions and
+   limitations under the License.
+*/
+
+package reconciler_test
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	api "github.com/kubermatic-labs/registryman/pkg/apis/registryman/v1alpha1"
+	"github.com/kubermatic-labs/registryman/pkg/config/registry"
+	"github.com/kubermatic-labs/registryman/pkg/globalregistry/reconciler"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+var _ = Describe("UserGroupStatus", func() {
+	capabilities := api.RegistryCapabilities{
+		HasUserGroups:           true,
+		CanManipulateUserGroups: true,
+	}
+	developers := api.UserGroupStatus{
+		Name:      "developers",
+		GroupType: "LDAP",
+		DN:        "cn=developers,ou=groups,dc=example,dc=com",
+	}
+	testers := api.UserGroupStatus{
+		Name:      "testers",
+		GroupType: "OIDC",
+	}
+	ownedDevelopers := developers
+	ownedDevelopers.Owned = true
+	ownedTesters := testers
+	ownedTesters.Owned = true
+
+	It("returns no action when the user groups are used", func() {
+		act := []api.UserGroupStatus{ownedDevelopers, testers}
+		exp := []api.UserGroupStatus{testers, developers}
+		actions := reconciler.CompareUserGroupStatuses(act, exp, capabilities)
+		Expect(actions).ToNot(BeNil())
+		Expect(len(actions)).To(Equal(0))
+	})
+
+	It("records the missing user groups as owned", func() {
+		act := []api.UserGroupStatus{}
+		exp := []api.UserGroupStatus{developers}
+		actions := reconciler.CompareUserGroupStatuses(act, exp, capabilities)
+		Expect(actionsToStrings(actions)).To(Equal([]string{
+			"recording the owned user groups",
+		}))
+	})
+
+	It("removes the orphaned owned user groups", func() {
+		act := []api.UserGroupStatus{ownedDevelopers, ownedTesters}
+		exp := []api.UserGroupStatus{developers}
+		actions := reconciler.CompareUserGroupStatuses(act, exp, capabilities)
+		Expect(actionsToStrings(actions)).To(Equal([]string{
+			"removing orphaned OIDC user group testers",
+			"recording the owned user groups",
+		}))
+
+		actions = reconciler.CompareUserGroupStatuses(act, nil, capabilities)
+		Expect(actionsToStrings(actions)).To(Equal([]string{
+			"removing orphaned LDAP user group developers",
+			"removing orphaned OIDC user group testers",
+			"recording the owned user groups",
+		}))
+	})
+
+	It("keeps the user groups which are not owned", func() {
+		act := []api.UserGroupStatus{developers, testers}
+		actions := reconciler.CompareUserGroupStatuses(act, nil, capabilities)
+		Expect(actions).ToNot(BeNil())
+		Expect(len(actions)).To(Equal(0))
+
+		act = []api.UserGroupStatus{developers, ownedTesters}
+		actions = reconciler.CompareUserGroupStatuses(act, nil, capabilities)
+		Expect(actionsToStrings(actions)).To(Equal([]string{
+			"removing orphaned OIDC user group testers",
+			"recording the owned user groups",
+		}))
+	})
+
+	It("removes the owned user groups with different type", func() {
+		act := []api.UserGroupStatus{ownedTesters}
+		exp := []api.UserGroupStatus{{Name: "testers", GroupType: "LDAP"}}
+		actions := reconciler.CompareUserGroupStatuses(act, exp, capabilities)
+		Expect(actionsToStrings(actions)).To(Equal([]string{
+			"removing orphaned OIDC user group testers",
+			"recording the owned user groups",
+		}))
+	})
+
+	It("returns no action when the registry cannot remove user groups", func() {
+		act := []api.UserGroupStatus{ownedDevelopers}
+		actions := reconciler.CompareUserGroupStatuses(act, nil, api.RegistryCapabilities{
+			HasUserGroups: true,
+		})
+		Expect(actions).ToNot(BeNil())
+		Expect(len(actions)).To(Equal(0))
+	})
+
+	It("reads the ownership of the user groups from the ConfigMap", func() {
+		reg := registry.New(&api.Registry{
+			ObjectMeta: metav1.ObjectMeta{Name: "reg"},
+		}, nil)
+		status := &api.RegistryStatus{
+			UserGroups: []api.UserGroupStatus{developers, testers},
+		}
+		err := reconciler.AddRecordedStatus(context.Background(), recordedStatus{
+			configMaps: map[string]map[string]string{
+				"reg---usergroups": {
+					"userGroups": `[{"name":"testers","groupType":"OIDC"}]`,
+				},
+			},
+		}, reg, status)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(status.UserGroups).To(Equal([]api.UserGroupStatus{developers, ownedTesters}))
+	})
+})
@@ -0,0 +1,170 @@
+/*
+   Copyright 2021 The Kubermatic Kubernetes Platform contributors.
+
+   Licensed under the Apache License, Version 2.0 (the "License");
+   you may not use this file except in compliance with the License.
+   You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+   Unless required by applicable law or agreed to in writing, software
+   distributed under the License is distributed on an "AS IS" BASIS,
+   WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+   See the License for the specific language governing permissions and
+   limitations under the License.
+*/
+
+package reconciler
+
+import (
+	"context"
+	"fmt"
+
+	api "github.com/kubermatic-labs/registryman/pkg/apis/registryman/v1alpha1"
+	"github.com/kubermatic-labs/registryman/pkg/globalregistry"
+)
+
+type webhookAddAction struct {
+	projectName string
+	api.WebhookStatus
+}
+
+var _ Action = &webhookAddAction{}
+
+func (a *webhookAddAction) String() string {
+	return fmt.Sprintf("adding webhook %s to project %s: %s %v",
+		a.Name, a.projectName, a.Endpoint, a.EventTypes)
+}
+
+func (a *webhookAddAction) Perform(ctx context.Context, reg globalregistry.Registry) (SideEffect, error) {
+	project, err := reg.(globalregistry.RegistryWithProjects).GetProjectByName(ctx, a.projectName)
+	if err != nil {
+		return nilEffect, err
+	}
+	webhookManipulatorProject, ok := project.(globalregistry.WebhookManipulatorProject)
+	if !ok {
+		return nilEffect, nil
+	}
+	return nilEffect, webhookManipulatorProject.AddWebhook(ctx, a.WebhookStatus)
+}
+
+type webhookUpdateAction struct {
+	projectName string
+	api.WebhookStatus
+}
+
+var _ Action = &webhookUpdateAction{}
+
+func (a *webhookUpdateAction) String() string {
+	return fmt.Sprintf("updating webhook %s of project %s: %s %v",
+		a.Name, a.projectName, a.Endpoint, a.EventTypes)
+}
+
+func (a *webhookUpdateAction) Perform(ctx context.Context, reg globalregistry.Registry) (SideEffect, error) {
+	project, err := reg.(globalregistry.RegistryWithProjects).GetProjectByName(ctx, a.projectName)
+	if err != nil {
+		return nilEffect, err
+	}
+	webhookManipulatorProject, ok := project.(globalregistry.WebhookManipulatorProject)
+	if !ok {
+		return nilEffect, nil
+	}
+	return nilEffect, webhookManipulatorProject.UpdateWebhook(ctx, a.WebhookStatus)
+}
+
+type webhookRemoveAction struct {
+	projectName string
+	api.WebhookStatus
+}
+
+var _ Action = &webhookRemoveAction{}
+
+func (a *webhookRemoveAction) String() string {
+	return fmt.Sprintf("removing webhook %s from project %s",
+		a.Name, a.projectName)
+}
+
+func (a *webhookRemoveAction) Perform(ctx context.Context, reg globalregistry.Registry) (SideEffect, error) {
+	project, err := reg.(globalregistry.RegistryWithProjects).GetProjectByName(ctx, a.projectName)
+	if err != nil {
+		return nilEffect, err
+	}
+	webhookManipulatorProject, ok := project.(globalregistry.WebhookManipulatorProject)
+	if !ok {
+		return nilEffect, nil
+	}
+	return nilEffect, webhookManipulatorProject.RemoveWebhook(ctx, a.WebhookStatus)
+}
+
+// webhookEquals returns true if the two webhooks notify the same endpoint
+// about the same events in the same way. The order of the event types is
+// ignored. The auth headers are compared by their digests.
+func webhookEquals(a, b api.WebhookStatus) bool {
+	if a.Endpoint != b.Endpoint ||
+		a.SkipCertVerify != b.SkipCertVerify ||
+		a.Enabled != b.Enabled ||
+		a.AuthHeaderDigest != b.AuthHeaderDigest {
+		return false
+	}
+	eventTypes := make(map[api.WebhookEventType]bool)
+	for _, eventType := range a.EventTypes {
+		eventTypes[eventType] = true
+	}
+	otherEventTypes := make(map[api.WebhookEventType]bool)
+	for _, eventType := range b.EventTypes {
+		if !eventTypes[eventType] {
+			return false
+		}
+		otherEventTypes[eventType] = true
+	}
+	return len(eventTypes) == len(otherEventTypes)
+}
+
+// CompareWebhookStatuses compares the actual and expected webhooks of a
+// project. The webhooks are identified by their names. The function returns
+// the actions that are needed to synchronize the actual state to the expected
+// state. If the expected webhooks are nil, the webhooks of the project are not
+// managed and no action is returned.
+func CompareWebhookStatuses(projectName string, actual, expected []api.WebhookStatus, regCapabilities api.RegistryCapabilities) []Action {
+	actions := make([]Action, 0)
+
+	if !regCapabilities.CanManipulateProjectWebhooks || expected == nil {
+		return actions
+	}
+	actualWebhooks := make(map[string]api.WebhookStatus)
+	for _, webhook := range actual {
+		actualWebhooks[webhook.Name] = webhook
+	}
+	expectedWebhooks := make(map[string]bool)
+	for _, webhook := range expected {
+		expectedWebhooks[webhook.Name] = true
+	}
+
+	// webhooks which are there but are not needed
+	for _, webhook := range actual {
+		if !expectedWebhooks[webhook.Name] {
+			actions = append(actions, &webhookRemoveAction{
+				projectName:   projectName,
+				WebhookStatus: webhook,
+			})
+		}
+	}
+
+	// webhooks which are missing or differ
+	for _, webhook := range expected {
+		actualWebhook, found := actualWebhooks[webhook.Name]
+		switch {
+		case !found:
+			actions = append(actions, &webhookAddAction{
+				projectName:   projectName,
+				WebhookStatus: webhook,
+			})
+		case !webhookEquals(actualWebhook, webhook):
+			actions = append(actions, &webhookUpdateAction{
+				projectName:   projectName,
+				WebhookStatus: webhook,
+			})
+		}
+	}
+	return actions
+}
@@ -0,0 +1,188 @@
+/*
+   Copyright 2021 The Kubermatic Kubernetes Platform contributors.
+
+   Licensed under the Apache License, Version 2.0 (the "License");
+   you may not use this file except in compliance with the License.
+   You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+   Unless required by applicable law or agreed to in writing, software
+   distributed under the License is distributed on an "AS IS" BASIS,
+   WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+   See the License for the specific language governing permissions and
+   limitations under the License.
+*/
+
+package reconciler_test
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	api "github.com/kubermatic-labs/registryman/pkg/apis/registryman/v1alpha1"
+	"github.com/kubermatic-labs/registryman/pkg/globalregistry/reconciler"
+)
+
+var _ = Describe("WebhookStatus", func() {
+	capabilities := api.RegistryCapabilities{
+		CanManipulateProjectWebhooks: true,
+	}
+
+	It("returns no action when the webhooks are not managed", func() {
+		act := []api.WebhookStatus{
+			{
+				Name:       "ci",
+				Endpoint:   "https://ci.example.com/hook",
+				EventTypes: []api.WebhookEventType{api.PushWebhookEvent},
+			},
+		}
+		actions := reconciler.CompareWebhookStatuses("proj", act, nil, capabilities)
+		Expect(actions).ToNot(BeNil())
+		Expect(len(actions)).To(Equal(0))
+	})
+
+	It("returns no action for the same webhooks", func() {
+		act := []api.WebhookStatus{
+			{
+				Name:             "ci",
+				Endpoint:         "https://ci.example.com/hook",
+				EventTypes:       []api.WebhookEventType{api.PushWebhookEvent, api.DeleteWebhookEvent},
+				Enabled:          true,
+				AuthHeaderDigest: api.AuthHeaderDigest("Bearer token"),
+			},
+		}
+		exp := []api.WebhookStatus{
+			{
+				Name:             "ci",
+				Endpoint:         "https://ci.example.com/hook",
+				EventTypes:       []api.WebhookEventType{api.DeleteWebhookEvent, api.PushWebhookEvent},
+				Enabled:          true,
+				AuthHeaderDigest: api.AuthHeaderDigest("Bearer token"),
+			},
+		}
+		actions := reconciler.CompareWebhookStatuses("proj", act, exp, capabilities)
+		Expect(actions).ToNot(BeNil())
+		Expect(len(actions)).To(Equal(0))
+	})
+
+	It("updates the webhook when the auth header changes", func() {
+		act := []api.WebhookStatus{
+			{
+				Name:             "ci",
+				Endpoint:         "https://ci.example.com/hook",
+				EventTypes:       []api.WebhookEventType{api.PushWebhookEvent},
+				Enabled:          true,
+				AuthHeader:       "*****",
+				AuthHeaderDigest: api.AuthHeaderDigest("Bearer old"),
+			},
+		}
+		exp := []api.WebhookStatus{
+			{
+				Name:             "ci",
+				Endpoint:         "https://ci.example.com/hook",
+				EventTypes:       []api.WebhookEventType{api.PushWebhookEvent},
+				Enabled:          true,
+				AuthHeader:       "Bearer new",
+				AuthHeaderDigest: api.AuthHeaderDigest("Bearer new"),
+			},
+		}
+		actions := reconciler.CompareWebhookStatuses("proj", act, exp, capabilities)
+		Expect(actionsToStrings(actions)).To(Equal([]string{
+			"updating webhook ci of project proj: https://ci.example.com/hook [Push]",
+		}))
+	})
+
+	It("does not update the webhook when the auth header is masked", func() {
+		act := []api.WebhookStatus{
+			{
+				Name:             "ci",
+				Endpoint:         "https://ci.example.com/hook",
+				EventTypes:       []api.WebhookEventType{api.PushWebhookEvent},
+				Enabled:          true,
+				AuthHeader:       "*****",
+				AuthHeaderDigest: api.AuthHeaderDigest("Bearer token"),
+			},
+		}
+		exp := []api.WebhookStatus{
+			{
+				Name:             "ci",
+				Endpoint:         "https://ci.example.com/hook",
+				EventTypes:       []api.WebhookEventType{api.PushWebhookEvent},
+				Enabled:          true,
+				AuthHeader:       "Bearer token",
+				AuthHeaderDigest: api.AuthHeaderDigest("Bearer token"),
+			},
+		}
+		actions := reconciler.CompareWebhookStatuses("proj", act, exp, capabilities)
+		Expect(actions).To(BeEmpty())
+	})
+
+	It("enables the webhook disabled by hand", func() {
+		act := []api.WebhookStatus{
+			{
+				Name:       "ci",
+				Endpoint:   "https://ci.example.com/hook",
+				EventTypes: []api.WebhookEventType{api.PushWebhookEvent},
+				Enabled:    false,
+			},
+		}
+		exp := []api.WebhookStatus{
+			{
+				Name:       "ci",
+				Endpoint:   "https://ci.example.com/hook",
+				EventTypes: []api.WebhookEventType{api.PushWebhookEvent},
+				Enabled:    true,
+			},
+		}
+		actions := reconciler.CompareWebhookStatuses("proj", act, exp, capabilities)
+		Expect(actionsToStrings(actions)).To(Equal([]string{
+			"updating webhook ci of project proj: https://ci.example.com/hook [Push]",
+		}))
+	})
+
+	It("adds the missing and removes the surplus webhooks", func() {
+		act := []api.WebhookStatus{
+			{
+				Name:       "old",
+				Endpoint:   "https://old.example.com/hook",
+				EventTypes: []api.WebhookEventType{api.PushWebhookEvent},
+			},
+		}
+		exp := []api.WebhookStatus{
+			{
+				Name:       "scan",
+				Endpoint:   "https://scan.example.com/hook",
+				EventTypes: []api.WebhookEventType{api.ScanningCompletedWebhookEvent, api.ScanningFailedWebhookEvent},
+			},
+		}
+		actions := reconciler.CompareWebhookStatuses("proj", act, exp, capabilities)
+		Expect(actionsToStrings(actions)).To(Equal([]string{
+			"removing webhook old from project proj",
+			"adding webhook scan to project proj: https://scan.example.com/hook [ScanningCompleted ScanningFailed]",
+		}))
+	})
+
+	It("adds the webhooks of the new projects", func() {
+		exp := []api.ProjectStatus{
+			{
+				Name: "proj",
+				Webhooks: []api.WebhookStatus{
+					{
+						Name:       "ci",
+						Endpoint:   "https://ci.example.com/hook",
+						EventTypes: []api.WebhookEventType{api.PushWebhookEvent},
+					},
+				},
+			},
+		}
+		actions := reconciler.CompareProjectStatuses(nil, []api.ProjectStatus{}, exp, api.RegistryCapabilities{
+			CanCreateProject:             true,
+			CanManipulateProjectWebhooks: true,
+		})
+		Expect(actionsToStrings(actions)).To(Equal([]string{
+			"adding project proj",
+			"adding webhook ci to project proj: https://ci.example.com/hook [Push]",
+		}))
+	})
+})
@@ -29,11 +29,13 @@ type RegistryCreator func(logr.Logger, Registry) (Registry, error)
 var (
 	registeredRegistryCreators        map[string]RegistryCreator
 	registeredReplicationCapabilities map[string]ReplicationCapabilities
+	registeredRoleCapabilities        map[string]RoleCapabilities
 )
 
 func init() {
 	registeredRegistryCreators = make(map[string]RegistryCreator)
 	registeredReplicationCapabilities = make(map[string]ReplicationCapabilities)
+	registeredRoleCapabilities = make(map[string]RoleCapabilities)
 }
 
 // ReplicationCapabilities interface defines the methods that show the
@@ -66,6 +68,14 @@ type Registry interface {
 	GetInsecureSkipTLSVerify() bool
 }
 
+// RegistryWithToken interface is implemented by the registry configurations
+// that can provide an API token besides the username and password.
+type RegistryWithToken interface {
+	// GetToken returns the API token of the registry. Empty string is
+	// returned if no token is configured.
+	GetToken() string
+}
+
 // ProjectCreator interface defines the methods of a registry that can create a
 // new project.
 type ProjectCreator interface {
@@ -88,10 +98,14 @@ func New(logger logr.Logger, config Registry) (Registry, error) {
 // RegisterProviderImplementation is used by the different Registry interface
 // implementations to register the Register constructors. After a constructor
 // function is registered, a new Registry can be created using the New function.
+// The providers declare the replication capabilities and the project member
+// roles that they support, too.
 func RegisterProviderImplementation(providerName string,
 	constructor RegistryCreator,
 	repCap ReplicationCapabilities,
+	roleCap RoleCapabilities,
 ) {
 	registeredRegistryCreators[providerName] = constructor
 	registeredReplicationCapabilities[providerName] = repCap
+	registeredRoleCapabilities[providerName] = roleCap
 }
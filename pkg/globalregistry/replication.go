@@ -53,6 +53,45 @@ type DestructibleReplicationRule interface {
 	Delete(context.Context) error
 }
 
+// ReplicationRuleWithOptions interface declares the methods that can be used to
+// inspect which artifacts are replicated by a replication rule and how.
+type ReplicationRuleWithOptions interface {
+	// Options returns the filters and the behavior of the replication.
+	Options() api.ReplicationOptions
+}
+
+// ReplicationRuleWithState interface declares the methods that can be used to
+// check whether a replication rule is active. The replication rules that do not
+// implement this interface are considered to be always enabled.
+type ReplicationRuleWithState interface {
+	// Enabled returns whether the replication rule is active.
+	Enabled() bool
+}
+
+// UpdatableReplicationRule interface declares the methods that can be used to
+// change the mutable attributes of a replication rule in place, so that the
+// identity and the execution history of the rule are kept.
+type UpdatableReplicationRule interface {
+	// Update sets the trigger, the options and the enabled flag of the
+	// replication rule.
+	Update(ctx context.Context, trigger ReplicationTrigger, options api.ReplicationOptions, enabled bool) error
+}
+
+// ExecutableReplicationRule interface declares the methods that can be used to
+// inspect and start the executions of a replication rule.
+type ExecutableReplicationRule interface {
+	// GetLastExecution returns the most recent execution of the
+	// replication rule or nil if the rule has not been executed yet.
+	GetLastExecution(context.Context) (*api.ReplicationExecutionStatus, error)
+
+	// GetExecution returns the execution with the given ID.
+	GetExecution(ctx context.Context, id int) (api.ReplicationExecutionStatus, error)
+
+	// Execute starts a manual execution of the replication rule and
+	// returns the ID of the execution.
+	Execute(context.Context) (int, error)
+}
+
 // UpdatableRemoteRegistryReplicationRule interface declares the methods that
 // can be used to update the remote registry
 type UpdatableRemoteRegistryReplicationRule interface {
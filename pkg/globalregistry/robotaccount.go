@@ -0,0 +1,55 @@
+/*
+   Copyright 2021 The Kubermatic Kubernetes Platform contributors.
+
+   Licensed under the Apache License, Version 2.0 (the "License");
+   you may not use this file except in compliance with the License.
+   You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+   Unless required by applicable law or agreed to in writing, software
+   distributed under the License is distributed on an "AS IS" BASIS,
+   WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+   See the License for the specific language governing permissions and
+   limitations under the License.
+*/
+
+package globalregistry
+
+import (
+	"context"
+
+	api "github.com/kubermatic-labs/registryman/pkg/apis/registryman/v1alpha1"
+)
+
+// RobotAccount interface contains the methods that can be used to inspect a
+// registry level (system) robot account.
+type RobotAccount interface {
+	// GetName returns the name of the robot account.
+	GetName() string
+
+	// GetStatus returns the actual or expected state of the robot account.
+	GetStatus() api.RobotAccountStatus
+}
+
+// RegistryWithRobotAccounts interface contains the methods that we use for
+// registries which understand the concept of registry level robot accounts.
+type RegistryWithRobotAccounts interface {
+	// ListRobotAccounts returns the robot accounts of the registry.
+	ListRobotAccounts(context.Context) ([]RobotAccount, error)
+}
+
+// RobotAccountManipulatorRegistry interface contains the methods that are
+// needed to create, update and delete the registry level robot accounts.
+type RobotAccountManipulatorRegistry interface {
+	// CreateRobotAccount creates a new robot account and returns its
+	// credentials.
+	CreateRobotAccount(context.Context, api.RobotAccountStatus) (*ProjectMemberCredentials, error)
+
+	// UpdateRobotAccount updates the description and the project
+	// permissions of an existing robot account.
+	UpdateRobotAccount(context.Context, api.RobotAccountStatus) error
+
+	// DeleteRobotAccount removes the robot account with the given name.
+	DeleteRobotAccount(ctx context.Context, name string) error
+}
@@ -0,0 +1,188 @@
+/*
+   Copyright 2021 The Kubermatic Kubernetes Platform contributors.
+
+   Licensed under the Apache License, Version 2.0 (the "License");
+   you may not use this file except in compliance with the License.
+   You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+   Unless required by applicable law or agreed to in writing, software
+   distributed under the License is distributed on an "AS IS" BASIS,
+   WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+   See the License for the specific language governing permissions and
+   limitations under the License.
+*/
+
+package globalregistry
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// UnknownRole is the role of the project members whose provider specific role
+// cannot be mapped to a registryman role.
+const UnknownRole = "*unknown-role*"
+
+// RoleTable describes the project member roles that a registry provider can
+// express. The keys of the table are the member types (User, Group, Robot),
+// the values map the registryman roles (e.g. Developer) to the provider
+// specific roles. A provider specific role can be a comma separated list,
+// e.g. of permissions, in which case the order of the items is not
+// significant.
+type RoleTable map[string]map[string]string
+
+// RoleCapabilities interface defines the method that shows the project member
+// roles of a registry provider.
+type RoleCapabilities interface {
+	// GetRoleTable returns the role table of the provider for the given
+	// registry configuration.
+	GetRoleTable(config Registry) RoleTable
+}
+
+var _ RoleCapabilities = RoleTable{}
+
+// GetRoleTable returns the role table itself, so that the providers with a
+// single role table can register the table directly.
+func (rt RoleTable) GetRoleTable(Registry) RoleTable {
+	return rt
+}
+
+// RegistryWithRoleMapping interface is implemented by the registry
+// configurations which override the provider specific roles of the registryman
+// roles.
+type RegistryWithRoleMapping interface {
+	// GetRoleMapping returns the provider specific roles of the
+	// registryman roles in the form of a role table.
+	GetRoleMapping() RoleTable
+}
+
+// ProviderRole returns the provider specific role of the registryman role for
+// the given member type. false is returned if the provider cannot express the
+// role.
+func (rt RoleTable) ProviderRole(memberType, role string) (string, bool) {
+	providerRole, found := rt[memberType][role]
+	return providerRole, found
+}
+
+// ProviderRoleOf is like ProviderRole, but it returns an error if the provider
+// cannot express the role.
+func (rt RoleTable) ProviderRoleOf(memberType, role string) (string, error) {
+	providerRole, found := rt.ProviderRole(memberType, role)
+	if !found {
+		return "", fmt.Errorf("%s role %s is not supported", strings.ToLower(memberType), role)
+	}
+	return providerRole, nil
+}
+
+// Role returns the registryman role of the provider specific role for the
+// given member type. UnknownRole is returned if the role cannot be found. If
+// several registryman roles are mapped to the same provider specific role, the
+// first one in alphabetical order is returned.
+func (rt RoleTable) Role(memberType, providerRole string) string {
+	roles := make([]string, 0, len(rt[memberType]))
+	for role := range rt[memberType] {
+		roles = append(roles, role)
+	}
+	sort.Strings(roles)
+	providerRole = normalizeProviderRole(providerRole)
+	for _, role := range roles {
+		if normalizeProviderRole(rt[memberType][role]) == providerRole {
+			return role
+		}
+	}
+	return UnknownRole
+}
+
+// RoleOfPermissions returns the registryman role of the provider specific role
+// which is a comma separated list of permissions, for the given member type.
+// Unlike Role, the permissions do not have to match exactly: the role whose
+// permissions are all granted is returned, so that the permissions added by
+// hand do not turn the role unknown. If several roles match, the one with the
+// most permissions is returned, then the first one in alphabetical order.
+// UnknownRole is returned if no role matches.
+func (rt RoleTable) RoleOfPermissions(memberType, permissions string) string {
+	granted := make(map[string]bool)
+	for _, permission := range SplitProviderRole(permissions) {
+		granted[permission] = true
+	}
+	roles := make([]string, 0, len(rt[memberType]))
+	for role := range rt[memberType] {
+		roles = append(roles, role)
+	}
+	sort.Strings(roles)
+	result := UnknownRole
+	resultPermissions := 0
+RoleLoop:
+	for _, role := range roles {
+		rolePermissions := SplitProviderRole(rt[memberType][role])
+		if len(rolePermissions) <= resultPermissions {
+			continue
+		}
+		for _, permission := range rolePermissions {
+			if !granted[permission] {
+				continue RoleLoop
+			}
+		}
+		result = role
+		resultPermissions = len(rolePermissions)
+	}
+	return result
+}
+
+// WithRoleMapping returns a copy of the role table where the provider specific
+// roles are overridden by the mapping. The member types not present in the
+// role table cannot be expressed by the provider, so their mappings are
+// ignored.
+func (rt RoleTable) WithRoleMapping(mapping RoleTable) RoleTable {
+	result := make(RoleTable, len(rt))
+	for memberType, roles := range rt {
+		result[memberType] = make(map[string]string, len(roles)+len(mapping[memberType]))
+		for role, providerRole := range roles {
+			result[memberType][role] = providerRole
+		}
+		for role, providerRole := range mapping[memberType] {
+			result[memberType][role] = providerRole
+		}
+	}
+	return result
+}
+
+// SplitProviderRole returns the items of a provider specific role which is a
+// comma separated list.
+func SplitProviderRole(providerRole string) []string {
+	items := []string{}
+	for _, item := range strings.Split(providerRole, ",") {
+		if item = strings.TrimSpace(item); item != "" {
+			items = append(items, item)
+		}
+	}
+	return items
+}
+
+// normalizeProviderRole sorts the items of a provider specific role, so that
+// the roles can be compared regardless of the order of the items.
+func normalizeProviderRole(providerRole string) string {
+	items := SplitProviderRole(providerRole)
+	sort.Strings(items)
+	return strings.Join(items, ",")
+}
+
+// GetRoleTable function returns the role table of the provider of the registry
+// configuration. The role mapping of the registry configuration is applied to
+// the table. An error is returned if the provider is not registered.
+func GetRoleTable(config Registry) (RoleTable, error) {
+	roleCap, found := registeredRoleCapabilities[config.GetProvider()]
+	if !found {
+		return nil, fmt.Errorf("provider %s is not registered", config.GetProvider())
+	}
+	roleTable := roleCap.GetRoleTable(config)
+	if regWithRoleMapping, ok := config.(RegistryWithRoleMapping); ok {
+		if mapping := regWithRoleMapping.GetRoleMapping(); len(mapping) > 0 {
+			roleTable = roleTable.WithRoleMapping(mapping)
+		}
+	}
+	return roleTable, nil
+}
@@ -26,3 +26,14 @@ type Scanner interface {
 	// GetURL returns the URL of the vulnerability scanner.
 	GetURL() string
 }
+
+// ScannerWithAccessCredential interface is implemented by the scanners which
+// require authentication.
+type ScannerWithAccessCredential interface {
+	Scanner
+
+	// GetAccessCredential returns the value of the HTTP Authorization
+	// header sent with each request to the scanner. Empty string is
+	// returned if no authentication is needed.
+	GetAccessCredential() string
+}
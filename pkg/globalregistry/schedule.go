@@ -0,0 +1,30 @@
+/*
+   Copyright 2021 The Kubermatic Kubernetes Platform contributors.
+
+   Licensed under the Apache License, Version 2.0 (the "License");
+   you may not use this file except in compliance with the License.
+   You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+   Unless required by applicable law or agreed to in writing, software
+   distributed under the License is distributed on an "AS IS" BASIS,
+   WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+   See the License for the specific language governing permissions and
+   limitations under the License.
+*/
+
+package globalregistry
+
+import "github.com/robfig/cron/v3"
+
+// cronParser parses the schedules of the cron replication triggers. The
+// schedules have the 5 fields of the standard cron format, the Harbor provider
+// prepends the seconds field itself. The month and day of week names are
+// accepted too, like by Harbor.
+var cronParser = cron.NewParser(cron.Minute | cron.Hour | cron.Dom | cron.Month | cron.Dow)
+
+// ParseCronSchedule parses the schedule of a cron replication trigger.
+func ParseCronSchedule(schedule string) (cron.Schedule, error) {
+	return cronParser.Parse(schedule)
+}
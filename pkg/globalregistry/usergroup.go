@@ -0,0 +1,60 @@
+/*
+   Copyright 2021 The Kubermatic Kubernetes Platform contributors.
+
+   Licensed under the Apache License, Version 2.0 (the "License");
+   you may not use this file except in compliance with the License.
+   You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+   Unless required by applicable law or agreed to in writing, software
+   distributed under the License is distributed on an "AS IS" BASIS,
+   WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+   See the License for the specific language governing permissions and
+   limitations under the License.
+*/
+
+package globalregistry
+
+import (
+	"context"
+
+	api "github.com/kubermatic-labs/registryman/pkg/apis/registryman/v1alpha1"
+)
+
+// UserGroup interface contains the methods that can be used to inspect a
+// registry level user group. The user groups are used when group members are
+// assigned to the projects.
+type UserGroup interface {
+	// GetName returns the name of the user group.
+	GetName() string
+
+	// GetGroupType returns the type of the user group, e.g. LDAP or OIDC.
+	GetGroupType() string
+
+	// GetDN returns the distinguished name of an LDAP group. It returns
+	// empty string for the other group types.
+	GetDN() string
+}
+
+// RegistryWithUserGroups interface contains the methods that we use for
+// registries which maintain user groups.
+type RegistryWithUserGroups interface {
+	// ListUserGroups returns the user groups of the registry.
+	ListUserGroups(context.Context) ([]UserGroup, error)
+}
+
+// UserGroupManipulatorRegistry interface contains the methods that are needed
+// to clean up the user groups of a registry.
+type UserGroupManipulatorRegistry interface {
+	// DeleteUserGroup removes the user group from the registry.
+	DeleteUserGroup(context.Context, api.UserGroupStatus) error
+}
+
+// LdapGroupResolverRegistry interface contains the method that checks whether
+// the LDAP groups can be resolved by the registry.
+type LdapGroupResolverRegistry interface {
+	// ResolveLdapGroup returns true if the LDAP group with the given DN
+	// is found by the registry.
+	ResolveLdapGroup(ctx context.Context, dn string) (bool, error)
+}
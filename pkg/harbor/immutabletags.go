@@ -0,0 +1,195 @@
+/*
+   Copyright 2021 The Kubermatic Kubernetes Platform contributors.
+
+   Licensed under the Apache License, Version 2.0 (the "License");
+   you may not use this file except in compliance with the License.
+   You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+   Unless required by applicable law or agreed to in writing, software
+   distributed under the License is distributed on an "AS IS" BASIS,
+   WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+   See the License for the specific language governing permissions and
+   limitations under the License.
+*/
+
+package harbor
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	api "github.com/kubermatic-labs/registryman/pkg/apis/registryman/v1alpha1"
+)
+
+type immutableTagRule struct {
+	ID             int                            `json:"id,omitempty"`
+	ProjectID      int                            `json:"project_id"`
+	Disabled       bool                           `json:"disabled"`
+	Priority       int                            `json:"priority"`
+	Action         string                         `json:"action"`
+	Template       string                         `json:"template"`
+	TagSelectors   []retentionSelector            `json:"tag_selectors"`
+	ScopeSelectors map[string][]retentionSelector `json:"scope_selectors"`
+}
+
+// toImmutableTagRule converts the Harbor immutable tag rule to
+// api.ImmutableTagRule.
+func (rule *immutableTagRule) toImmutableTagRule() api.ImmutableTagRule {
+	result := api.ImmutableTagRule{}
+	if len(rule.ScopeSelectors["repository"]) > 0 {
+		result.Repositories = rule.ScopeSelectors["repository"][0].Pattern
+	}
+	if len(rule.TagSelectors) > 0 {
+		result.Tags = rule.TagSelectors[0].Pattern
+	}
+	return result
+}
+
+func (p *project) immutableTagRulesPath() string {
+	return fmt.Sprintf("%s/%d/immutabletagrules", path, p.id)
+}
+
+func (p *project) getImmutableTagRules(ctx context.Context) ([]*immutableTagRule, error) {
+	url := *p.registry.parsedUrl
+	url.Path = p.immutableTagRulesPath()
+	req, err := http.NewRequest(http.MethodGet, url.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+	req.SetBasicAuth(p.registry.GetUsername(), p.registry.GetPassword())
+
+	resp, err := p.registry.do(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	defer resp.Body.Close()
+
+	rules := []*immutableTagRule{}
+	err = json.NewDecoder(resp.Body).Decode(&rules)
+	if err != nil {
+		p.registry.logger.Error(err, "json decoding failed")
+		return nil, err
+	}
+	return rules, nil
+}
+
+// GetImmutableTagPolicy implements the globalregistry.ProjectWithImmutableTags
+// interface. The disabled rules are ignored.
+func (p *project) GetImmutableTagPolicy(ctx context.Context) (*api.ImmutableTagPolicy, error) {
+	p.registry.logger.V(1).Info("getting immutable tag rules of a project",
+		"projectName", p.Name,
+	)
+	rules, err := p.getImmutableTagRules(ctx)
+	if err != nil {
+		return nil, err
+	}
+	result := &api.ImmutableTagPolicy{
+		Rules: make([]api.ImmutableTagRule, 0, len(rules)),
+	}
+	for _, rule := range rules {
+		if rule.Disabled {
+			continue
+		}
+		result.Rules = append(result.Rules, rule.toImmutableTagRule())
+	}
+	return result, nil
+}
+
+// AddImmutableTagRule implements the
+// globalregistry.ImmutableTagManipulatorProject interface.
+func (p *project) AddImmutableTagRule(ctx context.Context, rule api.ImmutableTagRule) error {
+	p.registry.logger.V(1).Info("adding immutable tag rule to a project",
+		"projectName", p.Name,
+		"repositories", rule.RepositoriesPattern(),
+		"tags", rule.Tags,
+	)
+	url := *p.registry.parsedUrl
+	url.Path = p.immutableTagRulesPath()
+	reqBodyBuf := bytes.NewBuffer(nil)
+	err := json.NewEncoder(reqBodyBuf).Encode(&immutableTagRule{
+		ProjectID: p.id,
+		Action:    "immutable",
+		Template:  "immutable_template",
+		TagSelectors: []retentionSelector{
+			{
+				Kind:       "doublestar",
+				Decoration: "matches",
+				Pattern:    rule.Tags,
+			},
+		},
+		ScopeSelectors: map[string][]retentionSelector{
+			"repository": {
+				{
+					Kind:       "doublestar",
+					Decoration: "repoMatches",
+					Pattern:    rule.RepositoriesPattern(),
+				},
+			},
+		},
+	})
+	if err != nil {
+		return err
+	}
+	req, err := http.NewRequest(http.MethodPost, url.String(), reqBodyBuf)
+	if err != nil {
+		return err
+	}
+	req.Header["Content-Type"] = []string{"application/json"}
+	req.SetBasicAuth(p.registry.GetUsername(), p.registry.GetPassword())
+
+	resp, err := p.registry.do(ctx, req)
+	if err != nil {
+		return err
+	}
+
+	defer resp.Body.Close()
+
+	return nil
+}
+
+// RemoveImmutableTagRule implements the
+// globalregistry.ImmutableTagManipulatorProject interface.
+func (p *project) RemoveImmutableTagRule(ctx context.Context, rule api.ImmutableTagRule) error {
+	p.registry.logger.V(1).Info("removing immutable tag rule from a project",
+		"projectName", p.Name,
+		"repositories", rule.RepositoriesPattern(),
+		"tags", rule.Tags,
+	)
+	rules, err := p.getImmutableTagRules(ctx)
+	if err != nil {
+		return err
+	}
+	found := false
+	for _, r := range rules {
+		converted := r.toImmutableTagRule()
+		if converted.RepositoriesPattern() != rule.RepositoriesPattern() ||
+			converted.Tags != rule.Tags {
+			continue
+		}
+		found = true
+		url := *p.registry.parsedUrl
+		url.Path = fmt.Sprintf("%s/%d", p.immutableTagRulesPath(), r.ID)
+		req, err := http.NewRequest(http.MethodDelete, url.String(), nil)
+		if err != nil {
+			return err
+		}
+		req.SetBasicAuth(p.registry.GetUsername(), p.registry.GetPassword())
+
+		resp, err := p.registry.do(ctx, req)
+		if err != nil {
+			return err
+		}
+		resp.Body.Close()
+	}
+	if !found {
+		return fmt.Errorf("immutable tag rule %s:%s not found in project %s",
+			rule.RepositoriesPattern(), rule.Tags, p.Name)
+	}
+	return nil
+}
@@ -26,6 +26,7 @@ import (
 	"strconv"
 	"strings"
 
+	api "github.com/kubermatic-labs/registryman/pkg/apis/registryman/v1alpha1"
 	"github.com/kubermatic-labs/registryman/pkg/globalregistry"
 )
 
@@ -35,6 +36,41 @@ const (
 	groupType = "Group"
 )
 
+// group types of the Harbor user groups
+const (
+	ldapGroupType = 1
+	httpGroupType = 2
+	oidcGroupType = 3
+)
+
+// groupTypeToString converts the Harbor group type to the group type of the
+// API, e.g. LDAP or OIDC.
+func groupTypeToString(groupType int) string {
+	switch groupType {
+	case ldapGroupType:
+		return string(api.LdapGroupType)
+	case httpGroupType:
+		return "HTTP"
+	case oidcGroupType:
+		return string(api.OidcGroupType)
+	default:
+		return fmt.Sprintf("Unknown(%d)", groupType)
+	}
+}
+
+// groupTypeFromString converts the group type of the API to the Harbor group
+// type.
+func groupTypeFromString(groupType string) (int, error) {
+	switch groupType {
+	case string(api.LdapGroupType):
+		return ldapGroupType, nil
+	case string(api.OidcGroupType):
+		return oidcGroupType, nil
+	default:
+		return 0, fmt.Errorf("unsupported group type: %s", groupType)
+	}
+}
+
 type projectMemberEntity struct {
 	EntityId   int    `json:"entity_id"`
 	RoleName   string `json:"role_name"`
@@ -46,6 +82,12 @@ type projectMemberEntity struct {
 
 	// distinguished name for ldap groups
 	dn string
+
+	// type of the user group for group members
+	groupType int
+
+	// registryman role of the member
+	role string
 }
 
 func (m *projectMemberEntity) toProjectMember() globalregistry.ProjectMember {
@@ -55,7 +97,10 @@ func (m *projectMemberEntity) toProjectMember() globalregistry.ProjectMember {
 	case "u":
 		return (*projectMember)(m)
 	case "g":
-		return (*ldapMember)(m)
+		if m.groupType == ldapGroupType {
+			return (*ldapMember)(m)
+		}
+		return (*groupMember)(m)
 	}
 }
 
@@ -72,7 +117,7 @@ func (m *projectMember) GetType() string {
 }
 
 func (m *projectMember) GetRole() string {
-	return m.RoleId.String()
+	return m.role
 }
 
 type ldapMember projectMemberEntity
@@ -88,13 +133,39 @@ func (m *ldapMember) GetType() string {
 }
 
 func (m *ldapMember) GetRole() string {
-	return m.RoleId.String()
+	return m.role
 }
 
 func (m *ldapMember) GetDN() string {
 	return m.dn
 }
 
+func (m *ldapMember) GetGroupType() string {
+	return string(api.LdapGroupType)
+}
+
+// groupMember is a group member which is not an LDAP group, e.g. an OIDC
+// group.
+type groupMember projectMemberEntity
+
+var _ globalregistry.GroupMember = &groupMember{}
+
+func (m *groupMember) GetName() string {
+	return m.EntityName
+}
+
+func (m *groupMember) GetType() string {
+	return groupType
+}
+
+func (m *groupMember) GetRole() string {
+	return m.role
+}
+
+func (m *groupMember) GetGroupType() string {
+	return groupTypeToString(m.groupType)
+}
+
 type userGroup struct {
 	GroupName   string `json:"group_name"`
 	LdapGroupDn string `json:"ldap_group_dn"`
@@ -102,6 +173,20 @@ type userGroup struct {
 	Id          int    `json:"id"`
 }
 
+var _ globalregistry.UserGroup = &userGroup{}
+
+func (ug *userGroup) GetName() string {
+	return ug.GroupName
+}
+
+func (ug *userGroup) GetGroupType() string {
+	return groupTypeToString(ug.GroupType)
+}
+
+func (ug *userGroup) GetDN() string {
+	return ug.LdapGroupDn
+}
+
 type userEntity struct {
 	Username string `json:"username"`
 	UserId   int    `json:"user_id"`
@@ -145,12 +230,32 @@ func (r *registry) getMembers(ctx context.Context, projectID int) ([]*projectMem
 		r.logger.Info(b.String())
 		fmt.Printf("body: %+v\n", b.String())
 	}
+	roleTable, err := r.roleTable()
+	if err != nil {
+		return nil, err
+	}
+	var userGroups map[int]*userGroup
 	for _, member := range projectMembersResult {
-		if member.EntityType == "g" {
-			member.dn, err = r.searchLdapGroup(ctx, member.EntityName)
+		if member.EntityType != "g" {
+			member.role = roleTable.Role(userType, member.RoleId.String())
+			continue
+		}
+		member.role = roleTable.Role(groupType, member.RoleId.String())
+		if userGroups == nil {
+			// the type and the DN of the group members are stored in
+			// the user groups
+			groups, err := r.getUserGroups(ctx)
 			if err != nil {
 				return nil, err
 			}
+			userGroups = make(map[int]*userGroup, len(groups))
+			for _, group := range groups {
+				userGroups[group.Id] = group
+			}
+		}
+		if group, found := userGroups[member.EntityId]; found {
+			member.groupType = group.GroupType
+			member.dn = group.LdapGroupDn
 		}
 	}
 	return projectMembersResult, err
@@ -24,6 +24,7 @@ import (
 	"net/http"
 	"strings"
 
+	api "github.com/kubermatic-labs/registryman/pkg/apis/registryman/v1alpha1"
 	"github.com/kubermatic-labs/registryman/pkg/globalregistry"
 )
 
@@ -42,6 +43,16 @@ var _ globalregistry.ProjectWithScanner = &project{}
 var _ globalregistry.ScannerManipulatorProject = &project{}
 var _ globalregistry.ProjectWithReplication = &project{}
 var _ globalregistry.ProjectWithStorage = &project{}
+var _ globalregistry.ProjectWithStorageQuota = &project{}
+var _ globalregistry.StorageQuotaManipulatorProject = &project{}
+var _ globalregistry.ProjectWithSettings = &project{}
+var _ globalregistry.SettingsManipulatorProject = &project{}
+var _ globalregistry.ProjectWithRetention = &project{}
+var _ globalregistry.RetentionManipulatorProject = &project{}
+var _ globalregistry.ProjectWithImmutableTags = &project{}
+var _ globalregistry.ImmutableTagManipulatorProject = &project{}
+var _ globalregistry.ProjectWithWebhooks = &project{}
+var _ globalregistry.WebhookManipulatorProject = &project{}
 var _ globalregistry.DestructibleProject = &project{}
 var _ globalregistry.ReplicationRuleManipulatorProject = &project{}
 
@@ -77,49 +88,35 @@ func (p *project) Delete(ctx context.Context) error {
 	return p.registry.delete(ctx, p.id)
 }
 
-func robotRoleToAccess(role string) []access {
-	switch role {
-	case "PushOnly":
-		return []access{
-			{
-				Action:   "push",
-				Resource: "repository",
-				// Effect:   "",
-			},
-		}
-	case "PullOnly":
-		return []access{
-			{
-				Action:   "pull",
-				Resource: "repository",
-				// Effect:   "",
-			},
+// robotRoleToAccess returns the repository access of a robot member. The
+// provider specific robot role is the list of the actions on the repositories.
+func robotRoleToAccess(providerRole string) []access {
+	actions := globalregistry.SplitProviderRole(providerRole)
+	accesses := make([]access, len(actions))
+	for i, action := range actions {
+		accesses[i] = access{
+			Action:   action,
+			Resource: "repository",
 		}
-	case "PullAndPush":
-		return []access{
-			{
-				Action:   "pull",
-				Resource: "repository",
-				// Effect:   "",
-			},
-			{
-				Action:   "push",
-				Resource: "repository",
-				// Effect:   "",
-			},
-		}
-	default:
-		panic(fmt.Sprintf("%s robot role is not supported", role))
 	}
+	return accesses
 }
 
 func (p *project) AssignMember(ctx context.Context, member globalregistry.ProjectMember) (*globalregistry.ProjectMemberCredentials, error) {
 	memberType := member.GetType()
+	roleTable, err := p.registry.roleTable()
+	if err != nil {
+		return nil, err
+	}
+	providerRole, err := roleTable.ProviderRoleOf(memberType, member.GetRole())
+	if err != nil {
+		return nil, err
+	}
 	switch memberType {
 	default:
 		return nil, fmt.Errorf("unhandled member type: %s", memberType)
 	case userType:
-		role, err := roleFromString(member.GetRole())
+		role, err := roleFromString(providerRole)
 		if err != nil {
 			return nil, err
 		}
@@ -132,25 +129,25 @@ func (p *project) AssignMember(ctx context.Context, member globalregistry.Projec
 		_, err = p.registry.createProjectMember(ctx, p.id, pum)
 		return nil, err
 	case groupType:
-		groupMember, ok := member.(globalregistry.LdapMember)
-		if !ok {
-			return nil, fmt.Errorf("error assigning group %s to project %s: group is not LDAP group",
-				member.GetName(), p.Name)
-		}
-		role, err := roleFromString(member.GetRole())
+		role, err := roleFromString(providerRole)
 		if err != nil {
 			return nil, err
 		}
-		userGroup := &userGroup{
-			GroupName:   member.GetName(),
-			LdapGroupDn: groupMember.GetDN(),
-			GroupType:   1,
+		userGroup, err := p.registry.userGroupOfMember(ctx, member)
+		if err != nil {
+			return nil, fmt.Errorf("error assigning group %s to project %s: %w",
+				member.GetName(), p.Name, err)
 		}
-
-		_, err = p.registry.updateIDOfUserGroup(ctx, userGroup)
+		found, err := p.registry.updateIDOfUserGroup(ctx, userGroup)
 		if err != nil {
 			return nil, err
 		}
+		if !found {
+			err = p.registry.createUserGroup(ctx, userGroup)
+			if err != nil {
+				return nil, err
+			}
+		}
 
 		pum := &projectMemberRequestBody{
 			RoleId:      role,
@@ -166,11 +163,11 @@ func (p *project) AssignMember(ctx context.Context, member globalregistry.Projec
 			// ExpiresAt:    0,
 			Name: member.GetName(),
 			// Disable:      false,
-			// Duration:     0,
+			Duration: robotMemberDuration(member),
 			// Id:           0,
 			Permissions: []robotPermission{
 				{
-					Access:    robotRoleToAccess(member.GetRole()),
+					Access:    robotRoleToAccess(providerRole),
 					Kind:      "project",
 					Namespace: p.GetName(),
 				},
@@ -179,8 +176,7 @@ func (p *project) AssignMember(ctx context.Context, member globalregistry.Projec
 		// Name:        member.GetName(),
 		// ExpiresAt:   1024,
 		// Description: "generated robot member",
-		// Access:      robotRoleToAccess(member.GetRole()),
-		r, err := p.registry.createProjectRobotMember(ctx, prm)
+		r, err := p.registry.createRobot(ctx, prm)
 		if err != nil {
 			return nil, err
 		}
@@ -211,8 +207,13 @@ func (p *project) GetMembers(ctx context.Context) ([]globalregistry.ProjectMembe
 	}
 
 	// collecting the members of type Robot
+	roleTable, err := p.registry.roleTable()
+	if err != nil {
+		return nil, err
+	}
 	for _, robot := range robotMembers {
 		robot.Name = strings.TrimPrefix(robot.Name, fmt.Sprintf("robot$%s+", p.GetName()))
+		robot.role = roleTable.Role(robotType, robot.repositoryActions())
 		members[c] = robot
 		c++
 	}
@@ -244,28 +245,56 @@ func (p *project) UnassignMember(ctx context.Context, member globalregistry.Proj
 		err = p.registry.deleteProjectMember(ctx, p.id, m.Id)
 	case robotType:
 		var m *robot
-		var members []*robot
-		members, err = p.registry.getRobotMembers(ctx, p.id)
+		m, err = p.getRobotMember(ctx, member.GetName())
 		if err != nil {
 			return err
 		}
-		expectedName := fmt.Sprintf("robot$%s+%s", p.GetName(), member.GetName())
-		for _, memb := range members {
-			if memb.GetName() == expectedName {
-				m = memb
-				break
-			}
-		}
-		if m == nil {
-			return fmt.Errorf("robot member not found")
-		}
 		err = p.registry.deleteProjectRobotMember(ctx, p.id, m.Id)
 	}
 	return err
 }
 
-func (p *project) AssignReplicationRule(ctx context.Context, remoteReg globalregistry.Registry, trigger globalregistry.ReplicationTrigger, direction string) (globalregistry.ReplicationRule, error) {
-	return p.registry.createReplicationRule(ctx, p, remoteReg, trigger, direction)
+// getRobotMember returns the robot member of the project with the given name
+// (without the robot prefix).
+func (p *project) getRobotMember(ctx context.Context, name string) (*robot, error) {
+	members, err := p.registry.getRobotMembers(ctx, p.id)
+	if err != nil {
+		return nil, err
+	}
+	expectedName := fmt.Sprintf("robot$%s+%s", p.GetName(), name)
+	for _, memb := range members {
+		if memb.GetName() == expectedName {
+			return memb, nil
+		}
+	}
+	return nil, fmt.Errorf("robot member not found")
+}
+
+var _ globalregistry.CredentialRotatorProject = &project{}
+
+// RotateMemberCredentials refreshes the secret of a robot member. The
+// expiration of the robot is not changed.
+func (p *project) RotateMemberCredentials(ctx context.Context, member globalregistry.ProjectMember) (*globalregistry.ProjectMemberCredentials, error) {
+	if member.GetType() != robotType {
+		return nil, fmt.Errorf("credentials of %s member %s cannot be rotated",
+			member.GetType(), member.GetName())
+	}
+	m, err := p.getRobotMember(ctx, member.GetName())
+	if err != nil {
+		return nil, err
+	}
+	secret, err := p.registry.refreshRobotSecret(ctx, m.Id)
+	if err != nil {
+		return nil, err
+	}
+	return &globalregistry.ProjectMemberCredentials{
+		Username: m.Name,
+		Password: secret,
+	}, nil
+}
+
+func (p *project) AssignReplicationRule(ctx context.Context, remoteReg globalregistry.Registry, trigger globalregistry.ReplicationTrigger, direction string, options api.ReplicationOptions) (globalregistry.ReplicationRule, error) {
+	return p.registry.createReplicationRule(ctx, p, remoteReg, trigger, direction, options)
 }
 
 func (p *project) GetRepositories(ctx context.Context) ([]string, error) {
@@ -359,6 +388,7 @@ type projectStatusQuotaUsed struct {
 	Storage int `json:"storage"`
 }
 type projectStatusQuota struct {
+	Hard projectStatusQuotaUsed `json:"hard"`
 	Used projectStatusQuotaUsed `json:"used"`
 }
 
@@ -371,17 +401,48 @@ func (p *project) GetUsedStorage(ctx context.Context) (int, error) {
 	p.registry.logger.V(1).Info("getting storage usage of a project",
 		"projectName", p.Name,
 	)
+	parsedResponse, err := p.getSummary(ctx)
+	if err != nil {
+		return -1, err
+	}
+	return parsedResponse.Quota.Used.Storage, nil
+}
+
+// GetStorageQuota implements the globalregistry.ProjectWithStorageQuota
+// interface.
+func (p *project) GetStorageQuota(ctx context.Context) (int, error) {
+	p.registry.logger.V(1).Info("getting storage quota of a project",
+		"projectName", p.Name,
+	)
+	parsedResponse, err := p.getSummary(ctx)
+	if err != nil {
+		return -1, err
+	}
+	return parsedResponse.Quota.Hard.Storage, nil
+}
+
+// SetStorageQuota implements the globalregistry.StorageQuotaManipulatorProject
+// interface.
+func (p *project) SetStorageQuota(ctx context.Context, storageQuota int) error {
+	p.registry.logger.V(1).Info("setting storage quota of a project",
+		"projectName", p.Name,
+		"storageQuota", storageQuota,
+	)
+	return p.registry.setProjectStorageQuota(ctx, p.id, storageQuota)
+}
+
+func (p *project) getSummary(ctx context.Context) (*projectStatusResponse, error) {
 	url := *p.registry.parsedUrl
 	url.Path = fmt.Sprintf("/api/v2.0/projects/%d/summary", p.id)
 	req, err := http.NewRequest(http.MethodGet, url.String(), nil)
 	if err != nil {
-		return -1, err
+		return nil, err
 	}
 	req.SetBasicAuth(p.registry.GetUsername(), p.registry.GetPassword())
 
 	resp, err := p.registry.do(ctx, req)
 	if err != nil {
-		return -1, err
+		return nil, err
 	}
 
 	defer resp.Body.Close()
@@ -398,5 +459,5 @@ func (p *project) GetUsedStorage(ctx context.Context) (int, error) {
 		}
 		p.registry.logger.Info(b.String())
 	}
-	return parsedResponse.Quota.Used.Storage, nil
+	return parsedResponse, nil
 }
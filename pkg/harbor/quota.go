@@ -0,0 +1,105 @@
+/*
+   Copyright 2021 The Kubermatic Kubernetes Platform contributors.
+
+   Licensed under the Apache License, Version 2.0 (the "License");
+   you may not use this file except in compliance with the License.
+   You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+   Unless required by applicable law or agreed to in writing, software
+   distributed under the License is distributed on an "AS IS" BASIS,
+   WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+   See the License for the specific language governing permissions and
+   limitations under the License.
+*/
+
+package harbor
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+)
+
+const quotaPath = "/api/v2.0/quotas"
+
+type quotaResources struct {
+	Storage int `json:"storage"`
+}
+
+type quota struct {
+	ID   int            `json:"id"`
+	Hard quotaResources `json:"hard"`
+}
+
+type quotaUpdateReqBody struct {
+	Hard quotaResources `json:"hard"`
+}
+
+// getProjectQuota returns the quota of the project with the given ID.
+func (r *registry) getProjectQuota(ctx context.Context, projectID int) (*quota, error) {
+	url := *r.parsedUrl
+	url.Path = quotaPath
+	url.RawQuery = fmt.Sprintf("reference=project&reference_id=%d", projectID)
+	req, err := http.NewRequest(http.MethodGet, url.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+	req.SetBasicAuth(r.GetUsername(), r.GetPassword())
+
+	resp, err := r.do(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	defer resp.Body.Close()
+
+	quotas := []*quota{}
+	err = json.NewDecoder(resp.Body).Decode(&quotas)
+	if err != nil {
+		r.logger.Error(err, "json decoding failed")
+		return nil, err
+	}
+	if len(quotas) == 0 {
+		return nil, fmt.Errorf("no quota found for project %d", projectID)
+	}
+	return quotas[0], nil
+}
+
+// setProjectStorageQuota updates the storage limit of the project with the
+// given ID. Harbor handles -1 as unlimited storage.
+func (r *registry) setProjectStorageQuota(ctx context.Context, projectID int, storageQuota int) error {
+	q, err := r.getProjectQuota(ctx, projectID)
+	if err != nil {
+		return err
+	}
+	url := *r.parsedUrl
+	url.Path = fmt.Sprintf("%s/%d", quotaPath, q.ID)
+	reqBodyBuf := bytes.NewBuffer(nil)
+	err = json.NewEncoder(reqBodyBuf).Encode(&quotaUpdateReqBody{
+		Hard: quotaResources{
+			Storage: storageQuota,
+		},
+	})
+	if err != nil {
+		return err
+	}
+	req, err := http.NewRequest(http.MethodPut, url.String(), reqBodyBuf)
+	if err != nil {
+		return err
+	}
+	req.Header["Content-Type"] = []string{"application/json"}
+	req.SetBasicAuth(r.GetUsername(), r.GetPassword())
+
+	resp, err := r.do(ctx, req)
+	if err != nil {
+		return err
+	}
+
+	defer resp.Body.Close()
+
+	return nil
+}
@@ -39,6 +39,7 @@ func init() {
 		"harbor",
 		newRegistry,
 		harborRegistryCapabilities{},
+		harborRoles,
 	)
 }
 
@@ -129,16 +130,16 @@ type searchLdapGroupRespBody struct {
 	LdapGroupDN string `json:"ldap_group_dn"`
 }
 
-// searchLdapGroup returns with the distinguished name of the LDAP group. If
-// LDAP group is not found, it returns with "", nil.
-func (r *registry) searchLdapGroup(ctx context.Context, ldapGroupName string) (string, error) {
+// searchLdapGroup returns with the name of the LDAP group with the given
+// distinguished name. If LDAP group is not found, it returns with "", nil.
+func (r *registry) searchLdapGroup(ctx context.Context, ldapGroupDN string) (string, error) {
 	r.logger.V(1).Info("searching for LDAP group",
-		"ldapGroupName", ldapGroupName,
+		"ldapGroupDN", ldapGroupDN,
 	)
 	url := *r.parsedUrl
 	url.Path = "/api/v2.0/ldap/groups/search"
 	q := url.Query()
-	q.Add("groupname", ldapGroupName)
+	q.Add("groupdn", ldapGroupDN)
 	url.RawQuery = q.Encode()
 	r.logger.V(1).Info("sending HTTP request",
 		"url", url.String(),
@@ -156,6 +157,9 @@ func (r *registry) searchLdapGroup(ctx context.Context, ldapGroupName string) (s
 	case globalregistry.ErrInvalidStatusCode(500):
 		r.logger.V(1).Info("ldap group search failed. Maybe there is no LDAP configured. ignoring")
 		return "", nil
+	case globalregistry.ErrInvalidStatusCode(404):
+		r.logger.V(1).Info("ldap group not found")
+		return "", nil
 	default:
 		r.logger.V(1).Info("other HTTP API request error")
 		return "", err
@@ -180,9 +184,9 @@ func (r *registry) searchLdapGroup(ctx context.Context, ldapGroupName string) (s
 	case 0:
 		return "", nil
 	case 1:
-		return parsedResponse[0].LdapGroupDN, nil
+		return parsedResponse[0].GroupName, nil
 	default:
-		return "", fmt.Errorf("multiple LDAP groups found with the name %s", ldapGroupName)
+		return "", fmt.Errorf("multiple LDAP groups found with the DN %s", ldapGroupDN)
 	}
 }
 
@@ -190,6 +194,9 @@ func (r *registry) getUserGroups(ctx context.Context) ([]*userGroup, error) {
 	r.logger.V(1).Info("listing usergroups")
 	url := *r.parsedUrl
 	url.Path = "/api/v2.0/usergroups"
+	query := url.Query()
+	query.Set("page_size", "100")
+	url.RawQuery = query.Encode()
 	req, err := http.NewRequest(http.MethodGet, url.String(), nil)
 	if err != nil {
 		return nil, err
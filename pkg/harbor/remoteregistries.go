@@ -85,6 +85,15 @@ func remoteRegistryStatusFromRegistry(reg globalregistry.Registry) *remoteRegist
 	case "artifactory":
 		regType = "jfrog-artifactory"
 		insecure = true
+	case "quay":
+		regType = "quay"
+		insecure = reg.GetInsecureSkipTLSVerify()
+	case "distribution":
+		regType = "docker-registry"
+		insecure = reg.GetInsecureSkipTLSVerify()
+	case "gitlab":
+		regType = "gitlab"
+		insecure = reg.GetInsecureSkipTLSVerify()
 	default:
 		panic(fmt.Sprintf("provider %s not implemented", reg.GetProvider()))
 	}
@@ -26,8 +26,65 @@ import (
 )
 
 type replicationFilter struct {
-	Type  string `json:"type"`
-	Value string `json:"value"`
+	Type       string      `json:"type"`
+	Value      interface{} `json:"value"`
+	Decoration string      `json:"decoration,omitempty"`
+}
+
+// newReplicationFilters returns the Harbor replication filters that select the
+// artifacts of the project according to the filters of the replication.
+func newReplicationFilters(projectName string, filters api.ReplicationFilters) []replicationFilter {
+	replFilters := []replicationFilter{
+		{
+			Type:  "name",
+			Value: fmt.Sprintf("%s/**", projectName),
+		},
+	}
+	if filters.Tags != "" {
+		decoration := "matches"
+		if filters.ExcludeTags {
+			decoration = "excludes"
+		}
+		replFilters = append(replFilters, replicationFilter{
+			Type:       "tag",
+			Value:      filters.Tags,
+			Decoration: decoration,
+		})
+	}
+	if filters.Label != "" {
+		replFilters = append(replFilters, replicationFilter{
+			Type:       "label",
+			Value:      []string{filters.Label},
+			Decoration: "matches",
+		})
+	}
+	if filters.ResourceType != "" {
+		replFilters = append(replFilters, replicationFilter{
+			Type:  "resource",
+			Value: string(filters.ResourceType),
+		})
+	}
+	return replFilters
+}
+
+// filterValue returns the value of a replication filter as a string. The value
+// of the label filters is a list, in this case its first element is returned.
+func filterValue(filter replicationFilter) string {
+	switch value := filter.Value.(type) {
+	case string:
+		return value
+	case []string:
+		if len(value) > 0 {
+			return value[0]
+		}
+	case []interface{}:
+		if len(value) > 0 {
+			if s, ok := value[0].(string); ok {
+				return s
+			}
+		}
+	}
+	return ""
 }
 
 type triggerSettings struct {
@@ -40,6 +97,10 @@ type replicationTrigger struct {
 }
 
 func (rt replicationTrigger) TriggerType() api.ReplicationTriggerType {
+	if rt.Type == "scheduled" {
+		// Harbor calls the cron triggers scheduled
+		return api.CronReplicationTriggerType
+	}
 	var tt api.ReplicationTriggerType
 	err := tt.UnmarshalText([]byte(rt.Type))
 	if err != nil {
@@ -49,7 +110,7 @@ func (rt replicationTrigger) TriggerType() api.ReplicationTriggerType {
 }
 
 func (rt replicationTrigger) TriggerSchedule() string {
-	scheduleWords := strings.SplitN(rt.TriggerSettings.Cron, "", 2)
+	scheduleWords := strings.SplitN(rt.TriggerSettings.Cron, " ", 2)
 	if len(scheduleWords) != 2 {
 		return rt.TriggerSettings.Cron
 	}
@@ -72,6 +133,38 @@ type replicationResponseBody struct {
 	Name          string                `json:"name"`
 }
 
+// projectName returns the name of the project that is replicated by the
+// replication rule. The second return value is false if the rule has no name
+// filter.
+func (rp *replicationResponseBody) projectName() (string, bool) {
+	for _, filter := range rp.Filters {
+		if filter.Type == "name" {
+			return strings.TrimSuffix(filterValue(filter), "/**"), true
+		}
+	}
+	return "", false
+}
+
+// options returns the filters and behavior flags of the replication rule.
+func (rp *replicationResponseBody) options() api.ReplicationOptions {
+	options := api.ReplicationOptions{
+		Deletion: rp.Deletion,
+		Override: rp.Override,
+	}
+	for _, filter := range rp.Filters {
+		switch filter.Type {
+		case "tag":
+			options.Filters.Tags = filterValue(filter)
+			options.Filters.ExcludeTags = filter.Decoration == "excludes"
+		case "label":
+			options.Filters.Label = filterValue(filter)
+		case "resource":
+			options.Filters.ResourceType = api.ReplicationResourceType(filterValue(filter))
+		}
+	}
+	return options
+}
+
 func (rp *replicationResponseBody) direction() (string, error) {
 	if rp.SrcRegistry.Name == "Local" {
 		return "Push", nil
@@ -101,10 +194,16 @@ type replicationRule struct {
 	Dir         string
 	ReplTrigger *replicationTrigger
 	Remote      *remoteRegistryStatus
+	options     api.ReplicationOptions
+	enabled     bool
 }
 
 var _ globalregistry.ReplicationRule = &replicationRule{}
 var _ globalregistry.DestructibleReplicationRule = &replicationRule{}
+var _ globalregistry.ReplicationRuleWithOptions = &replicationRule{}
+var _ globalregistry.ReplicationRuleWithState = &replicationRule{}
+var _ globalregistry.UpdatableReplicationRule = &replicationRule{}
+var _ globalregistry.ExecutableReplicationRule = &replicationRule{}
 var _ globalregistry.UpdatableRemoteRegistryReplicationRule = &replicationRule{}
 
 func (r *replicationRule) GetProjectName() string {
@@ -127,6 +226,18 @@ func (r *replicationRule) RemoteRegistry() globalregistry.Registry {
 	return r.Remote
 }
 
+func (r *replicationRule) Options() api.ReplicationOptions {
+	return r.options
+}
+
+func (r *replicationRule) Enabled() bool {
+	return r.enabled
+}
+
+func (r *replicationRule) Update(ctx context.Context, trigger globalregistry.ReplicationTrigger, options api.ReplicationOptions, enabled bool) error {
+	return r.registry.updateReplicationRule(ctx, r, trigger, options, enabled)
+}
+
 func (r *replicationRule) UpdateRemoteRegistry(ctx context.Context, remoteRegistry globalregistry.Registry) error {
 	return r.registry.updateRemoteRegistry(ctx, r.Remote.Id, remoteRegistry)
 }
@@ -134,3 +245,15 @@ func (r *replicationRule) UpdateRemoteRegistry(ctx context.Context, remoteRegist
 func (r *replicationRule) Delete(ctx context.Context) error {
 	return r.registry.deleteReplicationRule(ctx, r.ID)
 }
+
+func (r *replicationRule) GetLastExecution(ctx context.Context) (*api.ReplicationExecutionStatus, error) {
+	return r.registry.getLastReplicationExecution(ctx, r.ID)
+}
+
+func (r *replicationRule) GetExecution(ctx context.Context, id int) (api.ReplicationExecutionStatus, error) {
+	return r.registry.getReplicationExecution(ctx, id)
+}
+
+func (r *replicationRule) Execute(ctx context.Context) (int, error) {
+	return r.registry.startReplicationExecution(ctx, r.ID)
+}
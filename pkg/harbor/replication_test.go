@@ -0,0 +1,89 @@
+/*
+   Copyright 2021 The Kubermatic Kubernetes Platform contributors.
+
+   Licensed under the Apache License, Version 2.0 (the "License");
+   you may not use this file except in compliance with the License.
+   You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+   Unless required by applicable law or agreed to in writing, software
+   distributed under the License is distributed on an "AS IS" BASIS,
+   WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+   See the License for the specific language governing permissions and
+   limitations under the License.
+*/
+
+package harbor
+
+import (
+	"encoding/json"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	api "github.com/kubermatic-labs/registryman/pkg/apis/registryman/v1alpha1"
+)
+
+var _ = Describe("Replication", func() {
+	It("converts the replication options to Harbor filters and back", func() {
+		options := api.ReplicationOptions{
+			Filters: api.ReplicationFilters{
+				Tags:         "*.sig",
+				ExcludeTags:  true,
+				Label:        "release",
+				ResourceType: api.ImageReplicationResourceType,
+			},
+			Deletion: false,
+			Override: true,
+		}
+		policy := &replicationResponseBody{
+			Filters:  newReplicationFilters("app", options.Filters),
+			Deletion: options.Deletion,
+			Override: options.Override,
+		}
+		b, err := json.Marshal(policy)
+		Expect(err).ToNot(HaveOccurred())
+		decodedPolicy := &replicationResponseBody{}
+		Expect(json.Unmarshal(b, decodedPolicy)).To(Succeed())
+
+		projectName, ok := decodedPolicy.projectName()
+		Expect(ok).To(BeTrue())
+		Expect(projectName).To(Equal("app"))
+		Expect(decodedPolicy.options()).To(Equal(options))
+	})
+
+	It("creates only the name filter if the artifacts are not filtered", func() {
+		Expect(newReplicationFilters("app", api.ReplicationFilters{})).To(Equal([]replicationFilter{
+			{
+				Type:  "name",
+				Value: "app/**",
+			},
+		}))
+	})
+
+	It("does not find the project of the rules without name filter", func() {
+		policy := &replicationResponseBody{
+			Filters: []replicationFilter{
+				{
+					Type:  "resource",
+					Value: "image",
+				},
+			},
+		}
+		_, ok := policy.projectName()
+		Expect(ok).To(BeFalse())
+	})
+
+	It("converts the cron triggers to Harbor triggers and back", func() {
+		trigger := api.ReplicationTrigger{
+			Type:     api.CronReplicationTriggerType,
+			Schedule: "*/5 * * * *",
+		}
+		replTrigger, err := newReplicationTrigger(trigger)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(replTrigger.TriggerSettings.Cron).To(Equal("0 */5 * * * *"))
+		Expect(replTrigger.TriggerType()).To(Equal(api.CronReplicationTriggerType))
+		Expect(replTrigger.TriggerSchedule()).To(Equal("*/5 * * * *"))
+	})
+})
@@ -76,15 +76,17 @@ func (r *registry) listReplicationRules(ctx context.Context) ([]globalregistry.R
 			return nil, err
 		}
 
-		if len(replResult.Filters) >= 1 {
+		if projectName, ok := replResult.projectName(); ok {
 			replicationRules = append(replicationRules, &replicationRule{
 				ID:          replResult.Id,
 				registry:    r,
 				name:        replResult.Name,
-				projectName: strings.TrimSuffix(replResult.Filters[0].Value, "/**"),
+				projectName: projectName,
 				Dir:         dir,
 				ReplTrigger: replResult.Trigger,
 				Remote:      remote,
+				options:     replResult.options(),
+				enabled:     replResult.Enabled,
 			})
 		}
 	}
@@ -92,18 +94,9 @@ func (r *registry) listReplicationRules(ctx context.Context) ([]globalregistry.R
 	return replicationRules, err
 }
 
-func (r *registry) createReplicationRule(ctx context.Context, project globalregistry.Project, remoteReg globalregistry.Registry, trigger globalregistry.ReplicationTrigger, direction string) (globalregistry.ReplicationRule, error) {
-	r.logger.V(1).Info("ReplicationAPI.Create invoked",
-		"project_name", project.GetName(),
-		"remoteReg_name", remoteReg.GetName(),
-		"trigger", trigger,
-		"direction", direction,
-	)
-	local := &remoteRegistryStatus{
-		Name:         "Local",
-		CreationTime: time.Time{}.Format(time.RFC3339),
-		Update_time:  time.Time{}.Format(time.RFC3339),
-	}
+// newReplicationTrigger returns the Harbor representation of the replication
+// trigger.
+func newReplicationTrigger(trigger globalregistry.ReplicationTrigger) (*replicationTrigger, error) {
 	var replTrigger *replicationTrigger
 	switch trigger.TriggerType() {
 	case api.ManualReplicationTriggerType:
@@ -131,6 +124,26 @@ func (r *registry) createReplicationRule(ctx context.Context, project globalregi
 	default:
 		return nil, fmt.Errorf("invalid replication trigger: %s", trigger)
 	}
+	return replTrigger, nil
+}
+
+func (r *registry) createReplicationRule(ctx context.Context, project globalregistry.Project, remoteReg globalregistry.Registry, trigger globalregistry.ReplicationTrigger, direction string, options api.ReplicationOptions) (globalregistry.ReplicationRule, error) {
+	r.logger.V(1).Info("ReplicationAPI.Create invoked",
+		"project_name", project.GetName(),
+		"remoteReg_name", remoteReg.GetName(),
+		"trigger", trigger,
+		"direction", direction,
+		"options", options,
+	)
+	local := &remoteRegistryStatus{
+		Name:         "Local",
+		CreationTime: time.Time{}.Format(time.RFC3339),
+		Update_time:  time.Time{}.Format(time.RFC3339),
+	}
+	replTrigger, err := newReplicationTrigger(trigger)
+	if err != nil {
+		return nil, err
+	}
 	n := time.Now()
 	now := n.Format(time.RFC3339)
 	nowStamp := time.Now().Unix()
@@ -143,19 +156,14 @@ func (r *registry) createReplicationRule(ctx context.Context, project globalregi
 	}
 
 	replicationPolicy := &replicationResponseBody{
-		CreationTime: now,
-		UpdateTime:   now,
-		Enabled:      true,
-		Filters: []replicationFilter{
-			{
-				Type:  "name",
-				Value: fmt.Sprintf("%s/**", project.GetName()),
-			},
-		},
+		CreationTime:  now,
+		UpdateTime:    now,
+		Enabled:       true,
+		Filters:       newReplicationFilters(project.GetName(), options.Filters),
 		DestNamespace: destNamespace,
 		Trigger:       replTrigger,
-		Deletion:      true,
-		Override:      true,
+		Deletion:      options.Deletion,
+		Override:      options.Override,
 	}
 	remoteRegistry, err := r.getRemoteRegistryByNameOrCreate(ctx, remoteReg)
 	if err != nil {
@@ -235,6 +243,8 @@ func (r *registry) createReplicationRule(ctx context.Context, project globalregi
 		Dir:         direction,
 		ReplTrigger: replTrigger,
 		Remote:      remoteRegistry,
+		options:     options,
+		enabled:     true,
 	}, nil
 }
 
@@ -261,3 +271,83 @@ func (r *registry) deleteReplicationRule(ctx context.Context, id int) error {
 	return nil
 }
 
+func (r *registry) getReplicationRule(ctx context.Context, id int) (*replicationResponseBody, error) {
+	url := *r.parsedUrl
+	url.Path = fmt.Sprintf("%s/%d", replicationPolicyPath, id)
+	r.logger.V(1).Info("creating new request", "url", url.String())
+	req, err := http.NewRequest(http.MethodGet, url.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	req.SetBasicAuth(r.GetUsername(), r.GetPassword())
+
+	resp, err := r.do(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	defer resp.Body.Close()
+
+	replicationPolicy := &replicationResponseBody{}
+	err = json.NewDecoder(resp.Body).Decode(replicationPolicy)
+	if err != nil {
+		r.logger.Error(err, "json decoding failed")
+		return nil, err
+	}
+	return replicationPolicy, nil
+}
+
+// updateReplicationRule changes the trigger, the filters, the behavior flags
+// and the enabled flag of an existing replication policy. The other attributes
+// of the policy, like its name and registries, are kept.
+func (r *registry) updateReplicationRule(ctx context.Context, rule *replicationRule, trigger globalregistry.ReplicationTrigger, options api.ReplicationOptions, enabled bool) error {
+	r.logger.V(1).Info("ReplicationAPI.Update invoked",
+		"project_name", rule.projectName,
+		"name", rule.name,
+		"trigger", trigger,
+		"options", options,
+		"enabled", enabled,
+	)
+	replTrigger, err := newReplicationTrigger(trigger)
+	if err != nil {
+		return err
+	}
+	replicationPolicy, err := r.getReplicationRule(ctx, rule.ID)
+	if err != nil {
+		return err
+	}
+	replicationPolicy.UpdateTime = time.Now().Format(time.RFC3339)
+	replicationPolicy.Filters = newReplicationFilters(rule.projectName, options.Filters)
+	replicationPolicy.Trigger = replTrigger
+	replicationPolicy.Deletion = options.Deletion
+	replicationPolicy.Override = options.Override
+	replicationPolicy.Enabled = enabled
+
+	reqBodyBuf := bytes.NewBuffer(nil)
+	err = json.NewEncoder(reqBodyBuf).Encode(replicationPolicy)
+	if err != nil {
+		return err
+	}
+	url := *r.parsedUrl
+	url.Path = fmt.Sprintf("%s/%d", replicationPolicyPath, rule.ID)
+	req, err := http.NewRequest(http.MethodPut, url.String(), reqBodyBuf)
+	if err != nil {
+		return err
+	}
+
+	req.Header["Content-Type"] = []string{"application/json"}
+	req.SetBasicAuth(r.GetUsername(), r.GetPassword())
+
+	resp, err := r.do(ctx, req)
+	if err != nil {
+		return err
+	}
+
+	defer resp.Body.Close()
+
+	rule.ReplTrigger = replTrigger
+	rule.options = options
+	rule.enabled = enabled
+	return nil
+}
@@ -0,0 +1,187 @@
+/*
+   Copyright 2021 The Kubermatic Kubernetes Platform contributors.
+
+   Licensed under the Apache License, Version 2.0 (the "License");
+   you may not use this file except in compliance with the License.
+   You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+   Unless required by applicable law or agreed to in writing, software
+   distributed under the License is distributed on an "AS IS" BASIS,
+   WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+   See the License for the specific language governing permissions and
+   limitations under the License.
+*/
+
+package harbor
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	api "github.com/kubermatic-labs/registryman/pkg/apis/registryman/v1alpha1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const replicationExecutionPath = "/api/v2.0/replication/executions"
+
+// replicationExecutionStates maps the Harbor execution statuses to the API
+// execution states.
+var replicationExecutionStates = map[string]api.ReplicationExecutionState{
+	"InProgress": api.InProgressReplicationExecutionState,
+	"Succeed":    api.SucceededReplicationExecutionState,
+	"Failed":     api.FailedReplicationExecutionState,
+	"Stopped":    api.StoppedReplicationExecutionState,
+}
+
+type replicationExecution struct {
+	ID        int    `json:"id"`
+	PolicyID  int    `json:"policy_id"`
+	Status    string `json:"status"`
+	Trigger   string `json:"trigger"`
+	StartTime string `json:"start_time"`
+	EndTime   string `json:"end_time"`
+	Failed    int    `json:"failed"`
+}
+
+// parseExecutionTime parses the timestamps of the Harbor executions. Harbor
+// returns the zero time for the unfinished executions, nil is returned in this
+// case.
+func parseExecutionTime(s string) *metav1.Time {
+	t, err := time.Parse(time.RFC3339, s)
+	if err != nil || t.IsZero() || t.Year() <= 1 {
+		return nil
+	}
+	mt := metav1.NewTime(t)
+	return &mt
+}
+
+// toReplicationExecutionStatus converts the Harbor execution to
+// api.ReplicationExecutionStatus. The unknown Harbor statuses are considered as
+// failures.
+func (exec *replicationExecution) toReplicationExecutionStatus() api.ReplicationExecutionStatus {
+	state, ok := replicationExecutionStates[exec.Status]
+	if !ok {
+		state = api.FailedReplicationExecutionState
+	}
+	return api.ReplicationExecutionStatus{
+		ID:          exec.ID,
+		State:       state,
+		StartTime:   parseExecutionTime(exec.StartTime),
+		EndTime:     parseExecutionTime(exec.EndTime),
+		FailedTasks: exec.Failed,
+	}
+}
+
+// getLastReplicationExecution returns the most recent execution of the
+// replication policy. Harbor sorts the executions, only the first one is
+// fetched. nil is returned if the policy has not been executed yet.
+func (r *registry) getLastReplicationExecution(ctx context.Context, policyID int) (*api.ReplicationExecutionStatus, error) {
+	url := *r.parsedUrl
+	url.Path = replicationExecutionPath
+	query := url.Query()
+	query.Set("policy_id", strconv.Itoa(policyID))
+	query.Set("sort", "-start_time")
+	query.Set("page", "1")
+	query.Set("page_size", "1")
+	url.RawQuery = query.Encode()
+	r.logger.V(1).Info("creating new request", "url", url.String())
+	req, err := http.NewRequest(http.MethodGet, url.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	req.SetBasicAuth(r.GetUsername(), r.GetPassword())
+
+	resp, err := r.do(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	defer resp.Body.Close()
+
+	executions := []*replicationExecution{}
+	err = json.NewDecoder(resp.Body).Decode(&executions)
+	if err != nil {
+		r.logger.Error(err, "json decoding failed")
+		return nil, err
+	}
+	if len(executions) == 0 {
+		return nil, nil
+	}
+	result := executions[0].toReplicationExecutionStatus()
+	return &result, nil
+}
+
+func (r *registry) getReplicationExecution(ctx context.Context, id int) (api.ReplicationExecutionStatus, error) {
+	url := *r.parsedUrl
+	url.Path = fmt.Sprintf("%s/%d", replicationExecutionPath, id)
+	r.logger.V(1).Info("creating new request", "url", url.String())
+	req, err := http.NewRequest(http.MethodGet, url.String(), nil)
+	if err != nil {
+		return api.ReplicationExecutionStatus{}, err
+	}
+
+	req.SetBasicAuth(r.GetUsername(), r.GetPassword())
+
+	resp, err := r.do(ctx, req)
+	if err != nil {
+		return api.ReplicationExecutionStatus{}, err
+	}
+
+	defer resp.Body.Close()
+
+	execution := &replicationExecution{}
+	err = json.NewDecoder(resp.Body).Decode(execution)
+	if err != nil {
+		r.logger.Error(err, "json decoding failed")
+		return api.ReplicationExecutionStatus{}, err
+	}
+	return execution.toReplicationExecutionStatus(), nil
+}
+
+func (r *registry) startReplicationExecution(ctx context.Context, policyID int) (int, error) {
+	r.logger.V(1).Info("starting replication execution",
+		"policy_id", policyID,
+	)
+	reqBodyBuf := bytes.NewBuffer(nil)
+	err := json.NewEncoder(reqBodyBuf).Encode(&struct {
+		PolicyID int `json:"policy_id"`
+	}{
+		PolicyID: policyID,
+	})
+	if err != nil {
+		return 0, err
+	}
+	url := *r.parsedUrl
+	url.Path = replicationExecutionPath
+	req, err := http.NewRequest(http.MethodPost, url.String(), reqBodyBuf)
+	if err != nil {
+		return 0, err
+	}
+
+	req.Header["Content-Type"] = []string{"application/json"}
+	req.SetBasicAuth(r.GetUsername(), r.GetPassword())
+
+	resp, err := r.do(ctx, req)
+	if err != nil {
+		return 0, err
+	}
+
+	defer resp.Body.Close()
+
+	executionID, err := strconv.Atoi(strings.TrimPrefix(resp.Header.Get("Location"), replicationExecutionPath+"/"))
+	if err != nil {
+		r.logger.Error(err, "cannot parse execution ID from response Location header",
+			"location-header", resp.Header.Get("Location"))
+		return 0, err
+	}
+	return executionID, nil
+}
@@ -0,0 +1,67 @@
+/*
+   Copyright 2021 The Kubermatic Kubernetes Platform contributors.
+
+   Licensed under the Apache License, Version 2.0 (the "License");
+   you may not use this file except in compliance with the License.
+   You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+   Unless required by applicable law or agreed to in writing, software
+   distributed under the License is distributed on an "AS IS" BASIS,
+   WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+   See the License for the specific language governing permissions and
+   limitations under the License.
+*/
+
+package harbor
+
+import (
+	"time"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	api "github.com/kubermatic-labs/registryman/pkg/apis/registryman/v1alpha1"
+)
+
+var _ = Describe("Replication executions", func() {
+	It("converts the finished Harbor executions", func() {
+		execution := &replicationExecution{
+			ID:        12,
+			PolicyID:  3,
+			Status:    "Failed",
+			StartTime: "2021-09-01T10:00:00Z",
+			EndTime:   "2021-09-01T10:05:00Z",
+			Failed:    2,
+		}
+		status := execution.toReplicationExecutionStatus()
+		Expect(status.ID).To(Equal(12))
+		Expect(status.State).To(Equal(api.FailedReplicationExecutionState))
+		Expect(status.FailedTasks).To(Equal(2))
+		Expect(status.StartTime).ToNot(BeNil())
+		Expect(status.StartTime.Time.Equal(time.Date(2021, 9, 1, 10, 0, 0, 0, time.UTC))).To(BeTrue())
+		Expect(status.EndTime).ToNot(BeNil())
+		Expect(status.EndTime.Time.Equal(time.Date(2021, 9, 1, 10, 5, 0, 0, time.UTC))).To(BeTrue())
+	})
+
+	It("does not set the end time of the running executions", func() {
+		execution := &replicationExecution{
+			ID:        13,
+			Status:    "InProgress",
+			StartTime: "2021-09-01T10:00:00Z",
+			EndTime:   "0001-01-01T00:00:00Z",
+		}
+		status := execution.toReplicationExecutionStatus()
+		Expect(status.State).To(Equal(api.InProgressReplicationExecutionState))
+		Expect(status.StartTime).ToNot(BeNil())
+		Expect(status.EndTime).To(BeNil())
+	})
+
+	It("maps the succeeded executions", func() {
+		execution := &replicationExecution{
+			Status: "Succeed",
+		}
+		Expect(execution.toReplicationExecutionStatus().State).To(Equal(api.SucceededReplicationExecutionState))
+	})
+})
@@ -0,0 +1,354 @@
+/*
+   Copyright 2021 The Kubermatic Kubernetes Platform contributors.
+
+   Licensed under the Apache License, Version 2.0 (the "License");
+   you may not use this file except in compliance with the License.
+   You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+   Unless required by applicable law or agreed to in writing, software
+   distributed under the License is distributed on an "AS IS" BASIS,
+   WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+   See the License for the specific language governing permissions and
+   limitations under the License.
+*/
+
+package harbor
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+
+	api "github.com/kubermatic-labs/registryman/pkg/apis/registryman/v1alpha1"
+)
+
+const retentionPath = "/api/v2.0/retentions"
+
+// defaultRetentionSchedule is the cron schedule of the retention policies
+// created by registryman. The retention runs daily at midnight.
+const defaultRetentionSchedule = "0 0 0 * * *"
+
+// The retention rule templates of Harbor and the corresponding values of the
+// Retain field of api.RetentionRule.
+const (
+	latestPushedTemplate     = "latestPushedK"
+	pushedWithinDaysTemplate = "nDaysSinceLastPush"
+
+	latestPushedRetain     = "LatestPushed"
+	pushedWithinDaysRetain = "PushedWithinDays"
+)
+
+type retentionSelector struct {
+	Kind       string `json:"kind"`
+	Decoration string `json:"decoration"`
+	Pattern    string `json:"pattern"`
+}
+
+type retentionRule struct {
+	ID             int                            `json:"id,omitempty"`
+	Priority       int                            `json:"priority"`
+	Disabled       bool                           `json:"disabled"`
+	Action         string                         `json:"action"`
+	Template       string                         `json:"template"`
+	Params         map[string]int                 `json:"params"`
+	TagSelectors   []retentionSelector            `json:"tag_selectors"`
+	ScopeSelectors map[string][]retentionSelector `json:"scope_selectors"`
+}
+
+type retentionTrigger struct {
+	Kind     string            `json:"kind"`
+	Settings map[string]string `json:"settings"`
+}
+
+type retentionScope struct {
+	Level string `json:"level"`
+	Ref   int    `json:"ref"`
+}
+
+type retentionPolicy struct {
+	ID        int              `json:"id,omitempty"`
+	Algorithm string           `json:"algorithm"`
+	Rules     []*retentionRule `json:"rules"`
+	Trigger   retentionTrigger `json:"trigger"`
+	Scope     retentionScope   `json:"scope"`
+}
+
+type projectRetentionMetadata struct {
+	Metadata struct {
+		RetentionID string `json:"retention_id"`
+	} `json:"metadata"`
+}
+
+func retainToTemplate(retain string) (string, error) {
+	switch retain {
+	case latestPushedRetain:
+		return latestPushedTemplate, nil
+	case pushedWithinDaysRetain:
+		return pushedWithinDaysTemplate, nil
+	default:
+		return "", fmt.Errorf("unknown retention rule type: %s", retain)
+	}
+}
+
+func templateToRetain(template string) string {
+	switch template {
+	case latestPushedTemplate:
+		return latestPushedRetain
+	case pushedWithinDaysTemplate:
+		return pushedWithinDaysRetain
+	default:
+		return template
+	}
+}
+
+// toRetentionRule converts the Harbor retention rule to api.RetentionRule.
+func (rule *retentionRule) toRetentionRule() api.RetentionRule {
+	result := api.RetentionRule{
+		Retain: templateToRetain(rule.Template),
+		Count:  rule.Params[rule.Template],
+	}
+	if len(rule.ScopeSelectors["repository"]) > 0 {
+		result.Repositories = rule.ScopeSelectors["repository"][0].Pattern
+	}
+	if len(rule.TagSelectors) > 0 {
+		result.Tags = rule.TagSelectors[0].Pattern
+	}
+	return result
+}
+
+// matches checks whether the Harbor retention rule has the same patterns as
+// the api.RetentionRule.
+func (rule *retentionRule) matches(r api.RetentionRule) bool {
+	converted := rule.toRetentionRule()
+	return converted.RepositoriesPattern() == r.RepositoriesPattern() &&
+		converted.TagsPattern() == r.TagsPattern()
+}
+
+// newRetentionRule converts the api.RetentionRule to a Harbor retention rule.
+func newRetentionRule(r api.RetentionRule) (*retentionRule, error) {
+	template, err := retainToTemplate(r.Retain)
+	if err != nil {
+		return nil, err
+	}
+	return &retentionRule{
+		Action:   "retain",
+		Template: template,
+		Params: map[string]int{
+			template: r.Count,
+		},
+		TagSelectors: []retentionSelector{
+			{
+				Kind:       "doublestar",
+				Decoration: "matches",
+				Pattern:    r.TagsPattern(),
+			},
+		},
+		ScopeSelectors: map[string][]retentionSelector{
+			"repository": {
+				{
+					Kind:       "doublestar",
+					Decoration: "repoMatches",
+					Pattern:    r.RepositoriesPattern(),
+				},
+			},
+		},
+	}, nil
+}
+
+// getRetentionID returns the ID of the retention policy of the project. 0 is
+// returned if the project has no retention policy.
+func (p *project) getRetentionID(ctx context.Context) (int, error) {
+	url := *p.registry.parsedUrl
+	url.Path = fmt.Sprintf("%s/%d", path, p.id)
+	req, err := http.NewRequest(http.MethodGet, url.String(), nil)
+	if err != nil {
+		return 0, err
+	}
+	req.SetBasicAuth(p.registry.GetUsername(), p.registry.GetPassword())
+
+	resp, err := p.registry.do(ctx, req)
+	if err != nil {
+		return 0, err
+	}
+
+	defer resp.Body.Close()
+
+	projectData := &projectRetentionMetadata{}
+	err = json.NewDecoder(resp.Body).Decode(projectData)
+	if err != nil {
+		p.registry.logger.Error(err, "json decoding failed")
+		return 0, err
+	}
+	if projectData.Metadata.RetentionID == "" {
+		return 0, nil
+	}
+	return strconv.Atoi(projectData.Metadata.RetentionID)
+}
+
+// getRetention returns the retention policy of the project. If the project
+// has no retention policy, a new empty policy is returned with ID 0.
+func (p *project) getRetention(ctx context.Context) (*retentionPolicy, error) {
+	retentionID, err := p.getRetentionID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if retentionID == 0 {
+		return &retentionPolicy{
+			Algorithm: "or",
+			Rules:     []*retentionRule{},
+			Trigger: retentionTrigger{
+				Kind: "Schedule",
+				Settings: map[string]string{
+					"cron": defaultRetentionSchedule,
+				},
+			},
+			Scope: retentionScope{
+				Level: "project",
+				Ref:   p.id,
+			},
+		}, nil
+	}
+	url := *p.registry.parsedUrl
+	url.Path = fmt.Sprintf("%s/%d", retentionPath, retentionID)
+	req, err := http.NewRequest(http.MethodGet, url.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+	req.SetBasicAuth(p.registry.GetUsername(), p.registry.GetPassword())
+
+	resp, err := p.registry.do(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	defer resp.Body.Close()
+
+	policy := &retentionPolicy{}
+	err = json.NewDecoder(resp.Body).Decode(policy)
+	if err != nil {
+		p.registry.logger.Error(err, "json decoding failed")
+		return nil, err
+	}
+	policy.ID = retentionID
+	return policy, nil
+}
+
+// putRetention stores the retention policy. A new policy is created if its ID
+// is 0, otherwise the existing policy is updated.
+func (p *project) putRetention(ctx context.Context, policy *retentionPolicy) error {
+	for i, rule := range policy.Rules {
+		rule.Priority = i + 1
+	}
+	url := *p.registry.parsedUrl
+	method := http.MethodPost
+	url.Path = retentionPath
+	if policy.ID != 0 {
+		method = http.MethodPut
+		url.Path = fmt.Sprintf("%s/%d", retentionPath, policy.ID)
+	}
+	reqBodyBuf := bytes.NewBuffer(nil)
+	err := json.NewEncoder(reqBodyBuf).Encode(policy)
+	if err != nil {
+		return err
+	}
+	req, err := http.NewRequest(method, url.String(), reqBodyBuf)
+	if err != nil {
+		return err
+	}
+	req.Header["Content-Type"] = []string{"application/json"}
+	req.SetBasicAuth(p.registry.GetUsername(), p.registry.GetPassword())
+
+	resp, err := p.registry.do(ctx, req)
+	if err != nil {
+		return err
+	}
+
+	defer resp.Body.Close()
+
+	return nil
+}
+
+// GetRetentionPolicy implements the globalregistry.ProjectWithRetention
+// interface.
+func (p *project) GetRetentionPolicy(ctx context.Context) (*api.RetentionPolicy, error) {
+	p.registry.logger.V(1).Info("getting retention policy of a project",
+		"projectName", p.Name,
+	)
+	policy, err := p.getRetention(ctx)
+	if err != nil {
+		return nil, err
+	}
+	result := &api.RetentionPolicy{
+		Rules: make([]api.RetentionRule, 0, len(policy.Rules)),
+	}
+	for _, rule := range policy.Rules {
+		if rule.Disabled {
+			continue
+		}
+		result.Rules = append(result.Rules, rule.toRetentionRule())
+	}
+	return result, nil
+}
+
+// AddRetentionRule implements the globalregistry.RetentionManipulatorProject
+// interface.
+func (p *project) AddRetentionRule(ctx context.Context, rule api.RetentionRule) error {
+	newRule, err := newRetentionRule(rule)
+	if err != nil {
+		return err
+	}
+	policy, err := p.getRetention(ctx)
+	if err != nil {
+		return err
+	}
+	policy.Rules = append(policy.Rules, newRule)
+	return p.putRetention(ctx, policy)
+}
+
+// UpdateRetentionRule implements the globalregistry.RetentionManipulatorProject
+// interface.
+func (p *project) UpdateRetentionRule(ctx context.Context, rule api.RetentionRule) error {
+	newRule, err := newRetentionRule(rule)
+	if err != nil {
+		return err
+	}
+	policy, err := p.getRetention(ctx)
+	if err != nil {
+		return err
+	}
+	for i, r := range policy.Rules {
+		if r.matches(rule) {
+			newRule.ID = r.ID
+			policy.Rules[i] = newRule
+			return p.putRetention(ctx, policy)
+		}
+	}
+	return fmt.Errorf("retention rule %s:%s not found in project %s",
+		rule.RepositoriesPattern(), rule.TagsPattern(), p.Name)
+}
+
+// RemoveRetentionRule implements the globalregistry.RetentionManipulatorProject
+// interface.
+func (p *project) RemoveRetentionRule(ctx context.Context, rule api.RetentionRule) error {
+	policy, err := p.getRetention(ctx)
+	if err != nil {
+		return err
+	}
+	rules := make([]*retentionRule, 0, len(policy.Rules))
+	for _, r := range policy.Rules {
+		if !r.matches(rule) {
+			rules = append(rules, r)
+		}
+	}
+	if len(rules) == len(policy.Rules) {
+		return fmt.Errorf("retention rule %s:%s not found in project %s",
+			rule.RepositoriesPattern(), rule.TagsPattern(), p.Name)
+	}
+	policy.Rules = rules
+	return p.putRetention(ctx, policy)
+}
@@ -0,0 +1,54 @@
+/*
+   Copyright 2021 The Kubermatic Kubernetes Platform contributors.
+
+   Licensed under the Apache License, Version 2.0 (the "License");
+   you may not use this file except in compliance with the License.
+   You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+   Unless required by applicable law or agreed to in writing, software
+   distributed under the License is distributed on an "AS IS" BASIS,
+   WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+   See the License for the specific language governing permissions and
+   limitations under the License.
+*/
+
+package harbor
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	api "github.com/kubermatic-labs/registryman/pkg/apis/registryman/v1alpha1"
+)
+
+var _ = Describe("Retention", func() {
+	It("converts the rules to Harbor retention rules and back", func() {
+		rule := api.RetentionRule{
+			Tags:   "v*",
+			Retain: "PushedWithinDays",
+			Count:  30,
+		}
+		harborRule, err := newRetentionRule(rule)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(harborRule.Template).To(Equal("nDaysSinceLastPush"))
+		Expect(harborRule.Params).To(Equal(map[string]int{"nDaysSinceLastPush": 30}))
+		Expect(harborRule.ScopeSelectors["repository"][0].Pattern).To(Equal("**"))
+		Expect(harborRule.toRetentionRule()).To(Equal(api.RetentionRule{
+			Repositories: "**",
+			Tags:         "v*",
+			Retain:       "PushedWithinDays",
+			Count:        30,
+		}))
+		Expect(harborRule.matches(rule)).To(BeTrue())
+		Expect(harborRule.matches(api.RetentionRule{Retain: "PushedWithinDays", Count: 30})).To(BeFalse())
+	})
+
+	It("rejects unknown rule types", func() {
+		_, err := newRetentionRule(api.RetentionRule{
+			Retain: "Always",
+		})
+		Expect(err).To(HaveOccurred())
+	})
+})
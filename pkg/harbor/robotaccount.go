@@ -0,0 +1,317 @@
+/*
+   Copyright 2021 The Kubermatic Kubernetes Platform contributors.
+
+   Licensed under the Apache License, Version 2.0 (the "License");
+   you may not use this file except in compliance with the License.
+   You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+   Unless required by applicable law or agreed to in writing, software
+   distributed under the License is distributed on an "AS IS" BASIS,
+   WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+   See the License for the specific language governing permissions and
+   limitations under the License.
+*/
+
+package harbor
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+
+	api "github.com/kubermatic-labs/registryman/pkg/apis/registryman/v1alpha1"
+	"github.com/kubermatic-labs/registryman/pkg/globalregistry"
+)
+
+const (
+	robotPath = "/api/v2.0/robots"
+
+	// systemRobotPrefix is prepended by Harbor to the names of the
+	// system robots.
+	systemRobotPrefix = "robot$"
+
+	// ownedRobotMarker is appended to the description of the system
+	// robots created by registryman. The system robots without the marker
+	// are not managed by registryman.
+	ownedRobotMarker = "[managed by registryman]"
+)
+
+// ownedRobotDescription returns the description of a system robot created by
+// registryman.
+func ownedRobotDescription(description string) string {
+	if description == "" {
+		return ownedRobotMarker
+	}
+	return description + " " + ownedRobotMarker
+}
+
+// parseOwnedRobotDescription returns the description of a system robot without
+// the ownership marker and whether the robot is owned by registryman.
+func parseOwnedRobotDescription(description string) (string, bool) {
+	if description == ownedRobotMarker {
+		return "", true
+	}
+	if strings.HasSuffix(description, " "+ownedRobotMarker) {
+		return strings.TrimSuffix(description, " "+ownedRobotMarker), true
+	}
+	return description, false
+}
+
+// robotPermissionAccesses maps the robot account permissions to the Harbor
+// accesses.
+var robotPermissionAccesses = []struct {
+	permission api.RobotPermission
+	access     access
+}{
+	{api.PullRobotPermission, access{Resource: "repository", Action: "pull"}},
+	{api.PushRobotPermission, access{Resource: "repository", Action: "push"}},
+	{api.DeleteArtifactRobotPermission, access{Resource: "artifact", Action: "delete"}},
+	{api.ReadHelmChartRobotPermission, access{Resource: "helm-chart", Action: "read"}},
+	{api.PushHelmChartRobotPermission, access{Resource: "helm-chart-version", Action: "create"}},
+}
+
+func robotPermissionsToAccess(permissions []api.RobotPermission) []access {
+	accesses := []access{}
+	for _, permission := range permissions {
+		for _, pa := range robotPermissionAccesses {
+			if pa.permission == permission {
+				accesses = append(accesses, pa.access)
+			}
+		}
+	}
+	return accesses
+}
+
+// accessToRobotPermissions converts the Harbor accesses to robot account
+// permissions. The accesses which cannot be configured via the API are
+// ignored.
+func accessToRobotPermissions(accesses []access) []api.RobotPermission {
+	permissions := []api.RobotPermission{}
+	for _, pa := range robotPermissionAccesses {
+		for _, a := range accesses {
+			if a.Resource == pa.access.Resource && a.Action == pa.access.Action {
+				permissions = append(permissions, pa.permission)
+				break
+			}
+		}
+	}
+	return permissions
+}
+
+func robotAccountProjectsToPermissions(projects []api.RobotAccountProject) []robotPermission {
+	permissions := make([]robotPermission, len(projects))
+	for i, project := range projects {
+		permissions[i] = robotPermission{
+			Kind:      "project",
+			Namespace: project.Name,
+			Access:    robotPermissionsToAccess(project.Permissions),
+		}
+	}
+	return permissions
+}
+
+var _ globalregistry.RobotAccount = &robot{}
+
+// GetStatus returns the state of a system robot. Harbor reports -1 as the
+// expiration of the robots which never expire.
+func (r *robot) GetStatus() api.RobotAccountStatus {
+	status := api.RobotAccountStatus{
+		Name:        r.Name,
+		Description: r.Description,
+		Projects:    []api.RobotAccountProject{},
+		Duration:    r.Duration,
+		ExpiresAt:   r.GetExpiresAt(),
+	}
+	for _, permission := range r.Permissions {
+		if permission.Kind != "project" {
+			continue
+		}
+		status.Projects = append(status.Projects, api.RobotAccountProject{
+			Name:        permission.Namespace,
+			Permissions: accessToRobotPermissions(permission.Access),
+		})
+	}
+	return status
+}
+
+func (r *registry) listSystemRobots(ctx context.Context) ([]*robot, error) {
+	url := *r.parsedUrl
+	url.Path = robotPath
+	query := url.Query()
+	query.Set("q", "Level=system")
+	query.Set("page_size", "100")
+	url.RawQuery = query.Encode()
+	r.logger.V(1).Info("creating new request", "url", url.String())
+	req, err := http.NewRequest(http.MethodGet, url.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	req.SetBasicAuth(r.GetUsername(), r.GetPassword())
+
+	resp, err := r.do(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	defer resp.Body.Close()
+
+	robots := []*robot{}
+	err = json.NewDecoder(resp.Body).Decode(&robots)
+	if err != nil {
+		r.logger.Error(err, "json decoding failed")
+		return nil, err
+	}
+	return robots, nil
+}
+
+// listOwnedSystemRobots returns the system robots created by registryman. The
+// robot prefix of their names and the ownership marker of their descriptions
+// are removed.
+func (r *registry) listOwnedSystemRobots(ctx context.Context) ([]*robot, error) {
+	robots, err := r.listSystemRobots(ctx)
+	if err != nil {
+		return nil, err
+	}
+	ownedRobots := make([]*robot, 0, len(robots))
+	for _, rb := range robots {
+		description, owned := parseOwnedRobotDescription(rb.Description)
+		if !owned {
+			continue
+		}
+		rb.Name = strings.TrimPrefix(rb.Name, systemRobotPrefix)
+		rb.Description = description
+		ownedRobots = append(ownedRobots, rb)
+	}
+	return ownedRobots, nil
+}
+
+// getOwnedSystemRobot returns the system robot created by registryman with the
+// given name (without the robot prefix). nil is returned if the robot is not
+// found.
+func (r *registry) getOwnedSystemRobot(ctx context.Context, name string) (*robot, error) {
+	robots, err := r.listOwnedSystemRobots(ctx)
+	if err != nil {
+		return nil, err
+	}
+	for _, rb := range robots {
+		if rb.Name == name {
+			return rb, nil
+		}
+	}
+	return nil, nil
+}
+
+func (r *registry) updateRobot(ctx context.Context, rb *robot) error {
+	url := *r.parsedUrl
+	url.Path = fmt.Sprintf("%s/%d", robotPath, rb.Id)
+	reqBodyBuf := bytes.NewBuffer(nil)
+	err := json.NewEncoder(reqBodyBuf).Encode(rb)
+	if err != nil {
+		return err
+	}
+	r.logger.V(1).Info("creating new request", "url", url.String())
+	req, err := http.NewRequest(http.MethodPut, url.String(), reqBodyBuf)
+	if err != nil {
+		return err
+	}
+
+	req.Header["Content-Type"] = []string{"application/json"}
+	req.SetBasicAuth(r.GetUsername(), r.GetPassword())
+
+	resp, err := r.do(ctx, req)
+	if err != nil {
+		return err
+	}
+
+	defer resp.Body.Close()
+	return nil
+}
+
+func (r *registry) deleteRobot(ctx context.Context, id int) error {
+	url := *r.parsedUrl
+	url.Path = fmt.Sprintf("%s/%d", robotPath, id)
+	r.logger.V(1).Info("creating new request", "url", url.String())
+	req, err := http.NewRequest(http.MethodDelete, url.String(), nil)
+	if err != nil {
+		return err
+	}
+
+	req.SetBasicAuth(r.GetUsername(), r.GetPassword())
+
+	resp, err := r.do(ctx, req)
+	if err != nil {
+		return err
+	}
+
+	defer resp.Body.Close()
+	return nil
+}
+
+var _ globalregistry.RegistryWithRobotAccounts = &registry{}
+var _ globalregistry.RobotAccountManipulatorRegistry = &registry{}
+
+// ListRobotAccounts returns the system robots of the registry which were
+// created by registryman. The other system robots are never touched.
+func (r *registry) ListRobotAccounts(ctx context.Context) ([]globalregistry.RobotAccount, error) {
+	robots, err := r.listOwnedSystemRobots(ctx)
+	if err != nil {
+		return nil, err
+	}
+	robotAccounts := make([]globalregistry.RobotAccount, len(robots))
+	for i, rb := range robots {
+		robotAccounts[i] = rb
+	}
+	return robotAccounts, nil
+}
+
+// CreateRobotAccount creates a new system robot and returns its credentials.
+func (r *registry) CreateRobotAccount(ctx context.Context, status api.RobotAccountStatus) (*globalregistry.ProjectMemberCredentials, error) {
+	created, err := r.createRobot(ctx, &robot{
+		Name:        status.Name,
+		Description: ownedRobotDescription(status.Description),
+		Level:       "system",
+		Duration:    status.Duration,
+		Permissions: robotAccountProjectsToPermissions(status.Projects),
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &globalregistry.ProjectMemberCredentials{
+		Username: created.Name,
+		Password: created.Secret,
+	}, nil
+}
+
+// UpdateRobotAccount updates the description and the permissions of a system
+// robot.
+func (r *registry) UpdateRobotAccount(ctx context.Context, status api.RobotAccountStatus) error {
+	rb, err := r.getOwnedSystemRobot(ctx, status.Name)
+	if err != nil {
+		return err
+	}
+	if rb == nil {
+		return fmt.Errorf("robot account %s not found", status.Name)
+	}
+	rb.Name = systemRobotPrefix + rb.Name
+	rb.Description = ownedRobotDescription(status.Description)
+	rb.Permissions = robotAccountProjectsToPermissions(status.Projects)
+	return r.updateRobot(ctx, rb)
+}
+
+// DeleteRobotAccount removes a system robot.
+func (r *registry) DeleteRobotAccount(ctx context.Context, name string) error {
+	rb, err := r.getOwnedSystemRobot(ctx, name)
+	if err != nil {
+		return err
+	}
+	if rb == nil {
+		return fmt.Errorf("robot account %s not found", name)
+	}
+	return r.deleteRobot(ctx, rb.Id)
+}
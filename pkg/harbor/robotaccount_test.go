@@ -0,0 +1,130 @@
+/*
+   Copyright 2021 The Kubermatic Kubernetes Platform contributors.
+
+   Licensed under the Apache License, Version 2.0 (the "License");
+   you may not use this file except in compliance with the License.
+   You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+   Unless required by applicable law or agreed to in writing, software
+   distributed under the License is distributed on an "AS IS" BASIS,
+   WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+   See the License for the specific language governing permissions and
+   limitations under the License.
+*/
+
+package harbor
+
+import (
+	"time"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	api "github.com/kubermatic-labs/registryman/pkg/apis/registryman/v1alpha1"
+)
+
+var _ = Describe("Robot accounts", func() {
+	It("converts the robot account projects to Harbor permissions", func() {
+		permissions := robotAccountProjectsToPermissions([]api.RobotAccountProject{
+			{
+				Name:        "app",
+				Permissions: []api.RobotPermission{api.PullRobotPermission, api.PushHelmChartRobotPermission},
+			},
+		})
+		Expect(permissions).To(Equal([]robotPermission{
+			{
+				Kind:      "project",
+				Namespace: "app",
+				Access: []access{
+					{Resource: "repository", Action: "pull"},
+					{Resource: "helm-chart-version", Action: "create"},
+				},
+			},
+		}))
+	})
+
+	It("converts the system robots to robot account statuses", func() {
+		rb := &robot{
+			Name:        "ci",
+			Description: "CI robot",
+			Level:       "system",
+			Duration:    30,
+			ExpiresAt:   1640995200,
+			Permissions: []robotPermission{
+				{
+					Kind:      "project",
+					Namespace: "app",
+					Access: []access{
+						{Resource: "repository", Action: "push"},
+						{Resource: "repository", Action: "pull"},
+						{Resource: "tag", Action: "list"},
+					},
+				},
+			},
+		}
+		status := rb.GetStatus()
+		Expect(status.Name).To(Equal("ci"))
+		Expect(status.Description).To(Equal("CI robot"))
+		Expect(status.Duration).To(Equal(30))
+		Expect(status.ExpiresAt).ToNot(BeNil())
+		Expect(status.ExpiresAt.Time.Equal(time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC))).To(BeTrue())
+		Expect(status.Projects).To(Equal([]api.RobotAccountProject{
+			{
+				Name:        "app",
+				Permissions: []api.RobotPermission{api.PullRobotPermission, api.PushRobotPermission},
+			},
+		}))
+	})
+
+	It("marks the system robots created by registryman", func() {
+		Expect(ownedRobotDescription("")).To(Equal("[managed by registryman]"))
+		Expect(ownedRobotDescription("CI robot")).To(Equal("CI robot [managed by registryman]"))
+		description, owned := parseOwnedRobotDescription("CI robot [managed by registryman]")
+		Expect(owned).To(BeTrue())
+		Expect(description).To(Equal("CI robot"))
+		description, owned = parseOwnedRobotDescription("[managed by registryman]")
+		Expect(owned).To(BeTrue())
+		Expect(description).To(BeEmpty())
+		description, owned = parseOwnedRobotDescription("robot of the admins")
+		Expect(owned).To(BeFalse())
+		Expect(description).To(Equal("robot of the admins"))
+	})
+
+	It("does not set the expiration of the robots which never expire", func() {
+		rb := &robot{
+			Name:      "ci",
+			Duration:  -1,
+			ExpiresAt: -1,
+		}
+		Expect(rb.GetStatus().ExpiresAt).To(BeNil())
+	})
+
+	It("derives the lifetime of robot members from their rotation policy", func() {
+		Expect(robotMemberDuration(&testRobotMember{})).To(Equal(0))
+		Expect(robotMemberDuration(&testRobotMember{
+			rotation: &api.CredentialRotation{ExpiresIn: 30},
+		})).To(Equal(30))
+		Expect(robotMemberDuration(&testRobotMember{
+			rotation: &api.CredentialRotation{RotateEvery: 10},
+		})).To(Equal(-1))
+	})
+
+	It("reports the lifetime of the robots", func() {
+		Expect((&robot{}).GetRotationPolicy()).To(BeNil())
+		Expect((&robot{Duration: 30}).GetRotationPolicy()).To(Equal(&api.CredentialRotation{ExpiresIn: 30}))
+		Expect((&robot{Duration: -1}).GetRotationPolicy()).To(Equal(&api.CredentialRotation{}))
+	})
+})
+
+type testRobotMember struct {
+	rotation *api.CredentialRotation
+}
+
+func (m *testRobotMember) GetName() string { return "robot" }
+func (m *testRobotMember) GetType() string { return "Robot" }
+func (m *testRobotMember) GetRole() string { return "Developer" }
+func (m *testRobotMember) GetRotationPolicy() *api.CredentialRotation {
+	return m.rotation
+}
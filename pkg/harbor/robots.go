@@ -22,9 +22,12 @@ import (
 	"encoding/json"
 	"fmt"
 	"net/http"
+	"strings"
 	"time"
 
+	api "github.com/kubermatic-labs/registryman/pkg/apis/registryman/v1alpha1"
 	"github.com/kubermatic-labs/registryman/pkg/globalregistry"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
 // RobotV2
@@ -41,9 +44,14 @@ type robot struct {
 	Duration     int               `json:"duration,omitempty"`
 	Id           int               `json:"id,omitempty"`
 	Permissions  []robotPermission `json:"permissions,omitempty"`
+
+	// registryman role of the robot members
+	role string
 }
 
 var _ globalregistry.ProjectMember = &robot{}
+var _ globalregistry.MemberWithCredentialStatus = &robot{}
+var _ globalregistry.MemberWithRotationPolicy = &robot{}
 
 func (r *robot) GetName() string {
 	return r.Name
@@ -54,31 +62,67 @@ func (r *robot) GetType() string {
 }
 
 func (r *robot) GetRole() string {
-	canPull := false
-	canPush := false
+	return r.role
+}
+
+// repositoryActions returns the provider specific role of a robot member, i.e.
+// the comma separated list of its actions on the repositories.
+func (r *robot) repositoryActions() string {
+	actions := []string{}
 	for _, permission := range r.Permissions {
 		// TODO: robot accounts covering multiple projects are not
 		// supported yet
 		for _, access := range permission.Access {
-			if access.Action == "push" &&
-				access.Resource == "repository" {
-				canPush = true
-			}
-			if access.Action == "pull" &&
-				access.Resource == "repository" {
-				canPull = true
+			if access.Resource == "repository" {
+				actions = append(actions, access.Action)
 			}
 		}
 	}
+	return strings.Join(actions, ",")
+}
+
+// GetExpiresAt returns when the robot expires. Harbor reports -1 for the robots
+// which never expire.
+func (r *robot) GetExpiresAt() *metav1.Time {
+	if r.ExpiresAt <= 0 {
+		return nil
+	}
+	expiresAt := metav1.NewTime(time.Unix(int64(r.ExpiresAt), 0))
+	return &expiresAt
+}
+
+// GetRotationPolicy returns the lifetime of the robot as a rotation policy, so
+// that the robot is renewed when the expected lifetime changes. The other
+// fields of the policy are not known by Harbor. nil is returned if the lifetime
+// is not reported.
+func (r *robot) GetRotationPolicy() *api.CredentialRotation {
+	switch {
+	case r.Duration > 0:
+		return &api.CredentialRotation{ExpiresIn: r.Duration}
+	case r.Duration < 0:
+		// the robot never expires
+		return &api.CredentialRotation{}
+	default:
+		return nil
+	}
+}
+
+// robotMemberDuration returns the lifetime of a new robot member in days. 0
+// means the default lifetime configured in Harbor, -1 means that the robot
+// never expires.
+func robotMemberDuration(member globalregistry.ProjectMember) int {
+	memberWithRotation, ok := member.(globalregistry.MemberWithRotationPolicy)
+	if !ok {
+		return 0
+	}
+	policy := memberWithRotation.GetRotationPolicy()
 	switch {
-	case canPull && canPush:
-		return "PullAndPush"
-	case canPull && !canPush:
-		return "PullOnly"
-	case !canPull && canPush:
-		return "PushOnly"
+	case policy == nil:
+		return 0
+	case policy.ExpiresIn > 0:
+		return policy.ExpiresIn
 	default:
-		panic("robot role not supported")
+		return -1
 	}
 }
 
@@ -139,7 +183,7 @@ func (r *registry) getRobotMembers(ctx context.Context, projectID int) ([]*robot
 	return robotMembersResult, err
 }
 
-func (r *registry) createProjectRobotMember(ctx context.Context, robotMember *robot) (*robotCreated, error) {
+func (r *registry) createRobot(ctx context.Context, robotMember *robot) (*robotCreated, error) {
 	url := *r.parsedUrl
 	url.Path = "/api/v2.0/robots"
 	reqBodyBuf := bytes.NewBuffer(nil)
@@ -178,6 +222,46 @@ func (r *registry) createProjectRobotMember(ctx context.Context, robotMember *ro
 	return robotResult, err
 }
 
+// robotSecret is the request and response body of the Harbor refresh secret
+// endpoint. If the secret of the request is empty, Harbor generates a random
+// one.
+type robotSecret struct {
+	Secret string `json:"secret"`
+}
+
+func (r *registry) refreshRobotSecret(ctx context.Context, robotID int) (string, error) {
+	url := *r.parsedUrl
+	url.Path = fmt.Sprintf("/api/v2.0/robots/%d", robotID)
+	reqBodyBuf := bytes.NewBuffer(nil)
+	err := json.NewEncoder(reqBodyBuf).Encode(&robotSecret{})
+	if err != nil {
+		return "", err
+	}
+	r.logger.V(1).Info("creating new request", "url", url.String())
+	req, err := http.NewRequest(http.MethodPatch, url.String(), reqBodyBuf)
+	if err != nil {
+		return "", err
+	}
+
+	req.Header["Content-Type"] = []string{"application/json"}
+	req.SetBasicAuth(r.GetUsername(), r.GetPassword())
+
+	resp, err := r.do(ctx, req)
+	if err != nil {
+		return "", err
+	}
+
+	defer resp.Body.Close()
+
+	result := &robotSecret{}
+	err = json.NewDecoder(resp.Body).Decode(result)
+	if err != nil {
+		r.logger.Error(err, "json decoding failed")
+		return "", err
+	}
+	return result.Secret, nil
+}
+
 func (r *registry) deleteProjectRobotMember(ctx context.Context, projectID int, robotMemberID int) error {
 	url := *r.parsedUrl
 	url.Path = fmt.Sprintf("%s/%d/robots/%d", path, projectID, robotMemberID)
@@ -19,8 +19,40 @@ package harbor
 import (
 	"encoding/json"
 	"fmt"
+
+	"github.com/kubermatic-labs/registryman/pkg/globalregistry"
 )
 
+// harborRoles is the role table of Harbor. The roles of the users and groups
+// are Harbor project roles, the roles of the robots are comma separated lists
+// of the actions that the robot can perform on the repositories of the
+// project.
+var harborRoles = globalregistry.RoleTable{
+	userType: {
+		"Guest":        guestRole.String(),
+		"Developer":    developerRole.String(),
+		"Maintainer":   maintainerRole.String(),
+		"ProjectAdmin": projectAdminRole.String(),
+	},
+	groupType: {
+		"Guest":        guestRole.String(),
+		"Developer":    developerRole.String(),
+		"Maintainer":   maintainerRole.String(),
+		"ProjectAdmin": projectAdminRole.String(),
+	},
+	robotType: {
+		"PullOnly":    "pull",
+		"PushOnly":    "push",
+		"PullAndPush": "pull,push",
+	},
+}
+
+// roleTable returns the role table of the registry, i.e. the Harbor role table
+// with the role mapping of the registry applied.
+func (r *registry) roleTable() (globalregistry.RoleTable, error) {
+	return globalregistry.GetRoleTable(r.Registry)
+}
+
 type role int
 
 const (
@@ -19,6 +19,7 @@ package harbor
 import (
 	"bytes"
 	"context"
+	"encoding/base64"
 	"encoding/json"
 	"fmt"
 	"net/http"
@@ -63,16 +64,46 @@ type projectScanner struct {
 
 var _ globalregistry.Scanner = &scannerRegistrationRequest{}
 
+// newScannerRegistrationRequest returns the registration request of the
+// scanner. The access credential of the scanner is converted to the
+// authentication type and credential of Harbor.
+func newScannerRegistrationRequest(s globalregistry.Scanner) *scannerRegistrationRequest {
+	request := &scannerRegistrationRequest{
+		Name: s.GetName(),
+		Url:  s.GetURL(),
+	}
+	if scannerWithCredential, ok := s.(globalregistry.ScannerWithAccessCredential); ok {
+		request.Auth, request.AccessCredential = scannerAuth(scannerWithCredential.GetAccessCredential())
+	}
+	return request
+}
+
+// scannerAuth converts the value of an HTTP Authorization header to the
+// authentication type and credential of a Harbor scanner registration. Harbor
+// sends the Bearer credential as it is and the Basic credential base64 encoded.
+// A value without Bearer or Basic scheme is sent as an API key.
+func scannerAuth(authHeader string) (string, string) {
+	switch {
+	case authHeader == "":
+		return "", ""
+	case strings.HasPrefix(authHeader, "Bearer "):
+		return "Bearer", strings.TrimPrefix(authHeader, "Bearer ")
+	case strings.HasPrefix(authHeader, "Basic "):
+		credential, err := base64.StdEncoding.DecodeString(strings.TrimPrefix(authHeader, "Basic "))
+		if err == nil {
+			return "Basic", string(credential)
+		}
+	}
+	return "X-ScannerAdapter-API-Key", authHeader
+}
+
 func (r *registry) createScanner(ctx context.Context, config globalregistry.Scanner) (string, error) {
 	r.logger.V(1).Info("createScanner invoked")
 	url := *r.parsedUrl
 	url.Path = scannersPath
 
 	reqBodyBuf := bytes.NewBuffer(nil)
-	err := json.NewEncoder(reqBodyBuf).Encode(&scannerRegistrationRequest{
-		Name: config.GetName(),
-		Url:  config.GetURL(),
-	})
+	err := json.NewEncoder(reqBodyBuf).Encode(newScannerRegistrationRequest(config))
 	if err != nil {
 		return "", err
 	}
@@ -142,13 +173,7 @@ func (r *registry) getScannerIDByNameOrCreate(ctx context.Context, targetScanner
 
 	r.logger.V(1).Info("creating global scanner", "name", targetScanner.GetName())
 
-	newScannerConfig := &scannerRegistrationRequest{
-		Name:     targetScanner.GetName(),
-		Url:      targetScanner.GetURL(),
-		Disabled: false,
-	}
-
-	return r.createScanner(ctx, newScannerConfig)
+	return r.createScanner(ctx, targetScanner)
 }
 
 func (r *registry) listScanners(ctx context.Context) ([]globalregistry.Scanner, error) {
@@ -276,10 +301,7 @@ func (r *registry) updateScanner(ctx context.Context, id string, targetScanner g
 	url.Path = fmt.Sprintf("%s/%s", scannersPath, id)
 
 	reqBodyBuf := bytes.NewBuffer(nil)
-	err := json.NewEncoder(reqBodyBuf).Encode(&scannerRegistrationRequest{
-		Name: targetScanner.GetName(),
-		Url:  targetScanner.GetURL(),
-	})
+	err := json.NewEncoder(reqBodyBuf).Encode(newScannerRegistrationRequest(targetScanner))
 	if err != nil {
 		return err
 	}
@@ -0,0 +1,45 @@
+/*
+   Copyright 2021 The Kubermatic Kubernetes Platform contributors.
+
+   Licensed under the Apache License, Version 2.0 (the "License");
+   you may not use this file except in compliance with the License.
+   You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+   Unless required by applicable law or agreed to in writing, software
+   distributed under the License is distributed on an "AS IS" BASIS,
+   WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+   See the License for the specific language governing permissions and
+   limitations under the License.
+*/
+
+package harbor
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Scanner registration", func() {
+	It("sends the Bearer credential as it is", func() {
+		auth, credential := scannerAuth("Bearer token")
+		Expect(auth).To(Equal("Bearer"))
+		Expect(credential).To(Equal("token"))
+	})
+	It("decodes the Basic credential", func() {
+		auth, credential := scannerAuth("Basic dXNlcjpwYXNzd29yZA==")
+		Expect(auth).To(Equal("Basic"))
+		Expect(credential).To(Equal("user:password"))
+	})
+	It("sends any other value as an API key", func() {
+		auth, credential := scannerAuth("api-key")
+		Expect(auth).To(Equal("X-ScannerAdapter-API-Key"))
+		Expect(credential).To(Equal("api-key"))
+	})
+	It("sends no credential when it is not configured", func() {
+		auth, credential := scannerAuth("")
+		Expect(auth).To(BeEmpty())
+		Expect(credential).To(BeEmpty())
+	})
+})
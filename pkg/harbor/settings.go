@@ -0,0 +1,123 @@
+/*
+   Copyright 2021 The Kubermatic Kubernetes Platform contributors.
+
+   Licensed under the Apache License, Version 2.0 (the "License");
+   you may not use this file except in compliance with the License.
+   You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+   Unless required by applicable law or agreed to in writing, software
+   distributed under the License is distributed on an "AS IS" BASIS,
+   WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+   See the License for the specific language governing permissions and
+   limitations under the License.
+*/
+
+package harbor
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+
+	api "github.com/kubermatic-labs/registryman/pkg/apis/registryman/v1alpha1"
+)
+
+// settingsMetadata contains the project metadata fields that are managed as
+// project settings. Harbor stores the boolean values as strings.
+type settingsMetadata struct {
+	Public             string `json:"public"`
+	AutoScan           string `json:"auto_scan"`
+	EnableContentTrust string `json:"enable_content_trust"`
+	PreventVul         string `json:"prevent_vul"`
+	Severity           string `json:"severity,omitempty"`
+}
+
+type projectUpdateReqBody struct {
+	Metadata settingsMetadata `json:"metadata"`
+}
+
+func parseMetadataBool(s string) bool {
+	b, err := strconv.ParseBool(s)
+	return err == nil && b
+}
+
+// GetSettings implements the globalregistry.ProjectWithSettings interface.
+func (p *project) GetSettings(ctx context.Context) (*api.ProjectSettings, error) {
+	p.registry.logger.V(1).Info("getting settings of a project",
+		"projectName", p.Name,
+	)
+	url := *p.registry.parsedUrl
+	url.Path = fmt.Sprintf("%s/%d", path, p.id)
+	req, err := http.NewRequest(http.MethodGet, url.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+	req.SetBasicAuth(p.registry.GetUsername(), p.registry.GetPassword())
+
+	resp, err := p.registry.do(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	defer resp.Body.Close()
+
+	projectData := &projectStatus{}
+	err = json.NewDecoder(resp.Body).Decode(projectData)
+	if err != nil {
+		p.registry.logger.Error(err, "json decoding failed")
+		return nil, err
+	}
+	settings := &api.ProjectSettings{
+		Public:                  parseMetadataBool(projectData.Metadata.Public),
+		AutoScan:                parseMetadataBool(projectData.Metadata.AutoScan),
+		EnableContentTrust:      parseMetadataBool(projectData.Metadata.EnableContentTrust),
+		PreventVulnerableImages: parseMetadataBool(projectData.Metadata.PreventVul),
+	}
+	if settings.PreventVulnerableImages {
+		settings.Severity = projectData.Metadata.Severity
+	}
+	return settings, nil
+}
+
+// UpdateSettings implements the globalregistry.SettingsManipulatorProject
+// interface.
+func (p *project) UpdateSettings(ctx context.Context, settings *api.ProjectSettings) error {
+	p.registry.logger.V(1).Info("updating settings of a project",
+		"projectName", p.Name,
+	)
+	url := *p.registry.parsedUrl
+	url.Path = fmt.Sprintf("%s/%d", path, p.id)
+	reqBodyBuf := bytes.NewBuffer(nil)
+	err := json.NewEncoder(reqBodyBuf).Encode(&projectUpdateReqBody{
+		Metadata: settingsMetadata{
+			Public:             strconv.FormatBool(settings.Public),
+			AutoScan:           strconv.FormatBool(settings.AutoScan),
+			EnableContentTrust: strconv.FormatBool(settings.EnableContentTrust),
+			PreventVul:         strconv.FormatBool(settings.PreventVulnerableImages),
+			Severity:           settings.Severity,
+		},
+	})
+	if err != nil {
+		return err
+	}
+	req, err := http.NewRequest(http.MethodPut, url.String(), reqBodyBuf)
+	if err != nil {
+		return err
+	}
+	req.Header["Content-Type"] = []string{"application/json"}
+	req.SetBasicAuth(p.registry.GetUsername(), p.registry.GetPassword())
+
+	resp, err := p.registry.do(ctx, req)
+	if err != nil {
+		return err
+	}
+
+	defer resp.Body.Close()
+
+	return nil
+}
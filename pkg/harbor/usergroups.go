@@ -0,0 +1,102 @@
+/*
+   Copyright 2021 The Kubermatic Kubernetes Platform contributors.
+
+   Licensed under the Apache License, Version 2.0 (the "License");
+   you may not use this file except in compliance with the License.
+   You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+   Unless required by applicable law or agreed to in writing, software
+   distributed under the License is distributed on an "AS IS" BASIS,
+   WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+   See the License for the specific language governing permissions and
+   limitations under the License.
+*/
+
+package harbor
+
+import (
+	"context"
+	"fmt"
+
+	api "github.com/kubermatic-labs/registryman/pkg/apis/registryman/v1alpha1"
+	"github.com/kubermatic-labs/registryman/pkg/globalregistry"
+)
+
+var _ globalregistry.RegistryWithUserGroups = &registry{}
+var _ globalregistry.UserGroupManipulatorRegistry = &registry{}
+var _ globalregistry.LdapGroupResolverRegistry = &registry{}
+
+// userGroupOfMember returns the user group which represents the group member.
+// The DN of the LDAP groups is resolved, so that the groups which are not
+// found in LDAP are reported as recoverable errors.
+func (r *registry) userGroupOfMember(ctx context.Context, member globalregistry.ProjectMember) (*userGroup, error) {
+	groupTypeName := string(api.LdapGroupType)
+	if gm, ok := member.(globalregistry.GroupMember); ok {
+		groupTypeName = gm.GetGroupType()
+	}
+	groupType, err := groupTypeFromString(groupTypeName)
+	if err != nil {
+		return nil, err
+	}
+	ug := &userGroup{
+		GroupName: member.GetName(),
+		GroupType: groupType,
+	}
+	if groupType != ldapGroupType {
+		return ug, nil
+	}
+	ldapMember, ok := member.(globalregistry.LdapMember)
+	if !ok || ldapMember.GetDN() == "" {
+		return nil, fmt.Errorf("LDAP group has no DN")
+	}
+	ug.LdapGroupDn = ldapMember.GetDN()
+	ldapGroupName, err := r.searchLdapGroup(ctx, ug.LdapGroupDn)
+	if err != nil {
+		return nil, err
+	}
+	if ldapGroupName == "" {
+		return nil, fmt.Errorf("LDAP group %s cannot be resolved, %w",
+			ug.LdapGroupDn, globalregistry.ErrRecoverableError)
+	}
+	return ug, nil
+}
+
+// ResolveLdapGroup searches the LDAP group with the given DN. The group is not
+// found if no LDAP is configured in Harbor.
+func (r *registry) ResolveLdapGroup(ctx context.Context, dn string) (bool, error) {
+	ldapGroupName, err := r.searchLdapGroup(ctx, dn)
+	if err != nil {
+		return false, err
+	}
+	return ldapGroupName != "", nil
+}
+
+// ListUserGroups returns the user groups of the registry.
+func (r *registry) ListUserGroups(ctx context.Context) ([]globalregistry.UserGroup, error) {
+	userGroups, err := r.getUserGroups(ctx)
+	if err != nil {
+		return nil, err
+	}
+	result := make([]globalregistry.UserGroup, len(userGroups))
+	for i, ug := range userGroups {
+		result[i] = ug
+	}
+	return result, nil
+}
+
+// DeleteUserGroup removes the user group with the name and type of the given
+// user group status.
+func (r *registry) DeleteUserGroup(ctx context.Context, status api.UserGroupStatus) error {
+	userGroups, err := r.getUserGroups(ctx)
+	if err != nil {
+		return err
+	}
+	for _, ug := range userGroups {
+		if ug.GroupName == status.Name && ug.GetGroupType() == status.GroupType {
+			return r.deleteUserGroup(ctx, ug)
+		}
+	}
+	return fmt.Errorf("user group %s not found", status.Name)
+}
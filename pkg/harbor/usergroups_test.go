@@ -0,0 +1,62 @@
+/*
+   Copyright 2021 The Kubermatic Kubernetes Platform contributors.
+
+   Licensed under the Apache License, Version 2.0 (the "License");
+   you may not use this file except in compliance with the License.
+   You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+   Unless required by applicable law or agreed to in writing, software
+   distributed under the License is distributed on an "AS IS" BASIS,
+   WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+   See the License for the specific language governing permissions and
+   limitations under the License.
+*/
+
+package harbor
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"github.com/kubermatic-labs/registryman/pkg/globalregistry"
+)
+
+var _ = Describe("User groups", func() {
+	It("converts the group types", func() {
+		Expect(groupTypeToString(ldapGroupType)).To(Equal("LDAP"))
+		Expect(groupTypeToString(oidcGroupType)).To(Equal("OIDC"))
+		Expect(groupTypeToString(httpGroupType)).To(Equal("HTTP"))
+
+		groupType, err := groupTypeFromString("OIDC")
+		Expect(err).ToNot(HaveOccurred())
+		Expect(groupType).To(Equal(oidcGroupType))
+		_, err = groupTypeFromString("HTTP")
+		Expect(err).To(HaveOccurred())
+	})
+
+	It("converts the group members according to their group type", func() {
+		ldapGroup := (&projectMemberEntity{
+			EntityName: "developers",
+			EntityType: "g",
+			RoleId:     developerRole,
+			dn:         "cn=developers,ou=groups,dc=example,dc=com",
+			groupType:  ldapGroupType,
+		}).toProjectMember()
+		Expect(ldapGroup).To(BeAssignableToTypeOf(&ldapMember{}))
+		Expect(ldapGroup.(globalregistry.LdapMember).GetDN()).To(Equal("cn=developers,ou=groups,dc=example,dc=com"))
+		Expect(ldapGroup.(globalregistry.GroupMember).GetGroupType()).To(Equal("LDAP"))
+
+		oidcGroup := (&projectMemberEntity{
+			EntityName: "testers",
+			EntityType: "g",
+			RoleId:     guestRole,
+			groupType:  oidcGroupType,
+		}).toProjectMember()
+		_, isLdap := oidcGroup.(globalregistry.LdapMember)
+		Expect(isLdap).To(BeFalse())
+		Expect(oidcGroup.GetType()).To(Equal("Group"))
+		Expect(oidcGroup.(globalregistry.GroupMember).GetGroupType()).To(Equal("OIDC"))
+	})
+})
@@ -0,0 +1,259 @@
+/*
+   Copyright 2021 The Kubermatic Kubernetes Platform contributors.
+
+   Licensed under the Apache License, Version 2.0 (the "License");
+   you may not use this file except in compliance with the License.
+   You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+   Unless required by applicable law or agreed to in writing, software
+   distributed under the License is distributed on an "AS IS" BASIS,
+   WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+   See the License for the specific language governing permissions and
+   limitations under the License.
+*/
+
+package harbor
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+
+	api "github.com/kubermatic-labs/registryman/pkg/apis/registryman/v1alpha1"
+)
+
+// webhookEventTypes maps the API webhook event types to the Harbor event
+// types.
+var webhookEventTypes = map[api.WebhookEventType]string{
+	api.PushWebhookEvent:              "PUSH_ARTIFACT",
+	api.DeleteWebhookEvent:            "DELETE_ARTIFACT",
+	api.ScanningCompletedWebhookEvent: "SCANNING_COMPLETED",
+	api.ScanningFailedWebhookEvent:    "SCANNING_FAILED",
+}
+
+type webhookTarget struct {
+	Type           string `json:"type"`
+	Address        string `json:"address"`
+	AuthHeader     string `json:"auth_header,omitempty"`
+	SkipCertVerify bool   `json:"skip_cert_verify"`
+}
+
+type webhookPolicy struct {
+	ID          int             `json:"id,omitempty"`
+	Name        string          `json:"name"`
+	Description string          `json:"description,omitempty"`
+	ProjectID   int             `json:"project_id"`
+	Targets     []webhookTarget `json:"targets"`
+	EventTypes  []string        `json:"event_types"`
+	Enabled     bool            `json:"enabled"`
+}
+
+// authHeaderDigestPrefix prefixes the digest of the auth header in the
+// description of the webhook policies. Harbor does not guarantee that the auth
+// header of a target is returned as it was written, so the digest recorded in
+// the description is used to detect the changes of the auth header.
+const authHeaderDigestPrefix = "managed by registryman, auth header "
+
+// newWebhookPolicy converts the webhook to a Harbor webhook policy with a
+// single HTTP target.
+func newWebhookPolicy(projectID int, webhook api.WebhookStatus) *webhookPolicy {
+	policy := &webhookPolicy{
+		Name:      webhook.Name,
+		ProjectID: projectID,
+		Targets: []webhookTarget{
+			{
+				Type:           "http",
+				Address:        webhook.Endpoint,
+				AuthHeader:     webhook.AuthHeader,
+				SkipCertVerify: webhook.SkipCertVerify,
+			},
+		},
+		EventTypes: make([]string, 0, len(webhook.EventTypes)),
+		Enabled:    true,
+	}
+	if webhook.AuthHeader != "" {
+		policy.Description = authHeaderDigestPrefix + api.AuthHeaderDigest(webhook.AuthHeader)
+	}
+	for _, eventType := range webhook.EventTypes {
+		policy.EventTypes = append(policy.EventTypes, webhookEventTypes[eventType])
+	}
+	return policy
+}
+
+// toWebhookStatus converts the Harbor webhook policy to api.WebhookStatus. Only
+// the first target of the policy is considered. The event types which cannot
+// be expressed by the API are ignored. The digest of the auth header is taken
+// from the description of the policy.
+func (policy *webhookPolicy) toWebhookStatus() api.WebhookStatus {
+	result := api.WebhookStatus{
+		Name:       policy.Name,
+		EventTypes: make([]api.WebhookEventType, 0, len(policy.EventTypes)),
+		Enabled:    policy.Enabled,
+	}
+	if strings.HasPrefix(policy.Description, authHeaderDigestPrefix) {
+		result.AuthHeaderDigest = strings.TrimPrefix(policy.Description, authHeaderDigestPrefix)
+	}
+	if len(policy.Targets) > 0 {
+		result.Endpoint = policy.Targets[0].Address
+		result.AuthHeader = policy.Targets[0].AuthHeader
+		result.SkipCertVerify = policy.Targets[0].SkipCertVerify
+	}
+	for _, harborEventType := range policy.EventTypes {
+		for eventType, harborName := range webhookEventTypes {
+			if harborName == harborEventType {
+				result.EventTypes = append(result.EventTypes, eventType)
+				break
+			}
+		}
+	}
+	return result
+}
+
+func (p *project) webhookPoliciesPath() string {
+	return fmt.Sprintf("%s/%d/webhook/policies", path, p.id)
+}
+
+func (p *project) getWebhookPolicies(ctx context.Context) ([]*webhookPolicy, error) {
+	url := *p.registry.parsedUrl
+	url.Path = p.webhookPoliciesPath()
+	req, err := http.NewRequest(http.MethodGet, url.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+	req.SetBasicAuth(p.registry.GetUsername(), p.registry.GetPassword())
+
+	resp, err := p.registry.do(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	defer resp.Body.Close()
+
+	policies := []*webhookPolicy{}
+	err = json.NewDecoder(resp.Body).Decode(&policies)
+	if err != nil {
+		p.registry.logger.Error(err, "json decoding failed")
+		return nil, err
+	}
+	return policies, nil
+}
+
+// getWebhookPolicyByName returns the webhook policy with the given name. An
+// error is returned if the project has no such webhook policy.
+func (p *project) getWebhookPolicyByName(ctx context.Context, name string) (*webhookPolicy, error) {
+	policies, err := p.getWebhookPolicies(ctx)
+	if err != nil {
+		return nil, err
+	}
+	for _, policy := range policies {
+		if policy.Name == name {
+			return policy, nil
+		}
+	}
+	return nil, fmt.Errorf("webhook %s not found in project %s", name, p.Name)
+}
+
+func (p *project) sendWebhookPolicy(ctx context.Context, method, urlPath string, policy *webhookPolicy) error {
+	url := *p.registry.parsedUrl
+	url.Path = urlPath
+	reqBodyBuf := bytes.NewBuffer(nil)
+	err := json.NewEncoder(reqBodyBuf).Encode(policy)
+	if err != nil {
+		return err
+	}
+	req, err := http.NewRequest(method, url.String(), reqBodyBuf)
+	if err != nil {
+		return err
+	}
+	req.Header["Content-Type"] = []string{"application/json"}
+	req.SetBasicAuth(p.registry.GetUsername(), p.registry.GetPassword())
+
+	resp, err := p.registry.do(ctx, req)
+	if err != nil {
+		return err
+	}
+
+	defer resp.Body.Close()
+
+	return nil
+}
+
+// GetWebhooks implements the globalregistry.ProjectWithWebhooks interface.
+func (p *project) GetWebhooks(ctx context.Context) ([]api.WebhookStatus, error) {
+	p.registry.logger.V(1).Info("getting webhooks of a project",
+		"projectName", p.Name,
+	)
+	policies, err := p.getWebhookPolicies(ctx)
+	if err != nil {
+		return nil, err
+	}
+	webhooks := make([]api.WebhookStatus, len(policies))
+	for i, policy := range policies {
+		webhooks[i] = policy.toWebhookStatus()
+	}
+	return webhooks, nil
+}
+
+// AddWebhook implements the globalregistry.WebhookManipulatorProject interface.
+func (p *project) AddWebhook(ctx context.Context, webhook api.WebhookStatus) error {
+	p.registry.logger.V(1).Info("adding webhook to a project",
+		"projectName", p.Name,
+		"webhookName", webhook.Name,
+		"endpoint", webhook.Endpoint,
+	)
+	return p.sendWebhookPolicy(ctx, http.MethodPost, p.webhookPoliciesPath(),
+		newWebhookPolicy(p.id, webhook))
+}
+
+// UpdateWebhook implements the globalregistry.WebhookManipulatorProject
+// interface. The updated webhook is enabled.
+func (p *project) UpdateWebhook(ctx context.Context, webhook api.WebhookStatus) error {
+	p.registry.logger.V(1).Info("updating webhook of a project",
+		"projectName", p.Name,
+		"webhookName", webhook.Name,
+		"endpoint", webhook.Endpoint,
+	)
+	policy, err := p.getWebhookPolicyByName(ctx, webhook.Name)
+	if err != nil {
+		return err
+	}
+	updatedPolicy := newWebhookPolicy(p.id, webhook)
+	updatedPolicy.ID = policy.ID
+	return p.sendWebhookPolicy(ctx, http.MethodPut,
+		fmt.Sprintf("%s/%d", p.webhookPoliciesPath(), policy.ID),
+		updatedPolicy)
+}
+
+// RemoveWebhook implements the globalregistry.WebhookManipulatorProject
+// interface.
+func (p *project) RemoveWebhook(ctx context.Context, webhook api.WebhookStatus) error {
+	p.registry.logger.V(1).Info("removing webhook from a project",
+		"projectName", p.Name,
+		"webhookName", webhook.Name,
+	)
+	policy, err := p.getWebhookPolicyByName(ctx, webhook.Name)
+	if err != nil {
+		return err
+	}
+	url := *p.registry.parsedUrl
+	url.Path = fmt.Sprintf("%s/%d", p.webhookPoliciesPath(), policy.ID)
+	req, err := http.NewRequest(http.MethodDelete, url.String(), nil)
+	if err != nil {
+		return err
+	}
+	req.SetBasicAuth(p.registry.GetUsername(), p.registry.GetPassword())
+
+	resp, err := p.registry.do(ctx, req)
+	if err != nil {
+		return err
+	}
+
+	defer resp.Body.Close()
+
+	return nil
+}
@@ -0,0 +1,82 @@
+/*
+   Copyright 2021 The Kubermatic Kubernetes Platform contributors.
+
+   Licensed under the Apache License, Version 2.0 (the "License");
+   you may not use this file except in compliance with the License.
+   You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+   Unless required by applicable law or agreed to in writing, software
+   distributed under the License is distributed on an "AS IS" BASIS,
+   WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+   See the License for the specific language governing permissions and
+   limitations under the License.
+*/
+
+package harbor
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	api "github.com/kubermatic-labs/registryman/pkg/apis/registryman/v1alpha1"
+)
+
+var _ = Describe("Webhooks", func() {
+	It("converts the webhooks to Harbor webhook policies and back", func() {
+		webhook := api.WebhookStatus{
+			Name:     "ci",
+			Endpoint: "https://ci.example.com/hook",
+			EventTypes: []api.WebhookEventType{
+				api.PushWebhookEvent,
+				api.ScanningFailedWebhookEvent,
+			},
+			SkipCertVerify:   true,
+			Enabled:          true,
+			AuthHeader:       "Bearer token",
+			AuthHeaderDigest: api.AuthHeaderDigest("Bearer token"),
+		}
+		policy := newWebhookPolicy(3, webhook)
+		Expect(policy.ProjectID).To(Equal(3))
+		Expect(policy.Enabled).To(BeTrue())
+		Expect(policy.EventTypes).To(Equal([]string{"PUSH_ARTIFACT", "SCANNING_FAILED"}))
+		Expect(policy.Targets).To(Equal([]webhookTarget{
+			{
+				Type:           "http",
+				Address:        "https://ci.example.com/hook",
+				AuthHeader:     "Bearer token",
+				SkipCertVerify: true,
+			},
+		}))
+		Expect(policy.toWebhookStatus()).To(Equal(webhook))
+	})
+
+	It("takes the auth header digest from the policy description", func() {
+		policy := newWebhookPolicy(3, api.WebhookStatus{
+			Name:       "ci",
+			Endpoint:   "https://ci.example.com/hook",
+			AuthHeader: "Bearer token",
+		})
+		// the auth header is not returned as it was written
+		policy.Targets[0].AuthHeader = ""
+		policy.Enabled = false
+		webhook := policy.toWebhookStatus()
+		Expect(webhook.AuthHeaderDigest).To(Equal(api.AuthHeaderDigest("Bearer token")))
+		Expect(webhook.Enabled).To(BeFalse())
+
+		policy.Description = "created by hand"
+		Expect(policy.toWebhookStatus().AuthHeaderDigest).To(BeEmpty())
+	})
+
+	It("ignores the unknown event types", func() {
+		policy := &webhookPolicy{
+			Name:       "pull",
+			EventTypes: []string{"PULL_ARTIFACT", "DELETE_ARTIFACT"},
+		}
+		Expect(policy.toWebhookStatus()).To(Equal(api.WebhookStatus{
+			Name:       "pull",
+			EventTypes: []api.WebhookEventType{api.DeleteWebhookEvent},
+		}))
+	})
+})
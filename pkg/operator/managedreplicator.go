@@ -0,0 +1,205 @@
+/*
+   Copyright 2021 The Kubermatic Kubernetes Platform contributors.
+
+   Licensed under the Apache License, Version 2.0 (the "License");
+   you may not use this file except in compliance with the License.
+   You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+   Unless required by applicable law or agreed to in writing, software
+   distributed under the License is distributed on an "AS IS" BASIS,
+   WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+   See the License for the specific language governing permissions and
+   limitations under the License.
+*/
+
+package operator
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"path"
+	"strings"
+	"time"
+
+	api "github.com/kubermatic-labs/registryman/pkg/apis/registryman/v1alpha1"
+	"github.com/kubermatic-labs/registryman/pkg/config/registry"
+	"github.com/kubermatic-labs/registryman/pkg/globalregistry"
+	"github.com/kubermatic-labs/registryman/pkg/skopeo"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// performManagedReplication copies the repositories of a managed replication
+// and records the result in the status of the destination registry.
+func (sch *ReplicationScheduler) performManagedReplication(ctx context.Context, managedReplication registry.ManagedReplication, now time.Time) {
+	destination := managedReplication.Destination
+	status := GetManagedReplicationStatus(destination, managedReplication)
+	logger.Info("performing managed replication",
+		"project", status.Project,
+		"source", status.Source,
+		"destination", destination.GetName(),
+	)
+	runTime := metav1.NewTime(now)
+	status.LastRunTime = &runTime
+	digests, err := SyncManagedReplication(ctx, sch.store, managedReplication, status.SyncedDigests)
+	status.SyncedDigests = digests
+	if err != nil {
+		logger.Error(err, "managed replication failed",
+			"project", status.Project,
+			"source", status.Source,
+			"destination", destination.GetName(),
+		)
+		sch.events.RecordEventWarning(destination,
+			"ManagedReplicationFailed",
+			fmt.Sprintf("replication of project %s from %s failed: %s",
+				status.Project, status.Source, err.Error()))
+	} else {
+		syncTime := metav1.Now()
+		status.LastSyncTime = &syncTime
+	}
+	sch.updateRegistryStatus(ctx, destination, func(reg *api.Registry) {
+		setManagedReplicationStatus(reg, status)
+	})
+}
+
+// GetManagedReplicationStatus returns the status of the managed replication
+// stored in the status of the destination registry. If there is no such status,
+// an empty status is returned.
+func GetManagedReplicationStatus(destination *api.Registry, managedReplication registry.ManagedReplication) api.ManagedReplicationStatus {
+	if destination.Status != nil {
+		for _, status := range destination.Status.ManagedReplications {
+			if status.Project == managedReplication.Project.GetName() &&
+				status.Source == managedReplication.Source.GetName() {
+				return status
+			}
+		}
+	}
+	return api.ManagedReplicationStatus{
+		Project: managedReplication.Project.GetName(),
+		Source:  managedReplication.Source.GetName(),
+	}
+}
+
+// setManagedReplicationStatus stores the status of a managed replication in the
+// status of the destination registry.
+func setManagedReplicationStatus(destination *api.Registry, status api.ManagedReplicationStatus) {
+	if destination.Status == nil {
+		destination.Status = &api.RegistryStatus{
+			Projects: []api.ProjectStatus{},
+		}
+	}
+	for i, s := range destination.Status.ManagedReplications {
+		if s.Project == status.Project && s.Source == status.Source {
+			destination.Status.ManagedReplications[i] = status
+			return
+		}
+	}
+	destination.Status.ManagedReplications = append(destination.Status.ManagedReplications, status)
+}
+
+// projectRepositoryPath returns the path of the repositories of a project in a
+// registry, e.g. harbor.example.com/project. The repositories of the
+// Artifactory registries are stored in the Docker registry set by the
+// dockerRegistryName annotation.
+func projectRepositoryPath(reg globalregistry.Registry, projectName string) (string, error) {
+	apiURL, err := url.Parse(reg.GetAPIEndpoint())
+	if err != nil {
+		return "", err
+	}
+	if reg.GetProvider() == "artifactory" {
+		if dockerRegistryName, ok := reg.GetAnnotations()["registryman.kubermatic.com/dockerRegistryName"]; ok {
+			return fmt.Sprintf("%s/%s/%s", apiURL.Host, dockerRegistryName, projectName), nil
+		}
+	}
+	return fmt.Sprintf("%s/%s", apiURL.Host, projectName), nil
+}
+
+func skopeoCredentials(reg globalregistry.Registry) skopeo.Credentials {
+	return skopeo.Credentials{
+		Username:              reg.GetUsername(),
+		Password:              reg.GetPassword(),
+		InsecureSkipTLSVerify: reg.GetInsecureSkipTLSVerify(),
+	}
+}
+
+// SyncManagedReplication copies the repositories of the project from the
+// source registry to the destination registry. The syncedDigests map the
+// images, in repository:tag format, to the manifest digests recorded at their
+// last synchronization. The images whose digest has not changed are not copied
+// again. The digests of the synchronized images are returned. The images which
+// are not in the source project anymore are dropped from the returned map. If
+// the synchronization fails, the recorded digests are returned updated with
+// the images processed before the failure.
+func SyncManagedReplication(ctx context.Context, aop registry.ApiObjectProvider, managedReplication registry.ManagedReplication, syncedDigests map[string]string) (map[string]string, error) {
+	projectName := managedReplication.Project.GetName()
+	source := registry.New(managedReplication.Source, aop)
+	destination := registry.New(managedReplication.Destination, aop)
+	realSource, err := source.ToReal()
+	if err != nil {
+		return syncedDigests, err
+	}
+	project, err := realSource.(globalregistry.RegistryWithProjects).GetProjectByName(ctx, projectName)
+	if err != nil {
+		return syncedDigests, err
+	}
+	if project == nil {
+		return syncedDigests, fmt.Errorf("project %s does not exist in registry %s", projectName, source.GetName())
+	}
+	projectWithRepositories, ok := project.(globalregistry.ProjectWithRepositories)
+	if !ok {
+		return syncedDigests, fmt.Errorf("repositories of registry %s cannot be listed", source.GetName())
+	}
+	repositories, err := projectWithRepositories.GetRepositories(ctx)
+	if err != nil {
+		return syncedDigests, err
+	}
+	sourcePath, err := projectRepositoryPath(source, projectName)
+	if err != nil {
+		return syncedDigests, err
+	}
+	destinationPath, err := projectRepositoryPath(destination, projectName)
+	if err != nil {
+		return syncedDigests, err
+	}
+	transfer := skopeo.NewBetweenRegistries(skopeoCredentials(source), skopeoCredentials(destination))
+	digests := make(map[string]string)
+	for _, repository := range repositories {
+		// Sync copies the tags into the destination directory under
+		// the base name of the repository
+		tagDigests, err := transfer.Sync(
+			fmt.Sprintf("%s/%s", sourcePath, repository),
+			path.Dir(fmt.Sprintf("%s/%s", destinationPath, repository)),
+			repositoryDigests(syncedDigests, repository),
+			logger,
+		)
+		for tag, digest := range tagDigests {
+			digests[fmt.Sprintf("%s:%s", repository, tag)] = digest
+		}
+		if err != nil {
+			for image, digest := range syncedDigests {
+				if _, found := digests[image]; !found {
+					digests[image] = digest
+				}
+			}
+			return digests, err
+		}
+	}
+	return digests, nil
+}
+
+// repositoryDigests returns the digests of the tags of a repository from the
+// digests of the images in repository:tag format.
+func repositoryDigests(digests map[string]string, repository string) map[string]string {
+	result := make(map[string]string)
+	prefix := repository + ":"
+	for image, digest := range digests {
+		// the tags cannot contain colons or slashes, so the prefix
+		// identifies the repository
+		if strings.HasPrefix(image, prefix) {
+			result[strings.TrimPrefix(image, prefix)] = digest
+		}
+	}
+	return result
+}
@@ -0,0 +1,45 @@
+/*
+   Copyright 2021 The Kubermatic Kubernetes Platform contributors.
+
+   Licensed under the Apache License, Version 2.0 (the "License");
+   you may not use this file except in compliance with the License.
+   You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+   Unless required by applicable law or agreed to in writing, software
+   distributed under the License is distributed on an "AS IS" BASIS,
+   WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+   See the License for the specific language governing permissions and
+   limitations under the License.
+*/
+
+package operator
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestRepositoryDigests(t *testing.T) {
+	digests := map[string]string{
+		"app:1.0":         "sha256:a",
+		"app:latest":      "sha256:b",
+		"app/web:1.0":     "sha256:c",
+		"application:1.0": "sha256:d",
+	}
+	if got, exp := repositoryDigests(digests, "app"), map[string]string{
+		"1.0":    "sha256:a",
+		"latest": "sha256:b",
+	}; !reflect.DeepEqual(got, exp) {
+		t.Errorf("got %v want %v", got, exp)
+	}
+	if got, exp := repositoryDigests(digests, "app/web"), map[string]string{
+		"1.0": "sha256:c",
+	}; !reflect.DeepEqual(got, exp) {
+		t.Errorf("got %v want %v", got, exp)
+	}
+	if got := repositoryDigests(nil, "app"); len(got) != 0 {
+		t.Errorf("unexpected digests without recorded ones: %v", got)
+	}
+}
@@ -122,6 +122,69 @@ func (rec *Reconciler) loop(ctx context.Context) {
 		logger.V(-1).Info("scanner informer stopped")
 		panic("scanner informer stopped")
 	}()
+
+	robotAccountInformer, err := siFactory.ForResource(schema.GroupVersionResource{
+		Group:    "registryman.kubermatic.com",
+		Version:  "v1alpha1",
+		Resource: "robotaccounts",
+	})
+	if err != nil {
+		logger.Error(err, "cannot create robotAccountInformer")
+		return
+	}
+	robotAccountInformer.Informer().AddEventHandler(
+		&robotAccountEventHandler{
+			ctx:    ctx,
+			aop:    rec.aos,
+			events: rec.aos,
+		})
+	go func() {
+		robotAccountInformer.Informer().Run(ctx.Done())
+		logger.V(-1).Info("robot account informer stopped")
+		panic("robot account informer stopped")
+	}()
+
+	teamInformer, err := siFactory.ForResource(schema.GroupVersionResource{
+		Group:    "registryman.kubermatic.com",
+		Version:  "v1alpha1",
+		Resource: "teams",
+	})
+	if err != nil {
+		logger.Error(err, "cannot create teamInformer")
+		return
+	}
+	teamInformer.Informer().AddEventHandler(
+		&teamEventHandler{
+			ctx:    ctx,
+			aop:    rec.aos,
+			events: rec.aos,
+		})
+	go func() {
+		teamInformer.Informer().Run(ctx.Done())
+		logger.V(-1).Info("team informer stopped")
+		panic("team informer stopped")
+	}()
+
+	projectClassInformer, err := siFactory.ForResource(schema.GroupVersionResource{
+		Group:    "registryman.kubermatic.com",
+		Version:  "v1alpha1",
+		Resource: "projectclasses",
+	})
+	if err != nil {
+		logger.Error(err, "cannot create projectClassInformer")
+		return
+	}
+	projectClassInformer.Informer().AddEventHandler(
+		&projectClassEventHandler{
+			ctx:    ctx,
+			aop:    rec.aos,
+			events: rec.aos,
+		})
+	go func() {
+		projectClassInformer.Informer().Run(ctx.Done())
+		logger.V(-1).Info("project class informer stopped")
+		panic("project class informer stopped")
+	}()
 	<-ctx.Done()
 	logger.V(1).Info("stopping reconciler loop")
 }
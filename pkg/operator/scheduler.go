@@ -0,0 +1,242 @@
+/*
+   Copyright 2021 The Kubermatic Kubernetes Platform contributors.
+
+   Licensed under the Apache License, Version 2.0 (the "License");
+   you may not use this file except in compliance with the License.
+   You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+   Unless required by applicable law or agreed to in writing, software
+   distributed under the License is distributed on an "AS IS" BASIS,
+   WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+   See the License for the specific language governing permissions and
+   limitations under the License.
+*/
+
+package operator
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	api "github.com/kubermatic-labs/registryman/pkg/apis/registryman/v1alpha1"
+	"github.com/kubermatic-labs/registryman/pkg/config/registry"
+	"github.com/kubermatic-labs/registryman/pkg/globalregistry"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// defaultReplicationInterval is the period of the managed replications of the
+// projects without cron trigger.
+const defaultReplicationInterval = 10 * time.Minute
+
+// ReplicationScheduler starts the replications according to the replication
+// triggers of the projects. It performs the managed replications, see
+// registry.ManagedReplications, and it executes the replication rules of the
+// projects with cron trigger that are not scheduled by the registries
+// themselves. The time of the last runs is stored in the Registry status, so
+// the schedule is kept when the operator restarts.
+type ReplicationScheduler struct {
+	interval time.Duration
+	store    RegistryStore
+	events   EventRecorder
+}
+
+func NewReplicationScheduler(interval time.Duration, store RegistryStore) *ReplicationScheduler {
+	return &ReplicationScheduler{
+		interval: interval,
+		store:    store,
+		events:   store,
+	}
+}
+
+func (sch *ReplicationScheduler) Start(ctx context.Context) {
+	logger.V(1).Info("starting replication scheduler")
+	go sch.loop(ctx)
+}
+
+func (sch *ReplicationScheduler) loop(ctx context.Context) {
+	timer := time.NewTicker(sch.interval)
+	defer timer.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			logger.V(1).Info("stopping replication scheduler loop")
+			return
+		case now := <-timer.C:
+			logger.V(1).Info("replication scheduler tick")
+			sch.schedule(ctx, now)
+		}
+	}
+}
+
+// isDue returns whether a replication with the given trigger and last run time
+// shall be started. The replications with manual trigger are never started,
+// the replications with other than cron trigger are started periodically.
+func isDue(trigger api.ReplicationTrigger, lastRun *metav1.Time, now time.Time) bool {
+	switch trigger.Type {
+	case api.ManualReplicationTriggerType:
+		return false
+	case api.CronReplicationTriggerType:
+		schedule, err := globalregistry.ParseCronSchedule(trigger.Schedule)
+		if err != nil {
+			return false
+		}
+		if lastRun == nil {
+			return true
+		}
+		next := schedule.Next(lastRun.Time)
+		return !next.IsZero() && !next.After(now)
+	default:
+		return lastRun == nil || now.Sub(lastRun.Time) >= defaultReplicationInterval
+	}
+}
+
+// schedule starts the replications that are due.
+func (sch *ReplicationScheduler) schedule(ctx context.Context, now time.Time) {
+	projects := sch.store.GetProjects(ctx)
+	registries := sch.store.GetRegistries(ctx)
+	for _, managedReplication := range registry.ManagedReplications(projects, registries) {
+		status := GetManagedReplicationStatus(managedReplication.Destination, managedReplication)
+		if isDue(managedReplication.Project.Spec.Trigger, status.LastRunTime, now) {
+			sch.performManagedReplication(ctx, managedReplication, now)
+		}
+	}
+	for _, project := range projects {
+		if project.Spec.Trigger.Type != api.CronReplicationTriggerType {
+			continue
+		}
+		for _, reg := range registries {
+			if !registry.IsProjectProvisioned(project, reg, registries) {
+				continue
+			}
+			if isDue(project.Spec.Trigger, getScheduledReplicationRunTime(reg, project.GetName()), now) {
+				sch.executeReplicationRules(ctx, reg, project, now)
+			}
+		}
+	}
+}
+
+// executeReplicationRules starts the replication rules of the project in the
+// registry, except the rules that are scheduled by the registry itself.
+func (sch *ReplicationScheduler) executeReplicationRules(ctx context.Context, reg *api.Registry, project *api.Project, now time.Time) {
+	err := sch.startReplicationRules(ctx, reg, project)
+	if err != nil {
+		logger.Error(err, "scheduled replication failed",
+			"registry", reg.GetName(),
+			"project", project.GetName(),
+		)
+		sch.events.RecordEventWarning(reg,
+			"ScheduledReplicationFailed",
+			fmt.Sprintf("scheduled replication of project %s failed: %s",
+				project.GetName(), err.Error()))
+	}
+	status := api.ScheduledReplicationStatus{
+		Project:     project.GetName(),
+		LastRunTime: metav1.NewTime(now),
+	}
+	sch.updateRegistryStatus(ctx, reg, func(reg *api.Registry) {
+		setScheduledReplicationStatus(reg, status)
+	})
+}
+
+func (sch *ReplicationScheduler) startReplicationRules(ctx context.Context, reg *api.Registry, apiProject *api.Project) error {
+	realRegistry, err := registry.New(reg, sch.store).ToReal()
+	if err != nil {
+		return err
+	}
+	project, err := realRegistry.(globalregistry.RegistryWithProjects).GetProjectByName(ctx, apiProject.GetName())
+	if err != nil {
+		return err
+	}
+	if project == nil {
+		return fmt.Errorf("project %s does not exist in registry %s", apiProject.GetName(), reg.GetName())
+	}
+	projectWithReplication, ok := project.(globalregistry.ProjectWithReplication)
+	if !ok {
+		return nil
+	}
+	rules, err := projectWithReplication.GetReplicationRules(ctx, nil, "")
+	if err != nil {
+		return err
+	}
+	for _, rule := range rules {
+		if rule.Trigger().TriggerType() == api.CronReplicationTriggerType {
+			// the registry schedules the rule
+			continue
+		}
+		executableRule, ok := rule.(globalregistry.ExecutableReplicationRule)
+		if !ok {
+			continue
+		}
+		executionID, err := executableRule.Execute(ctx)
+		if err != nil {
+			return err
+		}
+		logger.Info("scheduled replication started",
+			"registry", reg.GetName(),
+			"project", apiProject.GetName(),
+			"remote", rule.RemoteRegistry().GetName(),
+			"execution", executionID,
+		)
+	}
+	return nil
+}
+
+// getScheduledReplicationRunTime returns when the replication rules of the
+// project were last started by the scheduler. nil is returned if they have not
+// been started yet.
+func getScheduledReplicationRunTime(reg *api.Registry, projectName string) *metav1.Time {
+	if reg.Status == nil {
+		return nil
+	}
+	for i, status := range reg.Status.ScheduledReplications {
+		if status.Project == projectName {
+			return &reg.Status.ScheduledReplications[i].LastRunTime
+		}
+	}
+	return nil
+}
+
+func setScheduledReplicationStatus(reg *api.Registry, status api.ScheduledReplicationStatus) {
+	if reg.Status == nil {
+		reg.Status = &api.RegistryStatus{
+			Projects: []api.ProjectStatus{},
+		}
+	}
+	for i, s := range reg.Status.ScheduledReplications {
+		if s.Project == status.Project {
+			reg.Status.ScheduledReplications[i] = status
+			return
+		}
+	}
+	reg.Status.ScheduledReplications = append(reg.Status.ScheduledReplications, status)
+}
+
+// updateRegistryStatus changes the status of the registry and persists it. If
+// the registry has been changed in the meantime, the change is applied on the
+// current version of the registry once more.
+func (sch *ReplicationScheduler) updateRegistryStatus(ctx context.Context, reg *api.Registry, change func(*api.Registry)) {
+	change(reg)
+	err := sch.store.UpdateRegistryStatus(ctx, reg)
+	if err != nil {
+		logger.V(1).Info("retrying registry status update",
+			"registry", reg.GetName(),
+			"error", err.Error(),
+		)
+		for _, current := range sch.store.GetRegistries(ctx) {
+			if current.GetName() == reg.GetName() {
+				change(current)
+				err = sch.store.UpdateRegistryStatus(ctx, current)
+				break
+			}
+		}
+	}
+	if err != nil {
+		logger.Error(err, "failed updating registry status in replication scheduler")
+		sch.events.RecordEventWarning(reg,
+			"StatusUpdateFailed",
+			fmt.Sprintf("failed updating registry status in replication scheduler: %s", err.Error()))
+	}
+}
@@ -0,0 +1,69 @@
+/*
+   Copyright 2021 The Kubermatic Kubernetes Platform contributors.
+
+   Licensed under the Apache License, Version 2.0 (the "License");
+   you may not use this file except in compliance with the License.
+   You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+   Unless required by applicable law or agreed to in writing, software
+   distributed under the License is distributed on an "AS IS" BASIS,
+   WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+   See the License for the specific language governing permissions and
+   limitations under the License.
+*/
+
+package operator
+
+import (
+	"testing"
+	"time"
+
+	api "github.com/kubermatic-labs/registryman/pkg/apis/registryman/v1alpha1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestIsDue(t *testing.T) {
+	now := time.Date(2021, 9, 1, 10, 7, 0, 0, time.UTC)
+	lastRun := func(d time.Duration) *metav1.Time {
+		mt := metav1.NewTime(now.Add(-d))
+		return &mt
+	}
+	cronTrigger := func(schedule string) api.ReplicationTrigger {
+		return api.ReplicationTrigger{
+			Type:     api.CronReplicationTriggerType,
+			Schedule: schedule,
+		}
+	}
+	eventBased := api.ReplicationTrigger{Type: api.EventBasedReplicationTriggerType}
+	manual := api.ReplicationTrigger{Type: api.ManualReplicationTriggerType}
+
+	dueTest := []struct {
+		id      string
+		trigger api.ReplicationTrigger
+		lastRun *metav1.Time
+		due     bool
+	}{
+		{id: "1", trigger: manual, lastRun: nil, due: false},
+		{id: "2", trigger: eventBased, lastRun: nil, due: true},
+		{id: "3", trigger: eventBased, lastRun: lastRun(5 * time.Minute), due: false},
+		{id: "4", trigger: eventBased, lastRun: lastRun(10 * time.Minute), due: true},
+		{id: "5", trigger: cronTrigger("*/5 * * * *"), lastRun: nil, due: true},
+		{id: "6", trigger: cronTrigger("*/5 * * * *"), lastRun: lastRun(time.Minute), due: false},
+		{id: "7", trigger: cronTrigger("*/5 * * * *"), lastRun: lastRun(3 * time.Minute), due: true},
+		{id: "8", trigger: cronTrigger("0 3 * * *"), lastRun: lastRun(time.Hour), due: false},
+		// the run missed while the operator was down is performed
+		{id: "9", trigger: cronTrigger("0 3 * * *"), lastRun: lastRun(48 * time.Hour), due: true},
+		{id: "10", trigger: cronTrigger("invalid"), lastRun: nil, due: false},
+		// 2021-09-01 is a Wednesday
+		{id: "11", trigger: cronTrigger("0 10 * SEP WED"), lastRun: lastRun(48 * time.Hour), due: true},
+		{id: "12", trigger: cronTrigger("0 10 * SEP MON-TUE"), lastRun: lastRun(48 * time.Hour), due: false},
+		{id: "13", trigger: cronTrigger("@daily"), lastRun: nil, due: false},
+	}
+	for _, tt := range dueTest {
+		if due := isDue(tt.trigger, tt.lastRun, now); due != tt.due {
+			t.Errorf("TC-%v: got %v want %v", tt.id, due, tt.due)
+		}
+	}
+}
@@ -93,6 +93,18 @@ func (sup *StatusUpdater) updateRegistryStatus(ctx context.Context, reg *api.Reg
 		logger.Error(err, "failed getting registry status in statusupdater")
 		return
 	}
+	err = reconciler.AddRecordedStatus(ctx, sup.store, realReg, registryStatus)
+	if err != nil {
+		logger.Error(err, "failed getting recorded status in statusupdater")
+		return
+	}
+	sup.recordFailedReplications(reg, reg.Status, registryStatus)
+	if reg.Status != nil {
+		// the managed and scheduled replications are maintained by
+		// the ReplicationScheduler
+		registryStatus.ManagedReplications = reg.Status.ManagedReplications
+		registryStatus.ScheduledReplications = reg.Status.ScheduledReplications
+	}
 	reg.Status = registryStatus
 	err = sup.store.UpdateRegistryStatus(ctx, reg)
 	if err != nil {
@@ -102,3 +114,36 @@ func (sup *StatusUpdater) updateRegistryStatus(ctx context.Context, reg *api.Reg
 			fmt.Sprintf("failed updating registry status in statusupdater: %s", err.Error()))
 	}
 }
+
+// recordFailedReplications records a warning event for each failed replication
+// execution that was not reported in the previous registry status.
+func (sup *StatusUpdater) recordFailedReplications(reg *api.Registry, previous, current *api.RegistryStatus) {
+	reportedExecutions := make(map[int]bool)
+	if previous != nil {
+		for _, project := range previous.Projects {
+			for _, rule := range project.ReplicationRules {
+				if rule.LastExecution != nil {
+					reportedExecutions[rule.LastExecution.ID] = true
+				}
+			}
+		}
+	}
+	for _, project := range current.Projects {
+		for _, rule := range project.ReplicationRules {
+			execution := rule.LastExecution
+			if execution == nil ||
+				execution.State != api.FailedReplicationExecutionState ||
+				reportedExecutions[execution.ID] {
+				continue
+			}
+			sup.events.RecordEventWarning(reg,
+				"ReplicationFailed",
+				fmt.Sprintf("replication of project %s with %s [%s] failed, %d failed tasks",
+					project.Name,
+					rule.RemoteRegistry.Name,
+					rule.Direction,
+					execution.FailedTasks,
+				))
+		}
+	}
+}
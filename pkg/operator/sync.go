@@ -47,6 +47,10 @@ func resyncRegistry(ctx context.Context, sres SyncableResources, expectedProvide
 	if err != nil {
 		return false, err
 	}
+	err = reconciler.AddRecordedStatus(ctx, sres, actualRegistry, regStatusActual)
+	if err != nil {
+		return false, err
+	}
 	logger.V(1).Info("actual registry status acquired", "status", regStatusActual)
 	actions := reconciler.Compare(expectedProvider, regStatusActual, regStatusExpected)
 	logger.Info("ACTIONS:")
@@ -38,10 +38,19 @@ const (
 
 var AllTeamRoles = []TeamRole{MemberTeamRole, CreatorTeamRole, AdminTeamRole}
 
+// DefaultBaseURL is the API endpoint of quay.io. It is used when the BaseURL
+// of the Client is not set.
+const DefaultBaseURL = "https://quay.io/api/v1"
+
 type Client struct {
 	Token  string
 	Client *http.Client
 	Dry    bool
+
+	// BaseURL is the API endpoint of the Quay instance, e.g.
+	// "https://quay.example.com/api/v1". If it is empty, DefaultBaseURL is
+	// used.
+	BaseURL string
 }
 
 func NewClient(token string, timeout time.Duration, dryMode bool) (*Client, error) {
@@ -112,7 +121,11 @@ func (c *Client) call(ctx context.Context, method string, path string, opt optio
 		return nil
 	}
 
-	u := "https://quay.io/api/v1" + path
+	baseURL := c.BaseURL
+	if baseURL == "" {
+		baseURL = DefaultBaseURL
+	}
+	u := baseURL + path
 
 	request, err := http.NewRequestWithContext(ctx, method, u, body)
 	if err != nil {
@@ -136,6 +149,10 @@ func (c *Client) call(ctx context.Context, method string, path string, opt optio
 			return fmt.Errorf("request failed and decoding the response also failed, HTTP status was %s: %v", response.Status, err)
 		}
 
+		if e.Status == 0 {
+			e.Status = response.StatusCode
+		}
+
 		return e
 	}
 
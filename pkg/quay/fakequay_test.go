@@ -0,0 +1,252 @@
+/*
+   Copyright 2021 The Kubermatic Kubernetes Platform contributors.
+
+   Licensed under the Apache License, Version 2.0 (the "License");
+   you may not use this file except in compliance with the License.
+   You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+   Unless required by applicable law or agreed to in writing, software
+   distributed under the License is distributed on an "AS IS" BASIS,
+   WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+   See the License for the specific language governing permissions and
+   limitations under the License.
+*/
+
+package quay
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sort"
+	"strings"
+	"sync"
+)
+
+// fakeQuay is an in-memory implementation of the subset of the Quay REST API
+// used by the quay provider.
+type fakeQuay struct {
+	*httptest.Server
+	token string
+
+	mu            sync.Mutex
+	organizations map[string]*fakeOrganization
+	nextID        int
+}
+
+type fakeOrganization struct {
+	teams        map[string]Team
+	teamSyncs    map[string]string
+	prototypes   []Prototype
+	robots       map[string]string
+	repositories []string
+}
+
+func newFakeQuay(token string) *fakeQuay {
+	f := &fakeQuay{
+		token:         token,
+		organizations: make(map[string]*fakeOrganization),
+	}
+	f.Server = httptest.NewServer(http.HandlerFunc(f.serveHTTP))
+	return f
+}
+
+func (f *fakeQuay) addOrganization(name string) *fakeOrganization {
+	org := &fakeOrganization{
+		teams: map[string]Team{
+			ownersTeam: {Name: ownersTeam, Role: AdminTeamRole},
+		},
+		teamSyncs: make(map[string]string),
+		robots:    make(map[string]string),
+	}
+	f.organizations[name] = org
+	return org
+}
+
+func (f *fakeQuay) writeError(w http.ResponseWriter, status int, msg string) {
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(&APIError{
+		Status:       status,
+		ErrorMessage: msg,
+		Title:        http.StatusText(status),
+	})
+}
+
+func (f *fakeQuay) writeJSON(w http.ResponseWriter, body interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(body)
+}
+
+func (f *fakeQuay) serveHTTP(w http.ResponseWriter, req *http.Request) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	if req.Header.Get("Authorization") != "Bearer "+f.token {
+		f.writeError(w, http.StatusUnauthorized, "invalid token")
+		return
+	}
+	path := strings.TrimPrefix(req.URL.Path, "/api/v1")
+	if path == "/user/" && req.Method == http.MethodGet {
+		names := make([]string, 0, len(f.organizations))
+		for name := range f.organizations {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+		orgs := make([]UserOrganization, len(names))
+		for i, name := range names {
+			orgs[i].Name = name
+		}
+		f.writeJSON(w, getUserResponse{Organizations: orgs})
+		return
+	}
+	if path == "/organization/" && req.Method == http.MethodPost {
+		opt := CreateOrganizationOptions{}
+		if err := json.NewDecoder(req.Body).Decode(&opt); err != nil {
+			f.writeError(w, http.StatusBadRequest, err.Error())
+			return
+		}
+		if _, found := f.organizations[opt.Name]; found {
+			f.writeError(w, http.StatusBadRequest, "organization already exists")
+			return
+		}
+		f.addOrganization(opt.Name)
+		w.WriteHeader(http.StatusCreated)
+		return
+	}
+	if path == "/repository" && req.Method == http.MethodGet {
+		namespace := req.URL.Query().Get("namespace")
+		org, found := f.organizations[namespace]
+		if !found {
+			f.writeJSON(w, getRepositoriesReponse{})
+			return
+		}
+		repos := make([]Repository, len(org.repositories))
+		for i, repo := range org.repositories {
+			repos[i] = Repository{Namespace: namespace, Name: repo}
+		}
+		f.writeJSON(w, getRepositoriesReponse{Repositories: repos})
+		return
+	}
+
+	parts := strings.Split(strings.TrimPrefix(path, "/"), "/")
+	if len(parts) == 3 && parts[0] == "repository" && req.Method == http.MethodDelete {
+		org, found := f.organizations[parts[1]]
+		if !found {
+			f.writeError(w, http.StatusNotFound, "not found")
+			return
+		}
+		for i, repo := range org.repositories {
+			if repo == parts[2] {
+				org.repositories = append(org.repositories[:i], org.repositories[i+1:]...)
+				w.WriteHeader(http.StatusNoContent)
+				return
+			}
+		}
+		f.writeError(w, http.StatusNotFound, "not found")
+		return
+	}
+	if len(parts) < 2 || parts[0] != "organization" {
+		f.writeError(w, http.StatusNotFound, "not found")
+		return
+	}
+	orgName := parts[1]
+	org, found := f.organizations[orgName]
+	if !found {
+		f.writeError(w, http.StatusNotFound, "organization not found")
+		return
+	}
+	switch {
+	case len(parts) == 2 && req.Method == http.MethodGet:
+		f.writeJSON(w, Organization{
+			Name:  orgName,
+			Teams: org.teams,
+		})
+	case len(parts) == 2 && req.Method == http.MethodDelete:
+		delete(f.organizations, orgName)
+		w.WriteHeader(http.StatusNoContent)
+	case len(parts) == 3 && parts[2] == "prototypes" && req.Method == http.MethodGet:
+		f.writeJSON(w, getOrganizationPrototypesResponse{Prototypes: org.prototypes})
+	case len(parts) == 3 && parts[2] == "prototypes" && req.Method == http.MethodPost:
+		prototype := Prototype{}
+		if err := json.NewDecoder(req.Body).Decode(&prototype); err != nil {
+			f.writeError(w, http.StatusBadRequest, err.Error())
+			return
+		}
+		if prototype.Delegate.Kind == UserDelegate {
+			shortName := strings.TrimPrefix(prototype.Delegate.Name, orgName+"+")
+			_, prototype.Delegate.IsRobot = org.robots[shortName]
+		}
+		f.nextID++
+		prototype.ID = fmt.Sprintf("prototype-%d", f.nextID)
+		org.prototypes = append(org.prototypes, prototype)
+		f.writeJSON(w, prototype)
+	case len(parts) == 4 && parts[2] == "prototypes" && req.Method == http.MethodDelete:
+		for i, prototype := range org.prototypes {
+			if prototype.ID == parts[3] {
+				org.prototypes = append(org.prototypes[:i], org.prototypes[i+1:]...)
+				w.WriteHeader(http.StatusNoContent)
+				return
+			}
+		}
+		f.writeError(w, http.StatusNotFound, "prototype not found")
+	case len(parts) == 4 && parts[2] == "team" && req.Method == http.MethodPut:
+		opt := UpsertTeamOptions{}
+		if err := json.NewDecoder(req.Body).Decode(&opt); err != nil {
+			f.writeError(w, http.StatusBadRequest, err.Error())
+			return
+		}
+		org.teams[parts[3]] = Team{
+			Name:        parts[3],
+			Role:        opt.Role,
+			Description: opt.Description,
+		}
+		f.writeJSON(w, org.teams[parts[3]])
+	case len(parts) == 4 && parts[2] == "team" && req.Method == http.MethodDelete:
+		if _, found := org.teams[parts[3]]; !found {
+			f.writeError(w, http.StatusNotFound, "team not found")
+			return
+		}
+		delete(org.teams, parts[3])
+		delete(org.teamSyncs, parts[3])
+		w.WriteHeader(http.StatusNoContent)
+	case len(parts) == 5 && parts[2] == "team" && parts[4] == "members" && req.Method == http.MethodGet:
+		response := getTeamMembersReponse{Name: parts[3]}
+		if dn, found := org.teamSyncs[parts[3]]; found {
+			response.Synced = &TeamSync{Service: "ldap"}
+			response.Synced.Config.GroupDN = dn
+		}
+		f.writeJSON(w, response)
+	case len(parts) == 5 && parts[2] == "team" && parts[4] == "syncing" && req.Method == http.MethodPost:
+		body := enableTeamSyncBody{}
+		if err := json.NewDecoder(req.Body).Decode(&body); err != nil {
+			f.writeError(w, http.StatusBadRequest, err.Error())
+			return
+		}
+		org.teamSyncs[parts[3]] = body.GroupDN
+		w.WriteHeader(http.StatusOK)
+	case len(parts) == 4 && parts[2] == "robots" && req.Method == http.MethodPut:
+		if _, found := org.robots[parts[3]]; found {
+			f.writeError(w, http.StatusBadRequest, "robot already exists")
+			return
+		}
+		f.nextID++
+		org.robots[parts[3]] = fmt.Sprintf("token-%d", f.nextID)
+		w.WriteHeader(http.StatusCreated)
+		f.writeJSON(w, Robot{
+			Name:  orgName + "+" + parts[3],
+			Token: org.robots[parts[3]],
+		})
+	case len(parts) == 4 && parts[2] == "robots" && req.Method == http.MethodDelete:
+		if _, found := org.robots[parts[3]]; !found {
+			f.writeError(w, http.StatusBadRequest, "robot not found")
+			return
+		}
+		delete(org.robots, parts[3])
+		w.WriteHeader(http.StatusNoContent)
+	default:
+		f.writeError(w, http.StatusNotFound, "not found")
+	}
+}
@@ -0,0 +1,332 @@
+/*
+   Copyright 2021 The Kubermatic Kubernetes Platform contributors.
+
+   Licensed under the Apache License, Version 2.0 (the "License");
+   you may not use this file except in compliance with the License.
+   You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+   Unless required by applicable law or agreed to in writing, software
+   distributed under the License is distributed on an "AS IS" BASIS,
+   WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+   See the License for the specific language governing permissions and
+   limitations under the License.
+*/
+
+package quay
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/kubermatic-labs/registryman/pkg/globalregistry"
+)
+
+const (
+	userType  = "User"
+	robotType = "Robot"
+	groupType = "Group"
+)
+
+type projectMember struct {
+	name       string
+	memberType string
+	role       string
+}
+
+var _ globalregistry.ProjectMember = &projectMember{}
+
+func (m *projectMember) GetName() string {
+	return m.name
+}
+
+func (m *projectMember) GetType() string {
+	return m.memberType
+}
+
+func (m *projectMember) GetRole() string {
+	return m.role
+}
+
+// teamMember is a group member of the project. If the team is synchronized
+// with an LDAP group, the DN of the group is stored too.
+type teamMember struct {
+	projectMember
+	dn string
+}
+
+var _ globalregistry.LdapMember = &teamMember{}
+
+func (m *teamMember) GetDN() string {
+	return m.dn
+}
+
+// robotFullName returns the name Quay uses for the robot account of the
+// organization.
+func (p *project) robotFullName(shortName string) string {
+	return fmt.Sprintf("%s+%s", p.name, shortName)
+}
+
+// GetMembers implements the globalregistry.ProjectWithMembers interface. The
+// teams of the organization are returned as Group members, while the users and
+// robots having a default permission in the organization are returned as User
+// and Robot members.
+func (p *project) GetMembers(ctx context.Context) ([]globalregistry.ProjectMember, error) {
+	organization, err := p.registry.client.GetOrganization(ctx, p.name)
+	if err != nil {
+		return nil, p.registry.apiError(err)
+	}
+	prototypes, err := p.registry.client.GetOrganizationPrototypes(ctx, p.name)
+	if err != nil {
+		return nil, p.registry.apiError(err)
+	}
+	teamRepositoryRoles := make(map[string]RepositoryRole)
+	for _, prototype := range prototypes {
+		if prototype.Delegate.Kind == TeamDelegate {
+			teamRepositoryRoles[prototype.Delegate.Name] = prototype.Role
+		}
+	}
+
+	roleTable, err := p.registry.roleTable()
+	if err != nil {
+		return nil, err
+	}
+	members := make([]globalregistry.ProjectMember, 0)
+
+	// collecting the members of type Group
+	teamNames := make([]string, 0, len(organization.Teams))
+	for teamName := range organization.Teams {
+		if teamName == ownersTeam {
+			continue
+		}
+		teamNames = append(teamNames, teamName)
+	}
+	sort.Strings(teamNames)
+	for _, teamName := range teamNames {
+		teamSync, err := p.registry.client.GetTeamSync(ctx, p.name, teamName)
+		if err != nil {
+			return nil, p.registry.apiError(err)
+		}
+		member := &teamMember{
+			projectMember: projectMember{
+				name:       teamName,
+				memberType: groupType,
+				role: roleTable.Role(groupType, teamProviderRole(
+					organization.Teams[teamName].Role,
+					teamRepositoryRoles[teamName])),
+			},
+		}
+		if teamSync != nil {
+			member.dn = teamSync.Config.GroupDN
+		}
+		members = append(members, member)
+	}
+
+	// collecting the members of type User and Robot
+	for _, prototype := range prototypes {
+		if prototype.Delegate.Kind != UserDelegate {
+			continue
+		}
+		if prototype.Delegate.IsRobot {
+			// robots cannot administer the organization, admin
+			// default permission grants them pull and push access
+			repositoryRole := prototype.Role
+			if repositoryRole == AdminRepositoryRole {
+				repositoryRole = WriteRepositoryRole
+			}
+			members = append(members, &projectMember{
+				name:       strings.TrimPrefix(prototype.Delegate.Name, p.name+"+"),
+				memberType: robotType,
+				role:       roleTable.Role(robotType, string(repositoryRole)),
+			})
+			continue
+		}
+		members = append(members, &projectMember{
+			name:       prototype.Delegate.Name,
+			memberType: userType,
+			role:       roleTable.Role(userType, string(prototype.Role)),
+		})
+	}
+	return members, nil
+}
+
+// AssignMember implements the globalregistry.MemberManipulatorProject
+// interface. In case of robot members the credentials of the created robot
+// account are returned.
+func (p *project) AssignMember(ctx context.Context, member globalregistry.ProjectMember) (*globalregistry.ProjectMemberCredentials, error) {
+	memberType := member.GetType()
+	roleTable, err := p.registry.roleTable()
+	if err != nil {
+		return nil, err
+	}
+	providerRole, err := roleTable.ProviderRoleOf(memberType, member.GetRole())
+	if err != nil {
+		return nil, err
+	}
+	switch memberType {
+	default:
+		return nil, fmt.Errorf("unhandled member type: %s", memberType)
+	case userType:
+		role, err := parseRepositoryRole(providerRole)
+		if err != nil {
+			return nil, err
+		}
+		return nil, p.createPrototype(ctx, Delegate{
+			Kind: UserDelegate,
+			Name: member.GetName(),
+		}, role)
+	case groupType:
+		teamRole, repositoryRole, err := parseTeamProviderRole(providerRole)
+		if err != nil {
+			return nil, err
+		}
+		p.registry.logger.V(1).Info("creating team",
+			"organization", p.name,
+			"team", member.GetName(),
+			"role", teamRole,
+		)
+		err = p.registry.client.UpsertTeam(ctx, p.name, member.GetName(), UpsertTeamOptions{
+			Role:        teamRole,
+			Description: "generated group member",
+		})
+		if err != nil {
+			return nil, p.registry.apiError(err)
+		}
+		if ldapMember, ok := member.(globalregistry.LdapMember); ok && ldapMember.GetDN() != "" {
+			p.registry.logger.V(1).Info("enabling team synchronization",
+				"organization", p.name,
+				"team", member.GetName(),
+				"dn", ldapMember.GetDN(),
+			)
+			err = p.registry.client.EnableTeamSync(ctx, p.name, member.GetName(), ldapMember.GetDN())
+			if err != nil {
+				return nil, p.registry.apiError(err)
+			}
+		}
+		if repositoryRole == "" {
+			return nil, nil
+		}
+		return nil, p.createPrototype(ctx, Delegate{
+			Kind: TeamDelegate,
+			Name: member.GetName(),
+		}, repositoryRole)
+	case robotType:
+		role, err := parseRepositoryRole(providerRole)
+		if err != nil {
+			return nil, err
+		}
+		p.registry.logger.V(1).Info("creating robot account",
+			"organization", p.name,
+			"robot", member.GetName(),
+		)
+		robot, err := p.registry.client.CreateOrganizationRobot(ctx, p.name, member.GetName(), CreateOrganizationRobotOptions{
+			Description: "generated robot member",
+		})
+		if err != nil {
+			return nil, p.registry.apiError(err)
+		}
+		err = p.createPrototype(ctx, Delegate{
+			Kind: UserDelegate,
+			Name: robot.Name,
+		}, role)
+		if err != nil {
+			return nil, err
+		}
+		return &globalregistry.ProjectMemberCredentials{
+			Username: robot.Name,
+			Password: robot.Token,
+		}, nil
+	}
+}
+
+// UnassignMember implements the globalregistry.MemberManipulatorProject
+// interface.
+func (p *project) UnassignMember(ctx context.Context, member globalregistry.ProjectMember) error {
+	memberType := member.GetType()
+	switch memberType {
+	default:
+		return fmt.Errorf("unhandled member type: %s", memberType)
+	case userType:
+		found, err := p.deletePrototypes(ctx, UserDelegate, member.GetName())
+		if err != nil {
+			return err
+		}
+		if !found {
+			return fmt.Errorf("user member not found")
+		}
+		return nil
+	case groupType:
+		_, err := p.deletePrototypes(ctx, TeamDelegate, member.GetName())
+		if err != nil {
+			return err
+		}
+		p.registry.logger.V(1).Info("deleting team",
+			"organization", p.name,
+			"team", member.GetName(),
+		)
+		err = p.registry.client.DeleteTeam(ctx, p.name, member.GetName())
+		if err != nil {
+			return p.registry.apiError(err)
+		}
+		return nil
+	case robotType:
+		_, err := p.deletePrototypes(ctx, UserDelegate, p.robotFullName(member.GetName()))
+		if err != nil {
+			return err
+		}
+		p.registry.logger.V(1).Info("deleting robot account",
+			"organization", p.name,
+			"robot", member.GetName(),
+		)
+		err = p.registry.client.DeleteOrganizationRobot(ctx, p.name, member.GetName())
+		if err != nil {
+			return p.registry.apiError(err)
+		}
+		return nil
+	}
+}
+
+func (p *project) createPrototype(ctx context.Context, delegate Delegate, role RepositoryRole) error {
+	p.registry.logger.V(1).Info("creating default permission",
+		"organization", p.name,
+		"delegate", delegate.Name,
+		"kind", delegate.Kind,
+		"role", role,
+	)
+	err := p.registry.client.CreateOrganizationPrototype(ctx, p.name, Prototype{
+		Role:     role,
+		Delegate: delegate,
+	})
+	if err != nil {
+		return p.registry.apiError(err)
+	}
+	return nil
+}
+
+// deletePrototypes removes the default permissions of the delegate. The
+// returned bool value shows whether any default permission was found.
+func (p *project) deletePrototypes(ctx context.Context, kind DelegateKind, name string) (bool, error) {
+	prototypes, err := p.registry.client.GetOrganizationPrototypes(ctx, p.name)
+	if err != nil {
+		return false, p.registry.apiError(err)
+	}
+	found := false
+	for _, prototype := range prototypes {
+		if prototype.Delegate.Kind != kind || prototype.Delegate.Name != name {
+			continue
+		}
+		found = true
+		p.registry.logger.V(1).Info("deleting default permission",
+			"organization", p.name,
+			"id", prototype.ID,
+		)
+		err = p.registry.client.DeleteOrganizationPrototype(ctx, p.name, prototype.ID)
+		if err != nil {
+			return found, p.registry.apiError(err)
+		}
+	}
+	return found, nil
+}
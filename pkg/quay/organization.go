@@ -56,3 +56,39 @@ func (c *Client) GetOrganizationMembers(ctx context.Context, organization string
 
 	return response.Members, err
 }
+
+type CreateOrganizationOptions struct {
+	Name  string `json:"name"`
+	Email string `json:"email,omitempty"`
+}
+
+func (c *Client) CreateOrganization(ctx context.Context, opt CreateOrganizationOptions) error {
+	return c.call(ctx, "POST", "/organization/", nil, toBody(opt), nil)
+}
+
+func (c *Client) DeleteOrganization(ctx context.Context, name string) error {
+	path := fmt.Sprintf("/organization/%s", url.PathEscape(name))
+
+	return c.call(ctx, "DELETE", path, nil, nil, nil)
+}
+
+type UserOrganization struct {
+	Name          string `json:"name"`
+	IsAdmin       bool   `json:"is_org_admin"`
+	CanCreateRepo bool   `json:"can_create_repo"`
+	Public        bool   `json:"public"`
+}
+
+type getUserResponse struct {
+	Username      string             `json:"username"`
+	Organizations []UserOrganization `json:"organizations"`
+}
+
+// GetUserOrganizations returns the organizations the owner of the token is a
+// member of.
+func (c *Client) GetUserOrganizations(ctx context.Context) ([]UserOrganization, error) {
+	response := getUserResponse{}
+	err := c.call(ctx, "GET", "/user/", nil, nil, &response)
+
+	return response.Organizations, err
+}
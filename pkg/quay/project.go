@@ -0,0 +1,100 @@
+/*
+   Copyright 2021 The Kubermatic Kubernetes Platform contributors.
+
+   Licensed under the Apache License, Version 2.0 (the "License");
+   you may not use this file except in compliance with the License.
+   You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+   Unless required by applicable law or agreed to in writing, software
+   distributed under the License is distributed on an "AS IS" BASIS,
+   WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+   See the License for the specific language governing permissions and
+   limitations under the License.
+*/
+
+package quay
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/kubermatic-labs/registryman/pkg/globalregistry"
+)
+
+type project struct {
+	name     string
+	registry *registry
+}
+
+// interface guard
+var _ globalregistry.Project = &project{}
+var _ globalregistry.ProjectWithRepositories = &project{}
+var _ globalregistry.ProjectWithMembers = &project{}
+var _ globalregistry.MemberManipulatorProject = &project{}
+var _ globalregistry.DestructibleProject = &project{}
+
+func (p *project) GetName() string {
+	return p.name
+}
+
+// Delete removes the organization from the registry.
+func (p *project) Delete(ctx context.Context) error {
+	repos, err := p.GetRepositories(ctx)
+	if err != nil {
+		return err
+	}
+
+	if len(repos) > 0 {
+		switch opt := p.registry.GetOptions().(type) {
+		case globalregistry.CanForceDelete:
+			if f := opt.ForceDeleteProjects(); !f {
+				return fmt.Errorf("%s: repositories are present, please delete them before deleting the project, %w", p.name, globalregistry.ErrRecoverableError)
+			}
+			for _, repo := range repos {
+				p.registry.logger.V(1).Info("deleting repository",
+					"repositoryName", repo,
+				)
+				err = p.deleteRepository(ctx, repo)
+				if err != nil {
+					return err
+				}
+			}
+		default:
+			return globalregistry.ErrNotImplemented
+		}
+	}
+	p.registry.logger.V(1).Info("deleting organization",
+		"name", p.name,
+	)
+	err = p.registry.client.DeleteOrganization(ctx, p.name)
+	if err != nil {
+		return p.registry.apiError(err)
+	}
+	return nil
+}
+
+// GetRepositories returns the names of the repositories of the organization
+// without the organization prefix.
+func (p *project) GetRepositories(ctx context.Context) ([]string, error) {
+	repositories, err := p.registry.client.GetRepositories(ctx, GetRepositoriesOptions{
+		Namespace: p.name,
+	})
+	if err != nil {
+		return nil, p.registry.apiError(err)
+	}
+	repoNames := make([]string, len(repositories))
+	for i, repository := range repositories {
+		repoNames[i] = repository.Name
+	}
+	return repoNames, nil
+}
+
+func (p *project) deleteRepository(ctx context.Context, repo string) error {
+	err := p.registry.client.DeleteRepository(ctx, fmt.Sprintf("%s/%s", p.name, repo))
+	if err != nil {
+		return p.registry.apiError(err)
+	}
+	return nil
+}
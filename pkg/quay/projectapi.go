@@ -0,0 +1,83 @@
+/*
+   Copyright 2021 The Kubermatic Kubernetes Platform contributors.
+
+   Licensed under the Apache License, Version 2.0 (the "License");
+   you may not use this file except in compliance with the License.
+   You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+   Unless required by applicable law or agreed to in writing, software
+   distributed under the License is distributed on an "AS IS" BASIS,
+   WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+   See the License for the specific language governing permissions and
+   limitations under the License.
+*/
+
+package quay
+
+import (
+	"context"
+
+	"github.com/kubermatic-labs/registryman/pkg/globalregistry"
+)
+
+// GetProjectByName implements the globalregistry.RegistryWithProjects
+// interface. The organizations of Quay are handled as projects.
+func (r *registry) GetProjectByName(ctx context.Context, name string) (globalregistry.Project, error) {
+	if name == "" {
+		return &project{
+			name:     "",
+			registry: r,
+		}, nil
+	}
+	projects, err := r.ListProjects(ctx)
+	if err != nil {
+		return nil, err
+	}
+	for _, project := range projects {
+		if project.GetName() == name {
+			return project, nil
+		}
+	}
+	return nil, nil
+}
+
+// ListProjects implements the globalregistry.RegistryWithProjects interface. It
+// returns the organizations that are visible for the owner of the OAuth2
+// token.
+func (r *registry) ListProjects(ctx context.Context) ([]globalregistry.Project, error) {
+	r.logger.V(1).Info("listing organizations",
+		"registry", r.GetName(),
+	)
+	organizations, err := r.client.GetUserOrganizations(ctx)
+	if err != nil {
+		return nil, r.apiError(err)
+	}
+	pStatus := make([]globalregistry.Project, len(organizations))
+	for i, organization := range organizations {
+		pStatus[i] = &project{
+			name:     organization.Name,
+			registry: r,
+		}
+	}
+	return pStatus, nil
+}
+
+// CreateProject implements the globalregistry.ProjectCreator interface. It
+// creates a new organization.
+func (r *registry) CreateProject(ctx context.Context, name string) (globalregistry.Project, error) {
+	r.logger.V(1).Info("creating organization",
+		"name", name,
+	)
+	err := r.client.CreateOrganization(ctx, CreateOrganizationOptions{
+		Name: name,
+	})
+	if err != nil {
+		return nil, r.apiError(err)
+	}
+	return &project{
+		name:     name,
+		registry: r,
+	}, nil
+}
@@ -0,0 +1,52 @@
+package quay
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+)
+
+type DelegateKind string
+
+const (
+	UserDelegate DelegateKind = "user"
+	TeamDelegate DelegateKind = "team"
+)
+
+type Delegate struct {
+	Kind    DelegateKind `json:"kind"`
+	Name    string       `json:"name"`
+	IsRobot bool         `json:"is_robot,omitempty"`
+}
+
+// Prototype is a default permission of an organization. Quay grants the role
+// to the delegate on every repository created in the organization.
+type Prototype struct {
+	ID       string         `json:"id,omitempty"`
+	Role     RepositoryRole `json:"role"`
+	Delegate Delegate       `json:"delegate"`
+}
+
+type getOrganizationPrototypesResponse struct {
+	Prototypes []Prototype `json:"prototypes"`
+}
+
+func (c *Client) GetOrganizationPrototypes(ctx context.Context, org string) ([]Prototype, error) {
+	response := getOrganizationPrototypesResponse{}
+	path := fmt.Sprintf("/organization/%s/prototypes", url.PathEscape(org))
+	err := c.call(ctx, "GET", path, nil, nil, &response)
+
+	return response.Prototypes, err
+}
+
+func (c *Client) CreateOrganizationPrototype(ctx context.Context, org string, prototype Prototype) error {
+	path := fmt.Sprintf("/organization/%s/prototypes", url.PathEscape(org))
+
+	return c.call(ctx, "POST", path, nil, toBody(prototype), nil)
+}
+
+func (c *Client) DeleteOrganizationPrototype(ctx context.Context, org string, id string) error {
+	path := fmt.Sprintf("/organization/%s/prototypes/%s", url.PathEscape(org), url.PathEscape(id))
+
+	return c.call(ctx, "DELETE", path, nil, nil, nil)
+}
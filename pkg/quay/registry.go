@@ -0,0 +1,120 @@
+/*
+   Copyright 2021 The Kubermatic Kubernetes Platform contributors.
+
+   Licensed under the Apache License, Version 2.0 (the "License");
+   you may not use this file except in compliance with the License.
+   You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+   Unless required by applicable law or agreed to in writing, software
+   distributed under the License is distributed on an "AS IS" BASIS,
+   WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+   See the License for the specific language governing permissions and
+   limitations under the License.
+*/
+
+// quay package implements the globalregistry.Registry interface for the registry
+// provider Quay. The package also contains the Client of the Quay REST API that
+// the provider is built upon.
+package quay
+
+import (
+	"crypto/tls"
+	"errors"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/go-logr/logr"
+	"github.com/kubermatic-labs/registryman/pkg/globalregistry"
+)
+
+func init() {
+	// during init the quay provider is registered
+	globalregistry.RegisterProviderImplementation(
+		"quay",
+		newRegistry,
+		quayRegistryCapabilities{},
+		quayRoles,
+	)
+}
+
+// ownersTeam is the team that Quay creates implicitly in every organization.
+// It contains the creator of the organization and it cannot be deleted.
+const ownersTeam = "owners"
+
+type registry struct {
+	logger    logr.Logger
+	parsedUrl *url.URL
+	globalregistry.Registry
+	client *Client
+}
+
+var _ globalregistry.Registry = &registry{}
+var _ globalregistry.RegistryWithProjects = &registry{}
+var _ globalregistry.ProjectCreator = &registry{}
+
+// newRegistry is the constructor of the registry type. It is a globalregistry
+// RegistryCreator.
+//
+// Quay API expects an OAuth2 access token, so the password of the registry
+// configuration is used as the token.
+func newRegistry(logger logr.Logger, config globalregistry.Registry) (globalregistry.Registry, error) {
+	var err error
+	r := &registry{
+		logger:   logger,
+		Registry: config,
+	}
+	r.parsedUrl, err = url.Parse(config.GetAPIEndpoint())
+	if err != nil {
+		return nil, err
+	}
+	apiUrl := *r.parsedUrl
+	apiUrl.Path = strings.TrimSuffix(apiUrl.Path, "/") + "/api/v1"
+	r.client = &Client{
+		Token:   config.GetPassword(),
+		BaseURL: apiUrl.String(),
+		Client: &http.Client{
+			Transport: &http.Transport{
+				TLSClientConfig: &tls.Config{
+					InsecureSkipVerify: config.GetInsecureSkipTLSVerify(),
+				},
+			},
+		},
+	}
+	return r, nil
+}
+
+// apiError converts the errors of the Quay Client to the errors of the
+// globalregistry package where possible.
+func (r *registry) apiError(err error) error {
+	var apiErr *APIError
+	if !errors.As(err, &apiErr) {
+		return err
+	}
+	r.logger.V(1).Info("Quay API returned error",
+		"status-code", apiErr.Status,
+		"error", apiErr.Error(),
+	)
+	switch apiErr.Status {
+	case http.StatusUnauthorized:
+		return globalregistry.ErrUnauthorized
+	case http.StatusConflict:
+		return globalregistry.ErrAlreadyExists
+	default:
+		return globalregistry.ErrInvalidStatusCode(apiErr.Status)
+	}
+}
+
+type quayRegistryCapabilities struct{}
+
+var _ globalregistry.ReplicationCapabilities = quayRegistryCapabilities{}
+
+func (cap quayRegistryCapabilities) CanPull() bool {
+	return false
+}
+
+func (cap quayRegistryCapabilities) CanPush() bool {
+	return false
+}
@@ -0,0 +1,176 @@
+/*
+   Copyright 2021 The Kubermatic Kubernetes Platform contributors.
+
+   Licensed under the Apache License, Version 2.0 (the "License");
+   you may not use this file except in compliance with the License.
+   You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+   Unless required by applicable law or agreed to in writing, software
+   distributed under the License is distributed on an "AS IS" BASIS,
+   WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+   See the License for the specific language governing permissions and
+   limitations under the License.
+*/
+
+package quay
+
+import (
+	"context"
+
+	"github.com/go-logr/logr"
+	"github.com/kubermatic-labs/registryman/pkg/globalregistry"
+	"github.com/kubermatic-labs/registryman/pkg/globalregistry/globalregistrytest"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+func memberStrings(members []globalregistry.ProjectMember) []string {
+	s := make([]string, len(members))
+	for i, member := range members {
+		s[i] = member.GetType() + ":" + member.GetName() + ":" + member.GetRole()
+		if ldapMember, ok := member.(globalregistry.LdapMember); ok && ldapMember.GetDN() != "" {
+			s[i] += ":" + ldapMember.GetDN()
+		}
+	}
+	return s
+}
+
+var _ = Describe("Quay provider", func() {
+	var (
+		ctx    context.Context
+		server *fakeQuay
+		config *globalregistrytest.Registry
+		reg    globalregistry.Registry
+	)
+
+	BeforeEach(func() {
+		var err error
+		ctx = context.Background()
+		server = newFakeQuay("secret-token")
+		config = &globalregistrytest.Registry{
+			Provider:    "quay",
+			Name:        "quay-test",
+			Username:    "admin",
+			Password:    "secret-token",
+			APIEndpoint: server.URL,
+			Options:     &globalregistrytest.Options{},
+		}
+		reg, err = globalregistry.New(logr.Discard(), config)
+		Expect(err).ToNot(HaveOccurred())
+	})
+
+	AfterEach(func() {
+		server.Close()
+	})
+
+	It("is registered as provider", func() {
+		Expect(reg).To(BeAssignableToTypeOf(&registry{}))
+		capabilities := globalregistry.GetReplicationCapability("quay")
+		Expect(capabilities.CanPull()).To(BeFalse())
+		Expect(capabilities.CanPush()).To(BeFalse())
+	})
+
+	It("returns ErrUnauthorized for invalid token", func() {
+		config.Password = "invalid"
+		reg, err := globalregistry.New(logr.Discard(), config)
+		Expect(err).ToNot(HaveOccurred())
+		_, err = reg.(globalregistry.RegistryWithProjects).ListProjects(ctx)
+		Expect(err).To(Equal(globalregistry.ErrUnauthorized))
+	})
+
+	It("handles organizations as projects", func() {
+		server.addOrganization("existing")
+		projects, err := reg.(globalregistry.RegistryWithProjects).ListProjects(ctx)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(projects).To(HaveLen(1))
+		Expect(projects[0].GetName()).To(Equal("existing"))
+
+		project, err := reg.(globalregistry.ProjectCreator).CreateProject(ctx, "new")
+		Expect(err).ToNot(HaveOccurred())
+		Expect(project.GetName()).To(Equal("new"))
+		Expect(server.organizations).To(HaveKey("new"))
+
+		project, err = reg.(globalregistry.RegistryWithProjects).GetProjectByName(ctx, "new")
+		Expect(err).ToNot(HaveOccurred())
+		Expect(project).ToNot(BeNil())
+
+		err = project.(globalregistry.DestructibleProject).Delete(ctx)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(server.organizations).ToNot(HaveKey("new"))
+
+		project, err = reg.(globalregistry.RegistryWithProjects).GetProjectByName(ctx, "new")
+		Expect(err).ToNot(HaveOccurred())
+		Expect(project).To(BeNil())
+	})
+
+	It("deletes organizations with repositories only when forced", func() {
+		server.addOrganization("images").repositories = []string{"alpine", "ubuntu"}
+		project, err := reg.(globalregistry.RegistryWithProjects).GetProjectByName(ctx, "images")
+		Expect(err).ToNot(HaveOccurred())
+		repos, err := project.(globalregistry.ProjectWithRepositories).GetRepositories(ctx)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(repos).To(ConsistOf("alpine", "ubuntu"))
+
+		err = project.(globalregistry.DestructibleProject).Delete(ctx)
+		Expect(err).To(MatchError(globalregistry.ErrRecoverableError))
+		Expect(server.organizations).To(HaveKey("images"))
+
+		config.Options.ForceDelete = true
+		err = project.(globalregistry.DestructibleProject).Delete(ctx)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(server.organizations).ToNot(HaveKey("images"))
+	})
+
+	It("manipulates the members of the organizations", func() {
+		server.addOrganization("apps")
+		project, err := reg.(globalregistry.RegistryWithProjects).GetProjectByName(ctx, "apps")
+		Expect(err).ToNot(HaveOccurred())
+		memberProject := project.(globalregistry.MemberManipulatorProject)
+
+		creds, err := memberProject.AssignMember(ctx, &globalregistrytest.Member{Name: "alice", Type: "User", Role: "Developer"})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(creds).To(BeNil())
+
+		creds, err = memberProject.AssignMember(ctx, &globalregistrytest.LdapMember{
+			Member: globalregistrytest.Member{Name: "devs", Type: "Group", Role: "Maintainer"},
+			DN:     "cn=devs,dc=example,dc=com",
+		})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(creds).To(BeNil())
+
+		creds, err = memberProject.AssignMember(ctx, &globalregistrytest.Member{Name: "ci", Type: "Robot", Role: "PullAndPush"})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(creds).ToNot(BeNil())
+		Expect(creds.Username).To(Equal("apps+ci"))
+		Expect(creds.Password).To(Equal(server.organizations["apps"].robots["ci"]))
+
+		members, err := project.(globalregistry.ProjectWithMembers).GetMembers(ctx)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(memberStrings(members)).To(ConsistOf(
+			"User:alice:Developer",
+			"Group:devs:Maintainer:cn=devs,dc=example,dc=com",
+			"Robot:ci:PullAndPush",
+		))
+
+		for _, member := range members {
+			Expect(memberProject.UnassignMember(ctx, member)).To(Succeed())
+		}
+		members, err = project.(globalregistry.ProjectWithMembers).GetMembers(ctx)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(members).To(BeEmpty())
+		Expect(server.organizations["apps"].robots).To(BeEmpty())
+		Expect(server.organizations["apps"].prototypes).To(BeEmpty())
+		Expect(server.organizations["apps"].teams).To(HaveLen(1))
+	})
+
+	It("rejects roles not supported by Quay", func() {
+		server.addOrganization("apps")
+		project, err := reg.(globalregistry.RegistryWithProjects).GetProjectByName(ctx, "apps")
+		Expect(err).ToNot(HaveOccurred())
+		_, err = project.(globalregistry.MemberManipulatorProject).AssignMember(ctx, &globalregistrytest.Member{Name: "pusher", Type: "Robot", Role: "PushOnly"})
+		Expect(err).To(HaveOccurred())
+		Expect(server.organizations["apps"].robots).To(BeEmpty())
+	})
+})
@@ -72,10 +72,12 @@ type CreateOrganizationRobotOptions struct {
 	Description string `json:"description"`
 }
 
-func (c *Client) CreateOrganizationRobot(ctx context.Context, org string, shortName string, opt CreateOrganizationRobotOptions) error {
+func (c *Client) CreateOrganizationRobot(ctx context.Context, org string, shortName string, opt CreateOrganizationRobotOptions) (*Robot, error) {
+	robot := &Robot{}
 	path := fmt.Sprintf("/organization/%s/robots/%s", url.PathEscape(org), url.PathEscape(shortName))
+	err := c.call(ctx, "PUT", path, nil, toBody(opt), robot)
 
-	return c.call(ctx, "PUT", path, nil, toBody(opt), nil)
+	return robot, err
 }
 
 func (c *Client) DeleteOrganizationRobot(ctx context.Context, org string, shortName string) error {
@@ -0,0 +1,100 @@
+/*
+   Copyright 2021 The Kubermatic Kubernetes Platform contributors.
+
+   Licensed under the Apache License, Version 2.0 (the "License");
+   you may not use this file except in compliance with the License.
+   You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+   Unless required by applicable law or agreed to in writing, software
+   distributed under the License is distributed on an "AS IS" BASIS,
+   WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+   See the License for the specific language governing permissions and
+   limitations under the License.
+*/
+
+package quay
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/kubermatic-labs/registryman/pkg/globalregistry"
+)
+
+// Users and robots are granted organization wide access by default
+// permissions (prototypes). The role of the member is derived from the
+// repository role of the prototype.
+//
+// Groups are implemented by teams. The provider specific role of a group is
+// the team role and the repository role of the default permission of the team
+// separated by a colon. Admin teams have full access to the organization, so
+// no default permission is needed for them.
+
+// quayRoles is the role table of Quay.
+var quayRoles = globalregistry.RoleTable{
+	userType: {
+		"Guest":        string(ReadRepositoryRole),
+		"Developer":    string(WriteRepositoryRole),
+		"ProjectAdmin": string(AdminRepositoryRole),
+	},
+	robotType: {
+		"PullOnly":    string(ReadRepositoryRole),
+		"PullAndPush": string(WriteRepositoryRole),
+	},
+	groupType: {
+		"Guest":        teamProviderRole(MemberTeamRole, ReadRepositoryRole),
+		"Developer":    teamProviderRole(MemberTeamRole, WriteRepositoryRole),
+		"Maintainer":   teamProviderRole(CreatorTeamRole, WriteRepositoryRole),
+		"ProjectAdmin": teamProviderRole(AdminTeamRole, ""),
+	},
+}
+
+// roleTable returns the role table of the registry, i.e. the Quay role table
+// with the role mapping of the registry applied.
+func (r *registry) roleTable() (globalregistry.RoleTable, error) {
+	return globalregistry.GetRoleTable(r.Registry)
+}
+
+// teamProviderRole returns the provider specific role of a group member.
+func teamProviderRole(t TeamRole, r RepositoryRole) string {
+	if t == AdminTeamRole || r == "" {
+		return string(t)
+	}
+	return fmt.Sprintf("%s:%s", t, r)
+}
+
+// parseRepositoryRole returns the repository role of a provider specific user
+// or robot role.
+func parseRepositoryRole(s string) (RepositoryRole, error) {
+	for _, r := range AllRepositoryRoles {
+		if string(r) == s {
+			return r, nil
+		}
+	}
+	return "", fmt.Errorf("invalid Quay repository role: %s", s)
+}
+
+// parseTeamProviderRole returns the team role and the repository role of the
+// default permission of a provider specific group role.
+func parseTeamProviderRole(s string) (TeamRole, RepositoryRole, error) {
+	teamRoleString, repositoryRoleString := s, ""
+	if i := strings.Index(s, ":"); i >= 0 {
+		teamRoleString, repositoryRoleString = s[:i], s[i+1:]
+	}
+	for _, t := range AllTeamRoles {
+		if string(t) != teamRoleString {
+			continue
+		}
+		if repositoryRoleString == "" {
+			return t, "", nil
+		}
+		r, err := parseRepositoryRole(repositoryRoleString)
+		if err != nil {
+			return "", "", err
+		}
+		return t, r, nil
+	}
+	return "", "", fmt.Errorf("invalid Quay team role: %s", s)
+}
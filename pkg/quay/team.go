@@ -20,10 +20,18 @@ type TeamMember struct {
 	IsRobot bool               `json:"is_robot"`
 }
 
+type TeamSync struct {
+	Service string `json:"service"`
+	Config  struct {
+		GroupDN string `json:"group_dn"`
+	} `json:"config"`
+}
+
 type getTeamMembersReponse struct {
 	Name    string       `json:"name"`
 	CanEdit bool         `json:"can_edit"`
 	Members []TeamMember `json:"members"`
+	Synced  *TeamSync    `json:"synced"`
 }
 
 type GetTeamMembersOptions struct {
@@ -74,3 +82,28 @@ func (c *Client) DeleteTeam(ctx context.Context, org string, team string) error
 
 	return c.call(ctx, "DELETE", path, nil, nil, nil)
 }
+
+// GetTeamSync returns the directory synchronization configuration of the team.
+// If the team is not synchronized, nil is returned.
+func (c *Client) GetTeamSync(ctx context.Context, org string, team string) (*TeamSync, error) {
+	response := getTeamMembersReponse{}
+	path := fmt.Sprintf("/organization/%s/team/%s/members", url.PathEscape(org), url.PathEscape(team))
+	err := c.call(ctx, "GET", path, nil, nil, &response)
+
+	return response.Synced, err
+}
+
+type enableTeamSyncBody struct {
+	GroupDN string `json:"group_dn"`
+}
+
+// EnableTeamSync makes Quay synchronize the members of the team with the
+// directory group identified by groupDN.
+func (c *Client) EnableTeamSync(ctx context.Context, org string, team string, groupDN string) error {
+	path := fmt.Sprintf("/organization/%s/team/%s/syncing", url.PathEscape(org), url.PathEscape(team))
+	body := enableTeamSyncBody{
+		GroupDN: groupDN,
+	}
+
+	return c.call(ctx, "POST", path, nil, toBody(body), nil)
+}
@@ -30,6 +30,7 @@ import (
 	"github.com/containers/image/v5/directory"
 	"github.com/containers/image/v5/docker"
 	"github.com/containers/image/v5/docker/reference"
+	"github.com/containers/image/v5/manifest"
 	"github.com/containers/image/v5/signature"
 	"github.com/containers/image/v5/transports"
 	"github.com/containers/image/v5/types"
@@ -144,16 +145,41 @@ func syncImages(data *transferData) error {
 				return err
 			}
 
+			tag := trackedTag(data, ref, destRef)
+			if tag != "" {
+				srcCtx := srcRepo.Context
+				synced, unchanged := syncedDigest(data.syncedDigests[tag],
+					func() (digest.Digest, error) {
+						return docker.GetDigest(ctx, srcCtx, ref)
+					},
+					func() (digest.Digest, error) {
+						return docker.GetDigest(ctx, data.destinationCtx, destRef)
+					})
+				if unchanged {
+					data.digests[tag] = synced
+					logrus.WithFields(logrus.Fields{
+						"from": transports.ImageName(ref),
+						"to":   transports.ImageName(destRef),
+					}).Infof("Skipping unchanged image ref %d/%d", counter+1, len(srcRepo.ImageRefs))
+					continue
+				}
+			}
+
 			logrus.WithFields(logrus.Fields{
 				"from": transports.ImageName(ref),
 				"to":   transports.ImageName(destRef),
 			}).Infof("Copying image ref %d/%d", counter+1, len(srcRepo.ImageRefs))
 
-			_, err = copy.Image(ctx, policyContext, destRef, ref, &options)
+			copiedManifest, err := copy.Image(ctx, policyContext, destRef, ref, &options)
 
 			if err != nil {
 				return errors.Wrapf(err, "Error copying ref %q", transports.ImageName(ref))
 			}
+			if tag != "" {
+				if d, err := manifest.Digest(copiedManifest); err == nil {
+					data.digests[tag] = d.String()
+				}
+			}
 			imagesNumber++
 		}
 	}
@@ -161,6 +187,42 @@ func syncImages(data *transferData) error {
 	return nil
 }
 
+// trackedTag returns the tag of the source image reference if the digests of
+// the synchronized images are tracked. Empty string is returned otherwise.
+func trackedTag(data *transferData, srcRef, destRef types.ImageReference) string {
+	if data.digests == nil ||
+		srcRef.Transport() != docker.Transport ||
+		destRef.Transport() != docker.Transport {
+		return ""
+	}
+	tagged, ok := srcRef.DockerReference().(reference.Tagged)
+	if !ok {
+		return ""
+	}
+	return tagged.Tag()
+}
+
+// syncedDigest returns the manifest digest of the source image and whether the
+// image is unchanged since its last synchronization, i.e. its digest is the
+// recorded one. If no digest is recorded for the image, it is compared to the
+// destination image instead, so the destination registry is asked only for
+// the images which have not been synchronized before. The images which cannot
+// be inspected are considered as changed.
+func syncedDigest(recorded string, sourceDigest, destinationDigest func() (digest.Digest, error)) (string, bool) {
+	if recorded == "" {
+		destDigest, err := destinationDigest()
+		if err != nil {
+			return "", false
+		}
+		recorded = destDigest.String()
+	}
+	srcDigest, err := sourceDigest()
+	if err != nil || srcDigest.String() != recorded {
+		return "", false
+	}
+	return recorded, true
+}
+
 // imagesToCopy retrieves all the images to copy from a specified sync source
 // and transport.
 // It returns a slice of repository descriptors, where each descriptor is a
@@ -0,0 +1,74 @@
+/*
+   Copyright 2021 The Kubermatic Kubernetes Platform contributors.
+
+   Licensed under the Apache License, Version 2.0 (the "License");
+   you may not use this file except in compliance with the License.
+   You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+   Unless required by applicable law or agreed to in writing, software
+   distributed under the License is distributed on an "AS IS" BASIS,
+   WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+   See the License for the specific language governing permissions and
+   limitations under the License.
+*/
+
+package skopeo
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/opencontainers/go-digest"
+)
+
+func TestSyncedDigest(t *testing.T) {
+	oldDigest := digest.FromString("old")
+	newDigest := digest.FromString("new")
+	inspect := func(d digest.Digest, calls *int) func() (digest.Digest, error) {
+		return func() (digest.Digest, error) {
+			*calls++
+			if d == "" {
+				return "", errors.New("manifest unknown")
+			}
+			return d, nil
+		}
+	}
+	syncedTest := []struct {
+		id             string
+		recorded       string
+		source         digest.Digest
+		destination    digest.Digest
+		expDigest      string
+		expUnchanged   bool
+		expSourceCalls int
+		expDestCalls   int
+	}{
+		{id: "unchanged", recorded: oldDigest.String(), source: oldDigest, destination: oldDigest,
+			expDigest: oldDigest.String(), expUnchanged: true, expSourceCalls: 1},
+		{id: "changed", recorded: oldDigest.String(), source: newDigest, destination: oldDigest,
+			expSourceCalls: 1},
+		{id: "source missing", recorded: oldDigest.String(), destination: oldDigest,
+			expSourceCalls: 1},
+		{id: "not recorded, copied before", source: oldDigest, destination: oldDigest,
+			expDigest: oldDigest.String(), expUnchanged: true, expSourceCalls: 1, expDestCalls: 1},
+		{id: "not recorded, new", source: newDigest,
+			expDestCalls: 1},
+	}
+	for _, tt := range syncedTest {
+		t.Run(tt.id, func(t *testing.T) {
+			var sourceCalls, destCalls int
+			d, unchanged := syncedDigest(tt.recorded,
+				inspect(tt.source, &sourceCalls),
+				inspect(tt.destination, &destCalls))
+			if d != tt.expDigest || unchanged != tt.expUnchanged {
+				t.Errorf("got %q, %t want %q, %t", d, unchanged, tt.expDigest, tt.expUnchanged)
+			}
+			if sourceCalls != tt.expSourceCalls || destCalls != tt.expDestCalls {
+				t.Errorf("unexpected number of digest requests: source %d, destination %d",
+					sourceCalls, destCalls)
+			}
+		})
+	}
+}
@@ -25,9 +25,27 @@ import (
 	"github.com/go-logr/logr"
 )
 
+// Credentials describes how a Docker registry can be accessed.
+type Credentials struct {
+	Username              string
+	Password              string
+	InsecureSkipTLSVerify bool
+}
+
+func (c Credentials) systemContext() *types.SystemContext {
+	return &types.SystemContext{
+		DockerAuthConfig: &types.DockerAuthConfig{
+			Username: c.Username,
+			Password: c.Password,
+		},
+		DockerInsecureSkipTLSVerify: types.NewOptionalBool(c.InsecureSkipTLSVerify),
+	}
+}
+
 type transfer struct {
-	dockerCtx *types.SystemContext
-	dirCtx    *types.SystemContext
+	dockerCtx            *types.SystemContext
+	destinationDockerCtx *types.SystemContext
+	dirCtx               *types.SystemContext
 }
 type transferData struct {
 	sourcePath           string
@@ -37,18 +55,35 @@ type transferData struct {
 	sourceTransport      string
 	destinationTransport string
 	scoped               bool
+	// syncedDigests maps the tags of the source repository to the
+	// manifest digests recorded at their last synchronization.
+	syncedDigests map[string]string
+	// digests collects the manifest digests of the synchronized tags. If
+	// it is nil, the digests are not tracked and all the images are
+	// copied.
+	digests map[string]string
 }
 
 // New creates a new transfer struct.
 func New(username, password string) *transfer {
+	dockerCtx := Credentials{
+		Username: username,
+		Password: password,
+	}.systemContext()
 	return &transfer{
-		dockerCtx: &types.SystemContext{
-			DockerAuthConfig: &types.DockerAuthConfig{
-				Username: username,
-				Password: password,
-			},
-		},
-		dirCtx: &types.SystemContext{},
+		dockerCtx:            dockerCtx,
+		destinationDockerCtx: dockerCtx,
+		dirCtx:               &types.SystemContext{},
+	}
+}
+
+// NewBetweenRegistries creates a new transfer struct which synchronizes
+// Docker repositories between registries accessed with different credentials.
+func NewBetweenRegistries(source, destination Credentials) *transfer {
+	return &transfer{
+		dockerCtx:            source.systemContext(),
+		destinationDockerCtx: destination.systemContext(),
+		dirCtx:               &types.SystemContext{},
 	}
 }
 
@@ -95,21 +130,28 @@ func (t *transfer) Import(source, destination string, logger logr.Logger) error
 }
 
 // Sync synchronizes Docker repositories from a source repository to a destination repository.
-func (t *transfer) Sync(sourceRepo, destinationRepo string, logger logr.Logger) error {
+// The syncedDigests map the tags of the source repository to the manifest digests recorded at
+// their last synchronization. The tags whose digest has not changed are not copied. The tags
+// without recorded digest are not copied if the destination image has the same digest. The
+// digests of the synchronized tags are returned, also the ones processed before a failure.
+func (t *transfer) Sync(sourceRepo, destinationRepo string, syncedDigests map[string]string, logger logr.Logger) (map[string]string, error) {
 	logger.Info("syncing images started")
+	digests := make(map[string]string)
 	err := syncImages(&transferData{
 		sourcePath:           sourceRepo,
 		destinationPath:      destinationRepo,
 		sourceCtx:            t.dockerCtx,
-		destinationCtx:       t.dockerCtx,
+		destinationCtx:       t.destinationDockerCtx,
 		sourceTransport:      docker.Transport.Name(),
 		destinationTransport: docker.Transport.Name(),
 		scoped:               false,
+		syncedDigests:        syncedDigests,
+		digests:              digests,
 	})
 
 	if err != nil {
-		return fmt.Errorf("syncing images failed: %w", err)
+		return digests, fmt.Errorf("syncing images failed: %w", err)
 	}
 
-	return nil
+	return digests, nil
 }
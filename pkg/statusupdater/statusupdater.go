@@ -73,6 +73,11 @@ func (sup *StatusUpdater) updateRegistryStatus(ctx context.Context, reg *api.Reg
 		logger.Error(err, "failed getting registry status in statusupdater")
 		return
 	}
+	err = reconciler.AddRecordedStatus(ctx, sup.store, realReg, registryStatus)
+	if err != nil {
+		logger.Error(err, "failed getting recorded status in statusupdater")
+		return
+	}
 	reg.Status = registryStatus
 	err = sup.store.UpdateRegistryStatus(ctx, reg)
 	if err != nil {
@@ -71,12 +71,18 @@ func getAos(namespace string) config.ApiObjectStore {
 
 type mockApiObjestStore struct {
 	config.ApiObjectStore
-	addedRegistry   *api.Registry
-	addedProject    *api.Project
-	addedScanner    *api.Scanner
-	removedRegistry *api.Registry
-	removedProject  *api.Project
-	removedScanner  *api.Scanner
+	addedRegistry       *api.Registry
+	addedProject        *api.Project
+	addedScanner        *api.Scanner
+	addedRobotAccount   *api.RobotAccount
+	addedTeam           *api.Team
+	addedProjectClass   *api.ProjectClass
+	removedRegistry     *api.Registry
+	removedProject      *api.Project
+	removedScanner      *api.Scanner
+	removedRobotAccount *api.RobotAccount
+	removedTeam         *api.Team
+	removedProjectClass *api.ProjectClass
 }
 
 func (maos *mockApiObjestStore) GetRegistries(ctx context.Context) []*api.Registry {
@@ -102,7 +108,15 @@ func (maos *mockApiObjestStore) GetRegistries(ctx context.Context) []*api.Regist
 	return result
 }
 
+// GetProjects returns the projects with the defaults of the project classes
+// applied. The project classes of the admission request are applied to all the
+// projects, so that the existing projects are checked against the modified
+// classes, too.
 func (maos *mockApiObjestStore) GetProjects(ctx context.Context) []*api.Project {
+	return config.ApplyProjectClasses(maos.GetRawProjects(ctx), maos.GetProjectClasses(ctx))
+}
+
+func (maos *mockApiObjestStore) GetRawProjects(ctx context.Context) []*api.Project {
 	var result []*api.Project
 	if maos.addedProject != nil {
 		result = []*api.Project{maos.addedProject}
@@ -115,8 +129,8 @@ func (maos *mockApiObjestStore) GetProjects(ctx context.Context) []*api.Project
 		removedProjectName = maos.removedProject.GetName()
 		removedProjectNamespace = maos.removedProject.GetNamespace()
 	}
-	projects := maos.ApiObjectStore.GetProjects(ctx)
-	for i, project := range maos.ApiObjectStore.GetProjects(ctx) {
+	projects := maos.ApiObjectStore.GetRawProjects(ctx)
+	for i, project := range projects {
 		if project.GetName() != removedProjectName ||
 			project.GetNamespace() != removedProjectNamespace {
 			result = append(result, projects[i])
@@ -148,6 +162,75 @@ func (maos *mockApiObjestStore) GetScanners(ctx context.Context) []*api.Scanner
 	return result
 }
 
+func (maos *mockApiObjestStore) GetRobotAccounts(ctx context.Context) []*api.RobotAccount {
+	var result []*api.RobotAccount
+	if maos.addedRobotAccount != nil {
+		result = []*api.RobotAccount{maos.addedRobotAccount}
+	} else {
+		result = []*api.RobotAccount{}
+	}
+	var removedRobotAccountName string
+	var removedRobotAccountNamespace string
+	if maos.removedRobotAccount != nil {
+		removedRobotAccountName = maos.removedRobotAccount.GetName()
+		removedRobotAccountNamespace = maos.removedRobotAccount.GetNamespace()
+	}
+	robotAccounts := maos.ApiObjectStore.GetRobotAccounts(ctx)
+	for i, robotAccount := range robotAccounts {
+		if robotAccount.GetName() != removedRobotAccountName ||
+			robotAccount.GetNamespace() != removedRobotAccountNamespace {
+			result = append(result, robotAccounts[i])
+		}
+	}
+	return result
+}
+
+func (maos *mockApiObjestStore) GetTeams(ctx context.Context) []*api.Team {
+	var result []*api.Team
+	if maos.addedTeam != nil {
+		result = []*api.Team{maos.addedTeam}
+	} else {
+		result = []*api.Team{}
+	}
+	var removedTeamName string
+	var removedTeamNamespace string
+	if maos.removedTeam != nil {
+		removedTeamName = maos.removedTeam.GetName()
+		removedTeamNamespace = maos.removedTeam.GetNamespace()
+	}
+	teams := maos.ApiObjectStore.GetTeams(ctx)
+	for i, team := range teams {
+		if team.GetName() != removedTeamName ||
+			team.GetNamespace() != removedTeamNamespace {
+			result = append(result, teams[i])
+		}
+	}
+	return result
+}
+
+func (maos *mockApiObjestStore) GetProjectClasses(ctx context.Context) []*api.ProjectClass {
+	var result []*api.ProjectClass
+	if maos.addedProjectClass != nil {
+		result = []*api.ProjectClass{maos.addedProjectClass}
+	} else {
+		result = []*api.ProjectClass{}
+	}
+	var removedProjectClassName string
+	var removedProjectClassNamespace string
+	if maos.removedProjectClass != nil {
+		removedProjectClassName = maos.removedProjectClass.GetName()
+		removedProjectClassNamespace = maos.removedProjectClass.GetNamespace()
+	}
+	projectClasses := maos.ApiObjectStore.GetProjectClasses(ctx)
+	for i, projectClass := range projectClasses {
+		if projectClass.GetName() != removedProjectClassName ||
+			projectClass.GetNamespace() != removedProjectClassNamespace {
+			result = append(result, projectClasses[i])
+		}
+	}
+	return result
+}
+
 func mockAOSWithRegistry(reg *api.Registry) *mockApiObjestStore {
 	return &mockApiObjestStore{
 		ApiObjectStore: getAos(reg.Namespace),
@@ -214,6 +297,72 @@ func mockAOSWithUpdatedScanner(oldScanner, newScanner *api.Scanner) *mockApiObje
 	}
 }
 
+func mockAOSWithRobotAccount(robotAccount *api.RobotAccount) *mockApiObjestStore {
+	return &mockApiObjestStore{
+		ApiObjectStore:    getAos(robotAccount.Namespace),
+		addedRobotAccount: robotAccount,
+	}
+}
+
+func mockAOSWithoutRobotAccount(robotAccount *api.RobotAccount) *mockApiObjestStore {
+	return &mockApiObjestStore{
+		ApiObjectStore:      getAos(robotAccount.Namespace),
+		removedRobotAccount: robotAccount,
+	}
+}
+
+func mockAOSWithUpdatedRobotAccount(oldRobotAccount, newRobotAccount *api.RobotAccount) *mockApiObjestStore {
+	return &mockApiObjestStore{
+		ApiObjectStore:      getAos(oldRobotAccount.Namespace),
+		addedRobotAccount:   newRobotAccount,
+		removedRobotAccount: oldRobotAccount,
+	}
+}
+
+func mockAOSWithTeam(team *api.Team) *mockApiObjestStore {
+	return &mockApiObjestStore{
+		ApiObjectStore: getAos(team.Namespace),
+		addedTeam:      team,
+	}
+}
+
+func mockAOSWithoutTeam(team *api.Team) *mockApiObjestStore {
+	return &mockApiObjestStore{
+		ApiObjectStore: getAos(team.Namespace),
+		removedTeam:    team,
+	}
+}
+
+func mockAOSWithUpdatedTeam(oldTeam, newTeam *api.Team) *mockApiObjestStore {
+	return &mockApiObjestStore{
+		ApiObjectStore: getAos(oldTeam.Namespace),
+		addedTeam:      newTeam,
+		removedTeam:    oldTeam,
+	}
+}
+
+func mockAOSWithProjectClass(projectClass *api.ProjectClass) *mockApiObjestStore {
+	return &mockApiObjestStore{
+		ApiObjectStore:    getAos(projectClass.Namespace),
+		addedProjectClass: projectClass,
+	}
+}
+
+func mockAOSWithoutProjectClass(projectClass *api.ProjectClass) *mockApiObjestStore {
+	return &mockApiObjestStore{
+		ApiObjectStore:      getAos(projectClass.Namespace),
+		removedProjectClass: projectClass,
+	}
+}
+
+func mockAOSWithUpdatedProjectClass(oldProjectClass, newProjectClass *api.ProjectClass) *mockApiObjestStore {
+	return &mockApiObjestStore{
+		ApiObjectStore:      getAos(oldProjectClass.Namespace),
+		addedProjectClass:   newProjectClass,
+		removedProjectClass: oldProjectClass,
+	}
+}
+
 func AdmissionRequestHandler(w http.ResponseWriter, r *http.Request) {
 	logger.Info("admission request handler invoked",
 		"method", r.Method,
@@ -339,6 +488,42 @@ func createAdmissionHandler(w http.ResponseWriter, admissionRev *admissionV1.Adm
 			return
 		}
 		aos = mockAOSWithScanner(scanner)
+	case metav1.GroupVersionKind{
+		Group:   api.GroupName,
+		Version: api.GroupVersion.Version,
+		Kind:    "RobotAccount",
+	}:
+		robotAccount, ok := o.(*api.RobotAccount)
+		if !ok {
+			logger.V(-2).Info("robot account type mismatch")
+			http.Error(w, "RobotAccount type mismatch", http.StatusBadRequest)
+			return
+		}
+		aos = mockAOSWithRobotAccount(robotAccount)
+	case metav1.GroupVersionKind{
+		Group:   api.GroupName,
+		Version: api.GroupVersion.Version,
+		Kind:    "Team",
+	}:
+		team, ok := o.(*api.Team)
+		if !ok {
+			logger.V(-2).Info("team type mismatch")
+			http.Error(w, "Team type mismatch", http.StatusBadRequest)
+			return
+		}
+		aos = mockAOSWithTeam(team)
+	case metav1.GroupVersionKind{
+		Group:   api.GroupName,
+		Version: api.GroupVersion.Version,
+		Kind:    "ProjectClass",
+	}:
+		projectClass, ok := o.(*api.ProjectClass)
+		if !ok {
+			logger.V(-2).Info("project class type mismatch")
+			http.Error(w, "ProjectClass type mismatch", http.StatusBadRequest)
+			return
+		}
+		aos = mockAOSWithProjectClass(projectClass)
 	}
 	validateConsistency(w, aos, admissionRev)
 }
@@ -396,6 +581,42 @@ func deleteAdmissionHandler(w http.ResponseWriter, admissionRev *admissionV1.Adm
 			return
 		}
 		aos = mockAOSWithoutScanner(scanner)
+	case metav1.GroupVersionKind{
+		Group:   api.GroupName,
+		Version: api.GroupVersion.Version,
+		Kind:    "RobotAccount",
+	}:
+		robotAccount, ok := o.(*api.RobotAccount)
+		if !ok {
+			logger.V(-2).Info("robot account type mismatch")
+			http.Error(w, "RobotAccount type mismatch", http.StatusBadRequest)
+			return
+		}
+		aos = mockAOSWithoutRobotAccount(robotAccount)
+	case metav1.GroupVersionKind{
+		Group:   api.GroupName,
+		Version: api.GroupVersion.Version,
+		Kind:    "Team",
+	}:
+		team, ok := o.(*api.Team)
+		if !ok {
+			logger.V(-2).Info("team type mismatch")
+			http.Error(w, "Team type mismatch", http.StatusBadRequest)
+			return
+		}
+		aos = mockAOSWithoutTeam(team)
+	case metav1.GroupVersionKind{
+		Group:   api.GroupName,
+		Version: api.GroupVersion.Version,
+		Kind:    "ProjectClass",
+	}:
+		projectClass, ok := o.(*api.ProjectClass)
+		if !ok {
+			logger.V(-2).Info("project class type mismatch")
+			http.Error(w, "ProjectClass type mismatch", http.StatusBadRequest)
+			return
+		}
+		aos = mockAOSWithoutProjectClass(projectClass)
 	}
 	validateConsistency(w, aos, admissionRev)
 }
@@ -478,6 +699,60 @@ func updateAdmissionHandler(w http.ResponseWriter, admissionRev *admissionV1.Adm
 			return
 		}
 		aos = mockAOSWithUpdatedScanner(oldscanner, scanner)
+	case metav1.GroupVersionKind{
+		Group:   api.GroupName,
+		Version: api.GroupVersion.Version,
+		Kind:    "RobotAccount",
+	}:
+		robotAccount, ok := o.(*api.RobotAccount)
+		if !ok {
+			logger.V(-2).Info("robot account type mismatch")
+			http.Error(w, "RobotAccount type mismatch", http.StatusBadRequest)
+			return
+		}
+		oldrobotaccount, ok := oldO.(*api.RobotAccount)
+		if !ok {
+			logger.V(-2).Info("robot account type mismatch")
+			http.Error(w, "RobotAccount type mismatch", http.StatusBadRequest)
+			return
+		}
+		aos = mockAOSWithUpdatedRobotAccount(oldrobotaccount, robotAccount)
+	case metav1.GroupVersionKind{
+		Group:   api.GroupName,
+		Version: api.GroupVersion.Version,
+		Kind:    "Team",
+	}:
+		team, ok := o.(*api.Team)
+		if !ok {
+			logger.V(-2).Info("team type mismatch")
+			http.Error(w, "Team type mismatch", http.StatusBadRequest)
+			return
+		}
+		oldteam, ok := oldO.(*api.Team)
+		if !ok {
+			logger.V(-2).Info("team type mismatch")
+			http.Error(w, "Team type mismatch", http.StatusBadRequest)
+			return
+		}
+		aos = mockAOSWithUpdatedTeam(oldteam, team)
+	case metav1.GroupVersionKind{
+		Group:   api.GroupName,
+		Version: api.GroupVersion.Version,
+		Kind:    "ProjectClass",
+	}:
+		projectClass, ok := o.(*api.ProjectClass)
+		if !ok {
+			logger.V(-2).Info("project class type mismatch")
+			http.Error(w, "ProjectClass type mismatch", http.StatusBadRequest)
+			return
+		}
+		oldprojectclass, ok := oldO.(*api.ProjectClass)
+		if !ok {
+			logger.V(-2).Info("project class type mismatch")
+			http.Error(w, "ProjectClass type mismatch", http.StatusBadRequest)
+			return
+		}
+		aos = mockAOSWithUpdatedProjectClass(oldprojectclass, projectClass)
 	}
 	validateConsistency(w, aos, admissionRev)
 }